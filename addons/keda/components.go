@@ -0,0 +1,464 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keda
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	kedav1alpha1 "github.com/apache/camel-k/v2/addons/keda/duck/v1alpha1"
+	"github.com/apache/camel-k/v2/pkg/metadata"
+	"github.com/apache/camel-k/v2/pkg/trait"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/property"
+	utilResource "github.com/apache/camel-k/v2/pkg/util/resource"
+	"github.com/apache/camel-k/v2/pkg/util/uri"
+
+	v1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// componentPropertyPrefix allows to set the KEDA metadata of the triggers inferred from the components,
+// e.g. `camel.k.keda.artemis-queue.managementEndpoint`.
+const componentPropertyPrefix = "camel.k.keda."
+
+var placeholderRegexp = regexp.MustCompile(`^\{\{([^:}]+)(?::([^}]*))?\}\}$`)
+
+// componentScaler describes how a KEDA trigger is inferred from the consumer endpoints of a Camel component.
+type componentScaler struct {
+	triggerType string
+	// metadata computes the KEDA metadata from the endpoint, or returns nil if the endpoint cannot be scaled on
+	metadata func(ep *componentEndpoint) map[string]string
+	// authentication maps the KEDA authentication parameters to the Camel options holding their value
+	authentication map[string][]string
+	// authenticationRequired skips the trigger when none of the authentication parameters is provided
+	authenticationRequired bool
+	required               []string
+}
+
+var artemisScaler = componentScaler{
+	triggerType: "artemis-queue",
+	metadata: func(ep *componentEndpoint) map[string]string {
+		queue := ep.path()
+		if strings.HasPrefix(queue, "topic:") || strings.HasPrefix(queue, "temp-topic:") {
+			return nil
+		}
+		queue = strings.TrimPrefix(queue, "queue:")
+		return map[string]string{
+			"queueName":     queue,
+			"brokerAddress": queue,
+		}
+	},
+	authentication: map[string][]string{
+		"username": {"username"},
+		"password": {"password"},
+	},
+	required: []string{"managementEndpoint", "brokerName", "brokerAddress", "queueName"},
+}
+
+// componentScalers maps the Camel components to the KEDA scalers.
+var componentScalers = map[string]componentScaler{
+	"kafka": {
+		triggerType: "kafka",
+		metadata: func(ep *componentEndpoint) map[string]string {
+			return map[string]string{
+				"topic":            ep.path(),
+				"bootstrapServers": ep.option("brokers"),
+				"consumerGroup":    ep.option("groupId"),
+			}
+		},
+		required: []string{"bootstrapServers", "consumerGroup", "topic"},
+	},
+	"aws2-sqs": {
+		triggerType: "aws-sqs-queue",
+		metadata: func(ep *componentEndpoint) map[string]string {
+			return map[string]string{
+				"queueURL":  ep.path(),
+				"awsRegion": ep.option("region"),
+			}
+		},
+		authentication: map[string][]string{
+			"awsAccessKeyID":     {"accessKey"},
+			"awsSecretAccessKey": {"secretKey"},
+		},
+		required: []string{"queueURL", "awsRegion"},
+	},
+	"jms":  artemisScaler,
+	"amqp": artemisScaler,
+	"rabbitmq": {
+		triggerType: "rabbitmq",
+		metadata: func(ep *componentEndpoint) map[string]string {
+			meta := map[string]string{
+				"queueName": ep.option("queue"),
+				"mode":      "QueueLength",
+				"value":     "20",
+			}
+			if hostname := ep.option("hostname"); hostname != "" {
+				port := ep.option("portNumber")
+				if port == "" {
+					port = "5672"
+				}
+				meta["host"] = fmt.Sprintf("amqp://%s:%s/%s", hostname, port, strings.TrimPrefix(ep.option("vhost"), "/"))
+			}
+			return meta
+		},
+		authentication: map[string][]string{
+			"username": {"username"},
+			"password": {"password"},
+		},
+		required: []string{"host", "queueName"},
+	},
+	"google-pubsub": {
+		triggerType: "gcp-pubsub",
+		metadata: func(ep *componentEndpoint) map[string]string {
+			project, subscription, found := strings.Cut(ep.path(), ":")
+			if !found {
+				return nil
+			}
+			return map[string]string{
+				"subscriptionName": fmt.Sprintf("projects/%s/subscriptions/%s", project, subscription),
+			}
+		},
+		required: []string{"subscriptionName"},
+	},
+	"azure-servicebus": {
+		triggerType: "azure-servicebus",
+		metadata: func(ep *componentEndpoint) map[string]string {
+			if ep.option("serviceBusType") == "topic" {
+				subscription := ep.option("subscriptionName")
+				if subscription == "" {
+					return nil
+				}
+				return map[string]string{
+					"topicName":        ep.path(),
+					"subscriptionName": subscription,
+				}
+			}
+			if ep.path() == "" {
+				return nil
+			}
+			return map[string]string{
+				"queueName": ep.path(),
+			}
+		},
+		authentication: map[string][]string{
+			"connection": {"connectionString"},
+		},
+		authenticationRequired: true,
+	},
+	"cron": {
+		triggerType: "cron",
+		// The time window is set with the trait properties, as the schedule of the consumer cannot be mapped to a window
+		metadata: func(ep *componentEndpoint) map[string]string {
+			return map[string]string{
+				"desiredReplicas": "1",
+			}
+		},
+		required: []string{"start", "end", "timezone"},
+	},
+}
+
+// alwaysActiveComponents are the components consuming no external event source, that must always have a running replica.
+var alwaysActiveComponents = map[string]bool{
+	"cron":  true,
+	"timer": true,
+}
+
+// componentEndpoint is a consumer endpoint of the integration.
+type componentEndpoint struct {
+	uri        string
+	component  string
+	properties *integrationProperties
+}
+
+// path returns the context path of the endpoint URI.
+func (ep *componentEndpoint) path() string {
+	path := strings.TrimPrefix(ep.uri, ep.component+":")
+	path = strings.TrimPrefix(path, "//")
+	if i := strings.Index(path, "?"); i >= 0 {
+		path = path[:i]
+	}
+
+	return ep.properties.resolve(path)
+}
+
+// option returns the value of the first of the given options set on the endpoint URI or on the component.
+func (ep *componentEndpoint) option(names ...string) string {
+	for _, name := range names {
+		if v := ep.properties.resolve(uri.GetQueryParameter(ep.uri, name)); v != "" {
+			return v
+		}
+		for _, key := range ep.componentPropertyKeys(name) {
+			if v := ep.properties.values[key]; v != "" {
+				return v
+			}
+		}
+	}
+
+	return ""
+}
+
+// authentication returns the value of the first of the given options, or a reference to the mounted Secret holding it.
+func (ep *componentEndpoint) authentication(parameter string, names ...string) (string, *kedav1alpha1.AuthSecretTargetRef) {
+	for _, name := range names {
+		keys := ep.componentPropertyKeys(name)
+		if v := uri.GetQueryParameter(ep.uri, name); v != "" {
+			if m := placeholderRegexp.FindStringSubmatch(v); m != nil {
+				keys = append([]string{m[1]}, keys...)
+			} else {
+				return v, nil
+			}
+		}
+		for _, key := range keys {
+			if secret, ok := ep.properties.secrets[key]; ok {
+				return "", &kedav1alpha1.AuthSecretTargetRef{
+					Parameter: parameter,
+					Name:      secret,
+					Key:       key,
+				}
+			}
+			if v := ep.properties.values[key]; v != "" {
+				return v, nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+func (ep *componentEndpoint) componentPropertyKeys(name string) []string {
+	prefix := fmt.Sprintf("camel.component.%s.", ep.component)
+	return []string{prefix + toKebabCase(name), prefix + name}
+}
+
+// integrationProperties are the properties of the integration, along with the Secrets mounted with the mount trait.
+type integrationProperties struct {
+	values map[string]string
+	// secrets maps the properties to the mounted Secret holding them
+	secrets map[string]string
+}
+
+func newIntegrationProperties(e *trait.Environment) (*integrationProperties, error) {
+	p := &integrationProperties{
+		values:  make(map[string]string),
+		secrets: make(map[string]string),
+	}
+	for k, v := range e.ApplicationProperties {
+		p.values[k] = v
+	}
+	for _, c := range e.Integration.Spec.Configuration {
+		if c.Type != "property" {
+			continue
+		}
+		key, _ := property.SplitPropertyFileEntry(c.Value)
+		v, err := property.DecodePropertyFileValue(c.Value, key)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode property %q: %w", key, err)
+		}
+		p.values[key] = v
+	}
+
+	if e.Integration.Spec.Traits.Mount == nil {
+		return p, nil
+	}
+	for _, c := range e.Integration.Spec.Traits.Mount.Configs {
+		conf, err := utilResource.ParseConfig(c)
+		if err != nil || conf.StorageType() != utilResource.StorageTypeSecret {
+			continue
+		}
+		secret := v1.Secret{}
+		key := ctrl.ObjectKey{
+			Namespace: e.Integration.Namespace,
+			Name:      conf.Name(),
+		}
+		if err := e.Client.Get(e.Ctx, key, &secret); err != nil {
+			return nil, fmt.Errorf("could not load secret named %q in namespace %q: %w", conf.Name(), e.Integration.Namespace, err)
+		}
+		for k, v := range secret.Data {
+			if conf.Key() != "" && conf.Key() != k {
+				continue
+			}
+			p.values[k] = string(v)
+			p.secrets[k] = secret.Name
+		}
+	}
+
+	return p, nil
+}
+
+// resolve replaces a property placeholder, e.g. `{{my.property:default}}`, with the value of the property.
+func (p *integrationProperties) resolve(value string) string {
+	m := placeholderRegexp.FindStringSubmatch(value)
+	if m == nil {
+		return value
+	}
+	if v, ok := p.values[m[1]]; ok {
+		return v
+	}
+
+	return m[2]
+}
+
+// overrides returns the metadata of the given trigger type set with the integration properties.
+func (p *integrationProperties) overrides(triggerType string) map[string]string {
+	prefix := componentPropertyPrefix + triggerType + "."
+	res := make(map[string]string)
+	for k, v := range p.values {
+		if strings.HasPrefix(k, prefix) {
+			res[strings.TrimPrefix(k, prefix)] = v
+		}
+	}
+
+	return res
+}
+
+func (t *kedaTrait) populateTriggersFromComponents(e *trait.Environment) error {
+	sources, err := kubernetes.ResolveIntegrationSources(e.Ctx, e.Client, e.Integration, e.Resources)
+	if err != nil {
+		return err
+	}
+	var uris []string
+	if err := metadata.Each(e.CamelCatalog, sources, func(i int, meta metadata.IntegrationMetadata) bool {
+		// The endpoints of the Kamelets are scaled on with the Kamelet KEDA metadata
+		if sources[i].FromKamelet {
+			return true
+		}
+		uris = append(uris, meta.FromURIs...)
+		return true
+	}); err != nil {
+		return err
+	}
+	if len(uris) == 0 {
+		return nil
+	}
+	sort.Strings(uris)
+
+	properties, err := newIntegrationProperties(e)
+	if err != nil {
+		return err
+	}
+	alwaysActive := false
+	for _, endpointURI := range uris {
+		component := uri.GetComponent(endpointURI)
+		trigger, ok := t.triggerForComponent(&componentEndpoint{
+			uri:        endpointURI,
+			component:  component,
+			properties: properties,
+		})
+		if ok {
+			t.Triggers = append(t.Triggers, trigger)
+		} else if alwaysActiveComponents[component] {
+			alwaysActive = true
+		}
+	}
+
+	// Prevent KEDA from scaling the integration down to zero, that would stop its timers
+	if alwaysActive && len(t.Triggers) > 0 && t.MinReplicaCount == nil {
+		minReplicas := int32(1)
+		t.MinReplicaCount = &minReplicas
+	}
+
+	return nil
+}
+
+func (t *kedaTrait) triggerForComponent(ep *componentEndpoint) (kedaTrigger, bool) {
+	scaler, ok := componentScalers[ep.component]
+	if !ok {
+		return kedaTrigger{}, false
+	}
+	meta := scaler.metadata(ep)
+	if meta == nil {
+		return kedaTrigger{}, false
+	}
+	for k, v := range t.componentMetadata(scaler.triggerType) {
+		meta[k] = v
+	}
+	for k, v := range ep.properties.overrides(scaler.triggerType) {
+		meta[k] = v
+	}
+	for k, v := range meta {
+		if v == "" {
+			delete(meta, k)
+		}
+	}
+	for _, req := range scaler.required {
+		if _, ok := meta[req]; !ok {
+			t.L.Infof("Skipping KEDA %s trigger for endpoint %q: metadata parameter %q is missing", scaler.triggerType, ep.uri, req)
+			return kedaTrigger{}, false
+		}
+	}
+
+	trigger := kedaTrigger{
+		Type:     scaler.triggerType,
+		Metadata: meta,
+	}
+	parameters := make([]string, 0, len(scaler.authentication))
+	for parameter := range scaler.authentication {
+		parameters = append(parameters, parameter)
+	}
+	sort.Strings(parameters)
+	for _, parameter := range parameters {
+		value, ref := ep.authentication(parameter, scaler.authentication[parameter]...)
+		switch {
+		case ref != nil:
+			trigger.authenticationRefs = append(trigger.authenticationRefs, *ref)
+		case value != "":
+			if trigger.authentication == nil {
+				trigger.authentication = make(map[string]string)
+			}
+			trigger.authentication[parameter] = value
+		}
+	}
+	if scaler.authenticationRequired && len(trigger.authentication) == 0 && len(trigger.authenticationRefs) == 0 {
+		t.L.Infof("Skipping KEDA %s trigger for endpoint %q: no authentication parameter is provided", scaler.triggerType, ep.uri)
+		return kedaTrigger{}, false
+	}
+
+	return trigger, true
+}
+
+// componentMetadata returns the metadata of the given trigger type set with the trait properties.
+func (t *kedaTrait) componentMetadata(triggerType string) map[string]string {
+	if triggerType != "cron" {
+		return nil
+	}
+
+	return map[string]string{
+		"start":    t.CronStart,
+		"end":      t.CronEnd,
+		"timezone": t.CronTimezone,
+	}
+}
+
+func toKebabCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteRune('-')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
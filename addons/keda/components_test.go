@@ -0,0 +1,295 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keda
+
+import (
+	"testing"
+
+	"github.com/apache/camel-k/v2/addons/keda/duck/v1alpha1"
+	camelv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/util/uri"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestKafkaComponentAutoDetection(t *testing.T) {
+	keda, _ := NewKedaTrait().(*kedaTrait)
+	keda.Enabled = pointer.Bool(true)
+	env := createBasicTestEnvironment(newComponentIntegration(""+
+		"- from:\n"+
+		"    uri: kafka:orders?brokers={{kafka.brokers}}\n"+
+		"    steps:\n"+
+		"    - to: log:info\n",
+		"kafka.brokers=my-cluster-kafka-bootstrap:9092",
+		"camel.component.kafka.group-id=my-group",
+	))
+
+	res, _, err := keda.Configure(env)
+	require.NoError(t, err)
+	assert.True(t, res)
+	require.NoError(t, keda.Apply(env))
+	so := getScaledObject(env)
+	require.NotNil(t, so)
+	require.Len(t, so.Spec.Triggers, 1)
+	assert.Equal(t, "kafka", so.Spec.Triggers[0].Type)
+	assert.Equal(t, map[string]string{
+		"topic":            "orders",
+		"bootstrapServers": "my-cluster-kafka-bootstrap:9092",
+		"consumerGroup":    "my-group",
+	}, so.Spec.Triggers[0].Metadata)
+	assert.Nil(t, so.Spec.Triggers[0].AuthenticationRef)
+	assert.Nil(t, so.Spec.MinReplicaCount)
+}
+
+func TestSQSComponentAuthenticationFromMountedSecret(t *testing.T) {
+	keda, _ := NewKedaTrait().(*kedaTrait)
+	keda.Enabled = pointer.Bool(true)
+	it := newComponentIntegration("" +
+		"- from:\n" +
+		"    uri: aws2-sqs:my-queue?region=eu-west-1&accessKey=my-access-key\n" +
+		"    steps:\n" +
+		"    - to: log:info\n")
+	it.Spec.Traits.Mount = &traitv1.MountTrait{
+		Configs: []string{"secret:aws-credentials"},
+	}
+	env := createBasicTestEnvironment(it, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "aws-credentials",
+		},
+		Data: map[string][]byte{
+			"camel.component.aws2-sqs.secret-key": []byte("my-secret-key"),
+		},
+	})
+
+	res, _, err := keda.Configure(env)
+	require.NoError(t, err)
+	assert.True(t, res)
+	require.NoError(t, keda.Apply(env))
+	so := getScaledObject(env)
+	require.NotNil(t, so)
+	require.Len(t, so.Spec.Triggers, 1)
+	assert.Equal(t, "aws-sqs-queue", so.Spec.Triggers[0].Type)
+	assert.Equal(t, map[string]string{
+		"queueURL":  "my-queue",
+		"awsRegion": "eu-west-1",
+	}, so.Spec.Triggers[0].Metadata)
+
+	triggerAuth := getTriggerAuthentication(env)
+	require.NotNil(t, triggerAuth)
+	assert.Equal(t, so.Spec.Triggers[0].AuthenticationRef.Name, triggerAuth.Name)
+	assert.Equal(t, []v1alpha1.AuthSecretTargetRef{
+		{Parameter: "awsAccessKeyID", Name: triggerAuth.Name, Key: "awsAccessKeyID"},
+		{Parameter: "awsSecretAccessKey", Name: "aws-credentials", Key: "camel.component.aws2-sqs.secret-key"},
+	}, triggerAuth.Spec.SecretTargetRef)
+	secret := getSecret(env)
+	require.NotNil(t, secret)
+	assert.Equal(t, map[string]string{"awsAccessKeyID": "my-access-key"}, secret.StringData)
+}
+
+func TestJMSComponentRequiresManagementEndpoint(t *testing.T) {
+	source := "" +
+		"- from:\n" +
+		"    uri: jms:queue:orders\n" +
+		"    steps:\n" +
+		"    - to: log:info\n"
+
+	keda, _ := NewKedaTrait().(*kedaTrait)
+	keda.Enabled = pointer.Bool(true)
+	env := createBasicTestEnvironment(newComponentIntegration(source))
+	res, _, err := keda.Configure(env)
+	require.NoError(t, err)
+	assert.False(t, res)
+
+	keda, _ = NewKedaTrait().(*kedaTrait)
+	keda.Enabled = pointer.Bool(true)
+	env = createBasicTestEnvironment(newComponentIntegration(source,
+		"camel.k.keda.artemis-queue.managementEndpoint=artemis-hdls-svc.test:8161",
+		"camel.k.keda.artemis-queue.brokerName=artemis",
+	))
+	res, _, err = keda.Configure(env)
+	require.NoError(t, err)
+	assert.True(t, res)
+	require.Len(t, keda.Triggers, 1)
+	assert.Equal(t, "artemis-queue", keda.Triggers[0].Type)
+	assert.Equal(t, map[string]string{
+		"managementEndpoint": "artemis-hdls-svc.test:8161",
+		"brokerName":         "artemis",
+		"brokerAddress":      "orders",
+		"queueName":          "orders",
+	}, keda.Triggers[0].Metadata)
+}
+
+func TestTimerComponentKeepsOneReplica(t *testing.T) {
+	keda, _ := NewKedaTrait().(*kedaTrait)
+	keda.Enabled = pointer.Bool(true)
+	env := createBasicTestEnvironment(newComponentIntegration("" +
+		"- from:\n" +
+		"    uri: timer:tick\n" +
+		"    steps:\n" +
+		"    - to: log:info\n" +
+		"- from:\n" +
+		"    uri: kafka:orders?brokers=kafka:9092&groupId=my-group\n" +
+		"    steps:\n" +
+		"    - to: log:info\n",
+	))
+
+	res, _, err := keda.Configure(env)
+	require.NoError(t, err)
+	assert.True(t, res)
+	require.NoError(t, keda.Apply(env))
+	so := getScaledObject(env)
+	require.NotNil(t, so)
+	assert.Len(t, so.Spec.Triggers, 1)
+	assert.Equal(t, pointer.Int32(1), so.Spec.MinReplicaCount)
+}
+
+func TestComponentTriggers(t *testing.T) {
+	tests := []struct {
+		uri        string
+		properties map[string]string
+		configure  func(keda *kedaTrait)
+		trigger    string
+		metadata   map[string]string
+		auth       map[string]string
+	}{
+		{
+			uri:      "cron:tab?schedule=0+0/5+8-18+*+*+?",
+			trigger:  "cron",
+			metadata: map[string]string{"timezone": "Europe/Rome", "start": "0 8 * * *", "end": "0 19 * * *", "desiredReplicas": "1"},
+			configure: func(keda *kedaTrait) {
+				keda.CronStart = "0 8 * * *"
+				keda.CronEnd = "0 19 * * *"
+				keda.CronTimezone = "Europe/Rome"
+			},
+		},
+		{
+			uri: "cron:tab?schedule=0+0/5+8-18+*+*+?",
+			properties: map[string]string{
+				"camel.k.keda.cron.end": "0 19 * * *",
+			},
+		},
+		{
+			uri:      "google-pubsub:my-project:my-subscription",
+			trigger:  "gcp-pubsub",
+			metadata: map[string]string{"subscriptionName": "projects/my-project/subscriptions/my-subscription"},
+		},
+		{
+			uri:      "azure-servicebus:orders?serviceBusType=topic&subscriptionName=sub&connectionString=RAW(Endpoint=sb://ns)",
+			trigger:  "azure-servicebus",
+			metadata: map[string]string{"topicName": "orders", "subscriptionName": "sub"},
+			auth:     map[string]string{"connection": "RAW(Endpoint=sb://ns)"},
+		},
+		{
+			uri:      "azure-servicebus:orders",
+			trigger:  "azure-servicebus",
+			metadata: map[string]string{"queueName": "orders"},
+			auth:     map[string]string{"connection": "Endpoint=sb://ns"},
+			properties: map[string]string{
+				"camel.component.azure-servicebus.connection-string": "Endpoint=sb://ns",
+			},
+		},
+		{
+			uri: "azure-servicebus:orders",
+		},
+		{
+			uri: "azure-servicebus:orders?serviceBusType=topic&connectionString=RAW(Endpoint=sb://ns)",
+		},
+		{
+			uri:      "rabbitmq:exchange?queue=orders&hostname=rabbitmq&vhost=shop",
+			trigger:  "rabbitmq",
+			metadata: map[string]string{"host": "amqp://rabbitmq:5672/shop", "queueName": "orders", "mode": "QueueLength", "value": "20"},
+			auth:     map[string]string{"username": "guest", "password": "guest"},
+			properties: map[string]string{
+				"camel.component.rabbitmq.username": "guest",
+				"camel.component.rabbitmq.password": "guest",
+			},
+		},
+		{
+			uri: "jms:topic:orders",
+		},
+		{
+			uri: "cron:tab?schedule=0/5+*+*+*+*+?",
+		},
+		{
+			uri: "log:info",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.uri, func(t *testing.T) {
+			keda, _ := NewKedaTrait().(*kedaTrait)
+			if test.configure != nil {
+				test.configure(keda)
+			}
+			properties := &integrationProperties{values: map[string]string{}, secrets: map[string]string{}}
+			for k, v := range test.properties {
+				properties.values[k] = v
+			}
+			trigger, ok := keda.triggerForComponent(&componentEndpoint{
+				uri:        test.uri,
+				component:  uri.GetComponent(test.uri),
+				properties: properties,
+			})
+			if test.trigger == "" {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, test.trigger, trigger.Type)
+			assert.Equal(t, test.metadata, trigger.Metadata)
+			assert.Equal(t, test.auth, trigger.authentication)
+		})
+	}
+}
+
+func newComponentIntegration(source string, properties ...string) *camelv1.Integration {
+	it := camelv1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "my-it",
+		},
+		Spec: camelv1.IntegrationSpec{
+			Sources: []camelv1.SourceSpec{
+				{
+					DataSpec: camelv1.DataSpec{
+						Name:    "my-it.yaml",
+						Content: source,
+					},
+					Language: camelv1.LanguageYaml,
+				},
+			},
+		},
+		Status: camelv1.IntegrationStatus{
+			Phase: camelv1.IntegrationPhaseDeploying,
+		},
+	}
+	for _, p := range properties {
+		it.Spec.Configuration = append(it.Spec.Configuration, camelv1.ConfigurationSpec{
+			Type:  "property",
+			Value: p,
+		})
+	}
+
+	return &it
+}
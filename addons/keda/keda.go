@@ -60,6 +60,9 @@ const (
 	kameletAnnotationMetadataPrefix = "camel.apache.org/keda.metadata."
 	// kameletAnnotationAuthenticationPrefix is used to define virtual authentication fields computed from Kamelet properties.
 	kameletAnnotationAuthenticationPrefix = "camel.apache.org/keda.authentication."
+
+	// pausedReplicasAnnotation pauses the autoscaling of a ScaledObject at the given replicas.
+	pausedReplicasAnnotation = "autoscaling.keda.sh/paused-replicas"
 )
 
 // The KEDA trait can be used for automatic integration with KEDA autoscalers.
@@ -71,13 +74,26 @@ const (
 // If you want to create Kamelets that contain KEDA metadata, refer to
 // xref:ROOT:kamelets/kamelets-dev.adoc#kamelet-keda-dev[the KEDA section in the Kamelets development guide].
 //
+// WARNING: The KEDA trait cannot be used together with the xref:traits:hpa.adoc[HPA trait] or the xref:traits:idle.adoc[Idle trait].
+//
 // The KEDA trait is disabled by default.
 //
 // +camel-k:trait=keda.
 type Trait struct {
 	traitv1.Trait `property:",squash" json:",inline"`
-	// Enables automatic configuration of the trait. Allows the trait to infer KEDA triggers from the Kamelets.
+	// Enables automatic configuration of the trait. Allows the trait to infer KEDA triggers from the Kamelets,
+	// and from the endpoints of the integration.
 	Auto *bool `property:"auto" json:"auto,omitempty"`
+	// The start of the time window during which the integration is scaled up by the trigger inferred from its `cron`
+	// consumers, as a standard cron expression, e.g. `0 8 * * *`. No trigger is inferred from the `cron` consumers,
+	// unless the start, the end and the timezone of the window are set.
+	CronStart string `property:"cron-start" json:"cronStart,omitempty"`
+	// The end of the time window during which the integration is scaled up by the trigger inferred from its `cron`
+	// consumers, as a standard cron expression, e.g. `0 18 * * *`.
+	CronEnd string `property:"cron-end" json:"cronEnd,omitempty"`
+	// The timezone of the time window during which the integration is scaled up by the trigger inferred from its `cron`
+	// consumers, as a name of the IANA Time Zone Database, e.g. `Europe/Rome`.
+	CronTimezone string `property:"cron-timezone" json:"cronTimezone,omitempty"`
 	// Set the spec->replicas field on the top level controller to an explicit value if missing, to allow KEDA to recognize it as a scalable resource.
 	HackControllerReplicas *bool `property:"hack-controller-replicas" json:"hackControllerReplicas,omitempty"`
 	// Interval (seconds) to check each trigger on.
@@ -107,6 +123,7 @@ type kedaTrigger struct {
 	Metadata             map[string]string `property:"metadata" json:"metadata,omitempty"`
 	AuthenticationSecret string            `property:"authentication-secret" json:"authenticationSecret,omitempty"`
 	authentication       map[string]string
+	authenticationRefs   []kedav1alpha1.AuthSecretTargetRef
 }
 
 // NewKedaTrait --.
@@ -120,6 +137,12 @@ func (t *kedaTrait) Configure(e *trait.Environment) (bool, *trait.TraitCondition
 	if e.Integration == nil || !pointer.BoolDeref(t.Enabled, false) {
 		return false, nil, nil
 	}
+	if e.GetTrait("hpa") != nil {
+		return false, nil, errors.New("the keda trait cannot be enabled together with the hpa trait")
+	}
+	if e.GetTrait("idle") != nil {
+		return false, nil, errors.New("the keda trait cannot be enabled together with the idle trait")
+	}
 	if e.CamelCatalog == nil {
 		return false, trait.NewIntegrationConditionPlatformDisabledCatalogMissing(), nil
 	}
@@ -130,6 +153,9 @@ func (t *kedaTrait) Configure(e *trait.Environment) (bool, *trait.TraitCondition
 		if err := t.populateTriggersFromKamelets(e); err != nil {
 			return false, nil, err
 		}
+		if err := t.populateTriggersFromComponents(e); err != nil {
+			return false, nil, err
+		}
 	}
 
 	return len(t.Triggers) > 0, nil, nil
@@ -174,32 +200,40 @@ func (t *kedaTrait) addScalingResources(e *trait.Environment) error {
 	if t.MaxReplicaCount != nil {
 		obj.Spec.MaxReplicaCount = t.MaxReplicaCount
 	}
+	if e.Integration.IsInactive() {
+		// Prevent KEDA from scaling the suspended Integration back up
+		obj.Annotations = map[string]string{
+			pausedReplicasAnnotation: "0",
+		}
+	}
 	for idx, trigger := range t.Triggers {
 		meta := make(map[string]string)
 		for k, v := range trigger.Metadata {
 			meta[k] = v
 		}
 		var authenticationRef *kedav1alpha1.ScaledObjectAuthRef
-		if len(trigger.authentication) > 0 && trigger.AuthenticationSecret != "" {
+		if (len(trigger.authentication) > 0 || len(trigger.authenticationRefs) > 0) && trigger.AuthenticationSecret != "" {
 			return errors.New("an authentication secret cannot be provided for auto-configured triggers")
 		}
 		extConfigName := fmt.Sprintf("%s-keda-%d", e.Integration.Name, idx)
-		if len(trigger.authentication) > 0 {
-			// Save all authentication config in a secret
-			secret := v1.Secret{
-				TypeMeta: metav1.TypeMeta{
-					Kind:       "Secret",
-					APIVersion: v1.SchemeGroupVersion.String(),
-				},
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: e.Integration.Namespace,
-					Name:      extConfigName,
-				},
-				StringData: trigger.authentication,
+		if len(trigger.authentication) > 0 || len(trigger.authenticationRefs) > 0 {
+			if len(trigger.authentication) > 0 {
+				// Save all authentication config in a secret
+				secret := v1.Secret{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Secret",
+						APIVersion: v1.SchemeGroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: e.Integration.Namespace,
+						Name:      extConfigName,
+					},
+					StringData: trigger.authentication,
+				}
+				e.Resources.Add(&secret)
 			}
-			e.Resources.Add(&secret)
 
-			// Link the secret using a TriggerAuthentication
+			// Link the secrets using a TriggerAuthentication
 			triggerAuth := kedav1alpha1.TriggerAuthentication{
 				TypeMeta: metav1.TypeMeta{
 					Kind:       "TriggerAuthentication",
@@ -217,6 +251,7 @@ func (t *kedaTrait) addScalingResources(e *trait.Environment) error {
 					Key:       k,
 				})
 			}
+			triggerAuth.Spec.SecretTargetRef = append(triggerAuth.Spec.SecretTargetRef, trigger.authenticationRefs...)
 			e.Resources.Add(&triggerAuth)
 			authenticationRef = &kedav1alpha1.ScaledObjectAuthRef{
 				Name: extConfigName,
@@ -68,6 +68,57 @@ func TestManualConfig(t *testing.T) {
 	assert.Nil(t, getSecret(env))
 }
 
+func TestSuspendedIntegration(t *testing.T) {
+	keda, _ := NewKedaTrait().(*kedaTrait)
+	keda.Enabled = pointer.Bool(true)
+	keda.Auto = pointer.Bool(false)
+	keda.Triggers = append(keda.Triggers, kedaTrigger{
+		Type: "mytype",
+	})
+	env := createBasicTestEnvironment()
+	env.Integration.Annotations = map[string]string{
+		camelv1.IntegrationSuspendedAnnotation: "true",
+	}
+
+	res, _, err := keda.Configure(env)
+	require.NoError(t, err)
+	assert.True(t, res)
+	require.NoError(t, keda.Apply(env))
+	so := getScaledObject(env)
+	require.NotNil(t, so)
+	assert.Equal(t, "0", so.Annotations[pausedReplicasAnnotation])
+}
+
+func TestHPAExclusion(t *testing.T) {
+	keda, _ := NewKedaTrait().(*kedaTrait)
+	keda.Enabled = pointer.Bool(true)
+	keda.Auto = pointer.Bool(false)
+	keda.Triggers = append(keda.Triggers, kedaTrigger{
+		Type: "mytype",
+	})
+	env := createBasicTestEnvironment()
+	env.ExecutedTraits = append(env.ExecutedTraits, env.Catalog.GetTrait("hpa"))
+
+	res, _, err := keda.Configure(env)
+	require.EqualError(t, err, "the keda trait cannot be enabled together with the hpa trait")
+	assert.False(t, res)
+}
+
+func TestIdleExclusion(t *testing.T) {
+	keda, _ := NewKedaTrait().(*kedaTrait)
+	keda.Enabled = pointer.Bool(true)
+	keda.Auto = pointer.Bool(false)
+	keda.Triggers = append(keda.Triggers, kedaTrigger{
+		Type: "mytype",
+	})
+	env := createBasicTestEnvironment()
+	env.ExecutedTraits = append(env.ExecutedTraits, env.Catalog.GetTrait("idle"))
+
+	res, _, err := keda.Configure(env)
+	require.EqualError(t, err, "the keda trait cannot be enabled together with the idle trait")
+	assert.False(t, res)
+}
+
 func TestConfigFromSecret(t *testing.T) {
 	keda, _ := NewKedaTrait().(*kedaTrait)
 	keda.Enabled = pointer.Bool(true)
@@ -211,6 +211,8 @@ type BuildStatus struct {
 	Image string `json:"image,omitempty"`
 	// the digest from image
 	Digest string `json:"digest,omitempty"`
+	// the digest of the image built for each requested platform
+	ImagePlatforms []ImagePlatform `json:"imagePlatforms,omitempty"`
 	// root image (the first image from which the incremental image has started)
 	RootImage string `json:"rootImage,omitempty"`
 	// the base image used for this build
@@ -229,6 +231,26 @@ type BuildStatus struct {
 	// Change to Duration / ISO 8601 when CRD uses OpenAPI spec v3
 	// https://github.com/OAI/OpenAPI-Specification/issues/845
 	Duration string `json:"duration,omitempty"`
+	// the archived log of the build (if any)
+	Log *BuildLog `json:"log,omitempty"`
+	// the usage of the Maven cache by the build (if any)
+	MavenCache *MavenCacheStatus `json:"mavenCache,omitempty"`
+}
+
+// BuildLog references the compressed log archived when the build completes.
+type BuildLog struct {
+	// the ConfigMaps holding the log chunks, in order
+	ConfigMaps []string `json:"configMaps,omitempty"`
+	// the compression algorithm of the log chunks
+	Compression string `json:"compression,omitempty"`
+	// the size of the uncompressed log in bytes
+	Size int64 `json:"size,omitempty"`
+}
+
+// MavenCacheStatus reports the usage of the Maven cache by a build.
+type MavenCacheStatus struct {
+	// the number of Maven artifacts missing from the cache, and downloaded by the build
+	Downloaded int `json:"downloaded"`
 }
 
 // BuildPhase -- .
@@ -259,6 +281,10 @@ const (
 	BuildPhaseInterrupted = "Interrupted"
 	// BuildPhaseError -- .
 	BuildPhaseError BuildPhase = "Error"
+	// BuildPhaseCancelling -- the Build has been requested to stop.
+	BuildPhaseCancelling BuildPhase = "Cancelling"
+	// BuildPhaseCancelled -- .
+	BuildPhaseCancelled BuildPhase = "Cancelled"
 
 	// BuildConditionScheduled --.
 	BuildConditionScheduled BuildConditionType = "Scheduled"
@@ -22,6 +22,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// BuildLabel labels the resources related to a Build.
+const BuildLabel = "camel.apache.org/build"
+
 func NewBuild(namespace string, name string) *Build {
 	return &Build{
 		TypeMeta: metav1.TypeMeta{
@@ -108,6 +111,30 @@ func ConfigurationTasksByName(tasks []Task, name string) *BuildConfiguration {
 	return &BuildConfiguration{}
 }
 
+// TaskName returns the name of the task.
+func TaskName(t Task) string {
+	switch {
+	case t.Builder != nil:
+		return t.Builder.Name
+	case t.Custom != nil:
+		return t.Custom.Name
+	case t.Package != nil:
+		return t.Package.Name
+	case t.Buildah != nil:
+		return t.Buildah.Name
+	case t.Kaniko != nil:
+		return t.Kaniko.Name
+	case t.Spectrum != nil:
+		return t.Spectrum.Name
+	case t.S2i != nil:
+		return t.S2i.Name
+	case t.Jib != nil:
+		return t.Jib.Name
+	}
+
+	return ""
+}
+
 // SetBuilderConfigurationTasks set the configuration required for the builder in the list of tasks.
 func SetBuilderConfigurationTasks(tasks []Task, conf *BuildConfiguration) {
 	for _, t := range tasks {
@@ -141,7 +168,8 @@ func (in *BuildStatus) Failed(err error) BuildStatus {
 
 func (in *BuildStatus) IsFinished() bool {
 	return in.Phase == BuildPhaseSucceeded || in.Phase == BuildPhaseFailed ||
-		in.Phase == BuildPhaseInterrupted || in.Phase == BuildPhaseError
+		in.Phase == BuildPhaseInterrupted || in.Phase == BuildPhaseError ||
+		in.Phase == BuildPhaseCancelled
 }
 
 func (in *BuildStatus) SetCondition(condType BuildConditionType, status corev1.ConditionStatus, reason string, message string) {
@@ -242,7 +270,8 @@ func (c BuildCondition) GetMessage() string {
 
 func (bl BuildList) HasRunningBuilds() bool {
 	for _, b := range bl.Items {
-		if b.Status.Phase == BuildPhasePending || b.Status.Phase == BuildPhaseRunning {
+		// A cancelling Build runs until it is actually interrupted
+		if b.Status.Phase == BuildPhasePending || b.Status.Phase == BuildPhaseRunning || b.Status.Phase == BuildPhaseCancelling {
 			return true
 		}
 	}
@@ -274,7 +303,7 @@ func (bl BuildList) HasMatchingBuild(build *Build) (bool, *Build) {
 	}
 
 	for _, b := range bl.Items {
-		if b.Name == build.Name || b.Status.IsFinished() {
+		if b.Name == build.Name || b.Status.IsFinished() || b.Status.Phase == BuildPhaseCancelling {
 			continue
 		}
 
@@ -66,7 +66,7 @@ type BuildConfiguration struct {
 // BuildStrategy specifies how the Build should be executed.
 // It will trigger a Maven process (either as an Operator routine or Kubernetes Pod execution) that
 // will take care of producing the expected Camel/Camel-Quarkus runtime.
-// +kubebuilder:validation:Enum=routine;pod
+// +kubebuilder:validation:Enum=routine;pod;external;tekton
 type BuildStrategy string
 
 const (
@@ -80,6 +80,12 @@ const (
 	// mitigated by the presence of a Maven proxy.
 	// Available for both Quarkus JVM and Native mode.
 	BuildStrategyPod BuildStrategy = "pod"
+	// BuildStrategyExternal delegates the build to an external build service, configured in the IntegrationPlatform, which is
+	// handed the build tasks over the external builder protocol. The operator polls the service to report the status of the build.
+	BuildStrategyExternal BuildStrategy = "external"
+	// BuildStrategyTekton performs the build in a Tekton `PipelineRun`, each task of the build being executed as a step of a Tekton `Task`.
+	// This strategy requires Tekton Pipelines to be installed in the cluster.
+	BuildStrategyTekton BuildStrategy = "tekton"
 
 	// BuildOrderStrategyFIFO performs the builds with first in first out strategy based on the creation timestamp.
 	// The strategy allows builds to run in parallel to each other but oldest builds will be run first.
@@ -96,6 +102,8 @@ const (
 var BuildStrategies = []BuildStrategy{
 	BuildStrategyRoutine,
 	BuildStrategyPod,
+	BuildStrategyExternal,
+	BuildStrategyTekton,
 }
 
 // BuildOrderStrategy specifies how builds are reconciled and queued.
@@ -135,6 +143,14 @@ type Artifact struct {
 	Checksum string `json:"checksum,omitempty" yaml:"checksum,omitempty"`
 }
 
+// ImagePlatform represents the image available for a given platform.
+type ImagePlatform struct {
+	// the platform, e.g. `linux/arm64`
+	Platform string `json:"platform"`
+	// the digest of the image for the platform
+	Digest string `json:"digest"`
+}
+
 // Failure represent a message specifying the reason and the time of an event failure.
 type Failure struct {
 	// a short text specifying the reason
@@ -199,6 +215,10 @@ type Traits struct {
 	GC *trait.GCTrait `property:"gc" json:"gc,omitempty"`
 	// The configuration of Health trait
 	Health *trait.HealthTrait `property:"health" json:"health,omitempty"`
+	// The configuration of HPA trait
+	HPA *trait.HPATrait `property:"hpa" json:"hpa,omitempty"`
+	// The configuration of Idle trait
+	Idle *trait.IdleTrait `property:"idle" json:"idle,omitempty"`
 	// The configuration of Ingress trait
 	Ingress *trait.IngressTrait `property:"ingress" json:"ingress,omitempty"`
 	// The configuration of Istio trait
@@ -238,6 +258,8 @@ type Traits struct {
 	Registry *trait.RegistryTrait `property:"registry" json:"registry,omitempty"`
 	// The configuration of Route trait
 	Route *trait.RouteTrait `property:"route" json:"route,omitempty"`
+	// The configuration of Schedule trait
+	Schedule *trait.ScheduleTrait `property:"schedule" json:"schedule,omitempty"`
 	// The configuration of Security Context trait
 	SecurityContext *trait.SecurityContextTrait `property:"security-context" json:"security-context,omitempty"`
 	// The configuration of Service trait
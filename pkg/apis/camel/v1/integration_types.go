@@ -121,6 +121,30 @@ type IntegrationStatus struct {
 	Capabilities []string `json:"capabilities,omitempty"`
 	// the timestamp representing the last time when this integration was initialized.
 	InitializationTimestamp *metav1.Time `json:"lastInitTimestamp,omitempty"`
+	// the Camel runtime metrics, aggregated over the running Pods (requires the prometheus trait)
+	Metrics *IntegrationRuntimeMetrics `json:"metrics,omitempty"`
+	// the next time the Integration is suspended or resumed by the schedule trait
+	NextScheduledTransition *metav1.Time `json:"nextScheduledTransition,omitempty"`
+	// the most recent generation of the Integration recorded by the operator audit trail
+	AuditedGeneration int64 `json:"auditedGeneration,omitempty"`
+}
+
+// IntegrationRuntimeMetrics contains the Camel runtime metrics of an Integration, aggregated over its running Pods.
+type IntegrationRuntimeMetrics struct {
+	// the last time the metrics were collected with changes
+	CollectedAt *metav1.Time `json:"collectedAt,omitempty"`
+	// the number of Pods the metrics were collected from
+	Pods int32 `json:"pods,omitempty"`
+	// the total number of exchanges processed
+	ExchangesTotal int64 `json:"exchangesTotal,omitempty"`
+	// the number of failed exchanges
+	ExchangesFailed int64 `json:"exchangesFailed,omitempty"`
+	// the number of exchanges being processed
+	ExchangesInflight int64 `json:"exchangesInflight,omitempty"`
+	// the mean processing time of the exchanges
+	MeanProcessingTime *metav1.Duration `json:"meanProcessingTime,omitempty"`
+	// the last time exchanges were observed to be processed
+	LastActivity *metav1.Time `json:"lastActivity,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -156,6 +180,8 @@ const (
 	IntegrationPhaseRunning IntegrationPhase = "Running"
 	// IntegrationPhaseError --.
 	IntegrationPhaseError IntegrationPhase = "Error"
+	// IntegrationPhaseSuspended --.
+	IntegrationPhaseSuspended IntegrationPhase = "Suspended"
 
 	// IntegrationConditionReady --.
 	IntegrationConditionReady IntegrationConditionType = "Ready"
@@ -183,6 +209,14 @@ const (
 	IntegrationConditionProbesAvailable IntegrationConditionType = "ProbesAvailable"
 	// IntegrationConditionTraitInfo --.
 	IntegrationConditionTraitInfo IntegrationConditionType = "TraitInfo"
+	// IntegrationConditionRoutesControlled reports the Camel routes stopped or suspended with the kamel route command.
+	IntegrationConditionRoutesControlled IntegrationConditionType = "RoutesControlled"
+	// IntegrationConditionIdle reports whether the Integration has been scaled down to zero by the idle trait.
+	IntegrationConditionIdle IntegrationConditionType = "Idle"
+	// IntegrationConditionScheduled --.
+	IntegrationConditionScheduled IntegrationConditionType = "Scheduled"
+	// IntegrationConditionPolicyCompliant reports whether the Integration complies with the IntegrationPolicies.
+	IntegrationConditionPolicyCompliant IntegrationConditionType = "PolicyCompliant"
 
 	// IntegrationConditionKitAvailableReason --.
 	IntegrationConditionKitAvailableReason string = "IntegrationKitAvailable"
@@ -242,6 +276,8 @@ const (
 	IntegrationConditionRuntimeNotReadyReason string = "RuntimeNotReady"
 	// IntegrationConditionErrorReason --.
 	IntegrationConditionErrorReason string = "Error"
+	// IntegrationConditionSuspendedReason --.
+	IntegrationConditionSuspendedReason string = "Suspended"
 	// IntegrationConditionInitializationFailedReason --.
 	IntegrationConditionInitializationFailedReason string = "InitializationFailed"
 	// IntegrationConditionUnsupportedLanguageReason --.
@@ -254,6 +290,22 @@ const (
 	IntegrationConditionKameletsNotAvailableReason string = "KameletsNotAvailable"
 	// IntegrationConditionImportingKindAvailableReason used (as false) if we're trying to import an unsupported kind.
 	IntegrationConditionImportingKindAvailableReason string = "ImportingKindAvailable"
+	// IntegrationConditionRoutesControlledReason --.
+	IntegrationConditionRoutesControlledReason string = "RoutesControlled"
+	// IntegrationConditionIdleReason --.
+	IntegrationConditionIdleReason string = "Idle"
+	// IntegrationConditionActiveReason --.
+	IntegrationConditionActiveReason string = "Active"
+	// IntegrationConditionInScheduleReason --.
+	IntegrationConditionInScheduleReason string = "InSchedule"
+	// IntegrationConditionOutOfScheduleReason --.
+	IntegrationConditionOutOfScheduleReason string = "OutOfSchedule"
+	// IntegrationConditionPolicyCompliantReason --.
+	IntegrationConditionPolicyCompliantReason string = "Compliant"
+	// IntegrationConditionPolicyMutatedReason --.
+	IntegrationConditionPolicyMutatedReason string = "Mutated"
+	// IntegrationConditionPolicyViolationReason --.
+	IntegrationConditionPolicyViolationReason string = "PolicyViolation"
 )
 
 // IntegrationCondition describes the state of a resource at a certain point.
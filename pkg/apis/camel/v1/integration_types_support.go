@@ -19,6 +19,7 @@ package v1
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
@@ -34,6 +35,16 @@ const IntegrationGenerationLabel = "camel.apache.org/generation"
 // IntegrationSyntheticLabel is used to tag k8s synthetic Integrations.
 const IntegrationSyntheticLabel = "camel.apache.org/is-synthetic"
 
+// IntegrationControlledRoutesAnnotation records the Camel routes stopped or suspended with the kamel route command,
+// as a comma separated list of route-id=state pairs.
+const IntegrationControlledRoutesAnnotation = "camel.apache.org/controlled-routes"
+
+// IntegrationSuspendedAnnotation is set to true to suspend an Integration, or a Pipe, with the kamel suspend command.
+const IntegrationSuspendedAnnotation = "camel.apache.org/suspended"
+
+// IntegrationChangedByAnnotation records the user that last changed an Integration with kamel, for the operator audit trail.
+const IntegrationChangedByAnnotation = "camel.apache.org/changed-by"
+
 // IntegrationImportedKindLabel specifies from what kind of resource an Integration was imported.
 const IntegrationImportedKindLabel = "camel.apache.org/imported-from-kind"
 
@@ -70,6 +81,8 @@ func (in *Integration) Initialize() {
 	in.Status = IntegrationStatus{
 		Phase:   IntegrationPhaseInitialization,
 		Profile: profile,
+		// The audited generation is kept, so that the changes are not recorded again in the audit trail
+		AuditedGeneration: in.Status.AuditedGeneration,
 	}
 }
 
@@ -291,6 +304,55 @@ func (in *Integration) IsSynthetic() bool {
 	return in.Annotations[IntegrationSyntheticLabel] == "true"
 }
 
+// IsSuspended returns true when the Integration is requested to be suspended.
+func (in *Integration) IsSuspended() bool {
+	return in.Annotations[IntegrationSuspendedAnnotation] == "true"
+}
+
+// IsOutOfSchedule returns true when the schedule trait reports the Integration out of its active windows.
+func (in *Integration) IsOutOfSchedule() bool {
+	cond := in.Status.GetCondition(IntegrationConditionScheduled)
+	return cond != nil && cond.Status == corev1.ConditionFalse
+}
+
+// IsInactive returns true when the Integration workloads must be scaled down, either because
+// the Integration is suspended or because it is out of schedule.
+func (in *Integration) IsInactive() bool {
+	return in.IsSuspended() || in.IsOutOfSchedule()
+}
+
+// GetControlledRoutes returns the state of the Camel routes stopped or suspended with the kamel route command, by route id.
+func (in *Integration) GetControlledRoutes() map[string]string {
+	routes := make(map[string]string)
+	for _, pair := range strings.Split(in.Annotations[IntegrationControlledRoutesAnnotation], ",") {
+		if id, state, ok := strings.Cut(strings.TrimSpace(pair), "="); ok && id != "" {
+			routes[id] = state
+		}
+	}
+	return routes
+}
+
+// SetControlledRoutes records the state of the Camel routes stopped or suspended with the kamel route command.
+func (in *Integration) SetControlledRoutes(routes map[string]string) {
+	if len(routes) == 0 {
+		delete(in.Annotations, IntegrationControlledRoutesAnnotation)
+		return
+	}
+	ids := make([]string, 0, len(routes))
+	for id := range routes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	pairs := make([]string, len(ids))
+	for i, id := range ids {
+		pairs[i] = id + "=" + routes[id]
+	}
+	if in.Annotations == nil {
+		in.Annotations = make(map[string]string)
+	}
+	in.Annotations[IntegrationControlledRoutesAnnotation] = strings.Join(pairs, ",")
+}
+
 // GetCondition returns the condition with the provided type.
 func (in *IntegrationStatus) GetCondition(condType IntegrationConditionType) *IntegrationCondition {
 	for i := range in.Conditions {
@@ -101,3 +101,16 @@ func TestGetConfigurationProperty(t *testing.T) {
 	v6 := integration.GetConfigurationProperty("key6")
 	assert.Equal(t, "", v6)
 }
+
+func TestControlledRoutes(t *testing.T) {
+	it := NewIntegration("ns", "my-it")
+	assert.Empty(t, it.GetControlledRoutes())
+
+	it.SetControlledRoutes(map[string]string{"route2": "Suspended", "route1": "Stopped"})
+	assert.Equal(t, "route1=Stopped,route2=Suspended", it.Annotations[IntegrationControlledRoutesAnnotation])
+	assert.Equal(t, map[string]string{"route1": "Stopped", "route2": "Suspended"}, it.GetControlledRoutes())
+
+	it.SetControlledRoutes(nil)
+	_, ok := it.Annotations[IntegrationControlledRoutesAnnotation]
+	assert.False(t, ok)
+}
@@ -109,6 +109,8 @@ type IntegrationKitStatus struct {
 	Image string `json:"image,omitempty"`
 	// actual image digest of the kit
 	Digest string `json:"digest,omitempty"`
+	// the digest of the image for each platform it is available for
+	ImagePlatforms []ImagePlatform `json:"imagePlatforms,omitempty"`
 	// list of artifacts used by the kit
 	Artifacts []Artifact `json:"artifacts,omitempty"`
 	// failure reason (if any)
@@ -194,6 +196,16 @@ const (
 	IntegrationKitConditionPlatformAvailableReason string = "IntegrationPlatformAvailable"
 	// IntegrationKitConditionTraitInfo --.
 	IntegrationKitConditionTraitInfo IntegrationKitConditionType = "TraitInfo"
+	// IntegrationKitConditionNativeSourcesGenerated reports whether the sources for the native compilation have been generated.
+	IntegrationKitConditionNativeSourcesGenerated IntegrationKitConditionType = "NativeSourcesGenerated"
+	// IntegrationKitConditionNativeExecutableCompiled reports whether the native executable has been compiled.
+	IntegrationKitConditionNativeExecutableCompiled IntegrationKitConditionType = "NativeExecutableCompiled"
+	// IntegrationKitConditionNativeBuildInProgressReason --.
+	IntegrationKitConditionNativeBuildInProgressReason string = "InProgress"
+	// IntegrationKitConditionNativeBuildCompletedReason --.
+	IntegrationKitConditionNativeBuildCompletedReason string = "Completed"
+	// IntegrationKitConditionNativeBuildFailedReason --.
+	IntegrationKitConditionNativeBuildFailedReason string = "Failed"
 )
 
 // IntegrationKitCondition describes the state of a resource at a certain point.
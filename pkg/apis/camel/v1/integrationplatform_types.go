@@ -135,6 +135,32 @@ type IntegrationPlatformBuildSpec struct {
 	PublishStrategyOptions map[string]string `json:"PublishStrategyOptions,omitempty"`
 	// the maximum amount of parallel running pipelines started by this operator instance
 	MaxRunningBuilds int32 `json:"maxRunningBuilds,omitempty"`
+	// how the IntegrationKits are shared among Integrations with similar dependencies
+	KitPooling *KitPoolingSpec `json:"kitPooling,omitempty"`
+	// the external build service the builds are delegated to, when using the `external` build strategy
+	ExternalBuilder *ExternalBuilderSpec `json:"externalBuilder,omitempty"`
+}
+
+// KitPoolingSpec defines how the IntegrationKits are shared among the Integrations sharing the same runtime
+// and most of their dependencies. When enabled, an Integration can run on any kit whose dependencies are a superset
+// of its own, and a missing kit is built with the union of the dependencies of the closest existing kit and of the
+// Integration, so that a single image can be shared by several Integrations.
+type KitPoolingSpec struct {
+	// enables the kit pooling
+	Enabled bool `json:"enabled,omitempty"`
+	// the maximum number of dependencies an Integration may add to an existing kit to build a shared kit (default 5)
+	MaxAddedDependencies int32 `json:"maxAddedDependencies,omitempty"`
+	// the maximum number of dependencies of a shared kit, 0 meaning no limit
+	MaxDependencies int32 `json:"maxDependencies,omitempty"`
+}
+
+// ExternalBuilderSpec defines the external build service implementing the external builder protocol.
+type ExternalBuilderSpec struct {
+	// the base URL of the external build service
+	URL string `json:"url"`
+	// the name of a Secret, in the IntegrationPlatform namespace, holding the bearer token used to authenticate
+	// to the external build service, under the `token` key
+	Secret string `json:"secret,omitempty"`
 }
 
 // IntegrationPlatformKameletSpec define the behavior for all the Kamelets controller by the IntegrationPlatform.
@@ -0,0 +1,91 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+// Important: Run "make generate-deepcopy" to regenerate code after modifying this file
+
+// IntegrationPolicySpec defines the admission rules the operator enforces on the Integrations.
+type IntegrationPolicySpec struct {
+	// what to do when an Integration violates the policy: `Deny` (default) prevents it from running,
+	// `Warn` only reports the violation in the Integration conditions.
+	// +kubebuilder:validation:Enum=Deny;Warn
+	Action IntegrationPolicyAction `json:"action,omitempty"`
+	// the Camel components the Integrations must not use (ie, `exec`, `file`)
+	ForbiddenComponents []string `json:"forbiddenComponents,omitempty"`
+	// the container registries the Integration images must be pulled from (ie, `quay.io/my-org`).
+	// When empty, any registry is allowed.
+	AllowedRegistries []string `json:"allowedRegistries,omitempty"`
+	// the rules applied to the trait configuration of the Integrations
+	Traits []TraitPolicy `json:"traits,omitempty"`
+}
+
+// TraitPolicy is a rule applied to a trait property.
+type TraitPolicy struct {
+	// the trait property the rule applies to, in the `trait.property` form (ie, `service.type`)
+	Property string `json:"property"`
+	// the values the property must not take (ie, `LoadBalancer`)
+	Forbidden []string `json:"forbidden,omitempty"`
+	// the maximum quantity the property can take (ie, `1Gi`)
+	Max string `json:"max,omitempty"`
+	// when enabled, the operator fixes the configuration instead of reporting a violation:
+	// forbidden values are dropped and quantities above the maximum are capped.
+	Mutate bool `json:"mutate,omitempty"`
+}
+
+// +genclient
+// +genclient:noStatus
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=integrationpolicies,scope=Namespaced,shortName=ipol,categories=kamel;camel
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Action",type=string,JSONPath=`.spec.action`,description="The action taken on violations"
+
+// IntegrationPolicy is the resource used to define the admission rules of the Integrations.
+// The policies found in the Integration namespace and in the operator namespace are all enforced.
+type IntegrationPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec IntegrationPolicySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IntegrationPolicyList contains a list of IntegrationPolicy.
+type IntegrationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IntegrationPolicy `json:"items"`
+}
+
+// IntegrationPolicyAction is the action taken when a policy is violated.
+type IntegrationPolicyAction string
+
+const (
+	// IntegrationPolicyKind is the Kind name of the IntegrationPolicy CR.
+	IntegrationPolicyKind string = "IntegrationPolicy"
+
+	// IntegrationPolicyActionDeny prevents the violating Integrations from running.
+	IntegrationPolicyActionDeny IntegrationPolicyAction = "Deny"
+	// IntegrationPolicyActionWarn reports the violations without blocking the Integrations.
+	IntegrationPolicyActionWarn IntegrationPolicyAction = "Warn"
+)
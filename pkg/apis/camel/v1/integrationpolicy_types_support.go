@@ -0,0 +1,54 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewIntegrationPolicyList --.
+func NewIntegrationPolicyList() IntegrationPolicyList {
+	return IntegrationPolicyList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: SchemeGroupVersion.String(),
+			Kind:       IntegrationPolicyKind,
+		},
+	}
+}
+
+// NewIntegrationPolicy --.
+func NewIntegrationPolicy(namespace string, name string) IntegrationPolicy {
+	return IntegrationPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: SchemeGroupVersion.String(),
+			Kind:       IntegrationPolicyKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+	}
+}
+
+// GetAction returns the action taken on violations, defaulting to Deny.
+func (in *IntegrationPolicy) GetAction() IntegrationPolicyAction {
+	if in.Spec.Action == "" {
+		return IntegrationPolicyActionDeny
+	}
+	return in.Spec.Action
+}
@@ -89,6 +89,10 @@ type IntegrationProfileBuildSpec struct {
 	Timeout *metav1.Duration `json:"timeout,omitempty"`
 	// Maven configuration used to build the Camel/Camel-Quarkus applications
 	Maven MavenSpec `json:"maven,omitempty"`
+	// how the IntegrationKits are shared among Integrations with similar dependencies
+	KitPooling *KitPoolingSpec `json:"kitPooling,omitempty"`
+	// the external build service the builds are delegated to, when using the `external` build strategy
+	ExternalBuilder *ExternalBuilderSpec `json:"externalBuilder,omitempty"`
 }
 
 // IntegrationProfileKameletSpec define the behavior for all the Kamelets controller by the IntegrationProfile.
@@ -52,6 +52,32 @@ type MavenSpec struct {
 	// e.g., `-V,--no-transfer-progress,-Dstyle.color=never`.
 	// See https://maven.apache.org/ref/3.8.4/maven-embedder/cli.html.
 	CLIOptions []string `json:"cliOptions,omitempty"`
+	// The cache of the Maven dependencies, shared by the builder Pods.
+	Cache *MavenCacheSpec `json:"cache,omitempty"`
+	// The offline bundle of Maven artifacts, used as the only Maven repository in air-gapped environments.
+	OfflineBundle *MavenOfflineBundleSpec `json:"offlineBundle,omitempty"`
+}
+
+// MavenCacheSpec defines a local Maven repository shared by the builder Pods, so that
+// the Maven dependencies are cached across the builds.
+type MavenCacheSpec struct {
+	// The name of the PersistentVolumeClaim mounted as the local Maven repository of the builder Pods.
+	// The claim is created in the namespace of the builder Pods when it does not exist.
+	PersistentVolumeClaim string `json:"persistentVolumeClaim"`
+	// The storage class of the created PersistentVolumeClaim.
+	StorageClassName string `json:"storageClassName,omitempty"`
+	// The capacity of the created PersistentVolumeClaim (default `10Gi`).
+	Capacity string `json:"capacity,omitempty"`
+	// The access mode of the created PersistentVolumeClaim (default `ReadWriteMany`).
+	// A `ReadWriteOnce` claim requires the builder Pods to run on the same node.
+	AccessMode corev1.PersistentVolumeAccessMode `json:"accessMode,omitempty"`
+}
+
+// MavenOfflineBundleSpec defines a pre-seeded Maven repository, mounted read-only in the operator
+// and in the builder Pods, that replaces the remote Maven repositories.
+type MavenOfflineBundleSpec struct {
+	// The name of the PersistentVolumeClaim holding the content of the offline bundle.
+	PersistentVolumeClaim string `json:"persistentVolumeClaim"`
 }
 
 // Repository defines a Maven repository.
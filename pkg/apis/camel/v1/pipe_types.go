@@ -105,6 +105,8 @@ type PipeStatus struct {
 	Replicas *int32 `json:"replicas,omitempty"`
 	// Selector allows to identify pods belonging to the pipe
 	Selector string `json:"selector,omitempty"`
+	// Metrics are the Camel runtime metrics of the pipe, aggregated over its running Pods
+	Metrics *IntegrationRuntimeMetrics `json:"metrics,omitempty"`
 }
 
 // PipeCondition describes the state of a resource at a certain point.
@@ -136,6 +138,8 @@ const (
 	PipeIntegrationConditionError PipeConditionType = "IntegrationError"
 	// PipeIntegrationDeprecationNotice is used to report the usage of a deprecated resource.
 	PipeIntegrationDeprecationNotice PipeConditionType = "DeprecationNotice"
+	// PipeConditionPolicyCompliant reports whether the Integration generated by the Pipe complies with the IntegrationPolicies.
+	PipeConditionPolicyCompliant PipeConditionType = "PolicyCompliant"
 )
 
 // PipePhase --.
@@ -153,6 +157,8 @@ const (
 	PipePhaseError PipePhase = "Error"
 	// PipePhaseReady --.
 	PipePhaseReady PipePhase = "Ready"
+	// PipePhaseSuspended --.
+	PipePhaseSuspended PipePhase = "Suspended"
 )
 
 // +kubebuilder:object:root=true
@@ -70,6 +70,11 @@ func (in *Pipe) SetOperatorID(operatorID string) {
 	SetAnnotation(&in.ObjectMeta, OperatorIDAnnotation, operatorID)
 }
 
+// IsSuspended returns true when the Pipe is requested to be suspended.
+func (in *Pipe) IsSuspended() bool {
+	return in.Annotations[IntegrationSuspendedAnnotation] == "true"
+}
+
 // GetCondition returns the condition with the provided type.
 func (in *PipeStatus) GetCondition(condType PipeConditionType) *PipeCondition {
 	for i := range in.Conditions {
@@ -55,6 +55,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&IntegrationPlatformList{},
 		&IntegrationProfile{},
 		&IntegrationProfileList{},
+		&IntegrationPolicy{},
+		&IntegrationPolicyList{},
 		&CamelCatalog{},
 		&CamelCatalogList{},
 		&Build{},
@@ -20,7 +20,8 @@ package trait
 // Allows constraining which nodes the integration pod(s) are eligible to be scheduled on, based on labels on the node,
 // or with inter-pod affinity and anti-affinity, based on labels on pods that are already running on the nodes.
 //
-// It's disabled by default.
+// It's disabled by default, unless the Integration image is available for a known set of architectures:
+// in such case, the integration pod(s) are only scheduled on the nodes of these architectures.
 //
 // +camel-k:trait=affinity.
 type AffinityTrait struct {
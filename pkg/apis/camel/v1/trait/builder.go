@@ -28,8 +28,8 @@ type BuilderTrait struct {
 	Verbose *bool `property:"verbose" json:"verbose,omitempty"`
 	// A list of properties to be provided to the build task
 	Properties []string `property:"properties" json:"properties,omitempty"`
-	// The strategy to use, either `pod` or `routine` (default `routine`)
-	// +kubebuilder:validation:Enum=pod;routine
+	// The strategy to use, either `pod`, `routine`, `external` or `tekton` (default `routine`)
+	// +kubebuilder:validation:Enum=pod;routine;external;tekton
 	Strategy string `property:"strategy" json:"strategy,omitempty"`
 	// Specify a base image. In order to have the application working properly it must be a container image which has a Java JDK
 	// installed and ready to use on path (ie `/usr/bin/java`).
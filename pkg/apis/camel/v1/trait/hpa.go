@@ -0,0 +1,56 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The HPA trait creates a `HorizontalPodAutoscaler` resource, that automatically scales the Integration
+// according to the CPU or memory utilization of its pods, or to the Camel metrics exposed with the Prometheus trait.
+//
+// The autoscaler targets the `scale` subresource of the Integration (or of the Pipe owning it), whose replicas are
+// propagated to the Integration Deployment.
+//
+// The Camel metrics are read through the Kubernetes custom metrics API, which requires an adapter, like the
+// https://github.com/kubernetes-sigs/prometheus-adapter[Prometheus Adapter], to serve the metrics scraped from the
+// Integration pods.
+//
+// WARNING: The HPA trait cannot be used together with the KEDA or Idle traits, and only applies to the `deployment` controller strategy.
+//
+// The HPA trait is disabled by default.
+//
+// +camel-k:trait=hpa.
+type HPATrait struct {
+	Trait `property:",squash" json:",inline"`
+	// Minimum number of replicas (default `1`).
+	MinReplicas *int32 `property:"min-replicas" json:"minReplicas,omitempty"`
+	// Maximum number of replicas (default `10`).
+	MaxReplicas *int32 `property:"max-replicas" json:"maxReplicas,omitempty"`
+	// Target average CPU utilization of the pods, as a percentage of the requested CPU
+	// (default `80` if no other metric is set).
+	CPUUtilization *int32 `property:"cpu-utilization" json:"cpuUtilization,omitempty"`
+	// Target average memory utilization of the pods, as a percentage of the requested memory.
+	MemoryUtilization *int32 `property:"memory-utilization" json:"memoryUtilization,omitempty"`
+	// Target average number of inflight exchanges per pod, e.g. `10`.
+	InflightExchanges string `property:"inflight-exchanges" json:"inflightExchanges,omitempty"`
+	// The name of the custom metric serving the inflight exchanges (default `camel_exchanges_inflight`).
+	InflightExchangesMetric string `property:"inflight-exchanges-metric" json:"inflightExchangesMetric,omitempty"`
+	// Target average number of exchanges per second per pod, e.g. `50` or `500m`.
+	ExchangesPerSecond string `property:"exchanges-per-second" json:"exchangesPerSecond,omitempty"`
+	// The name of the custom metric serving the exchanges per second (default `camel_exchanges_per_second`).
+	ExchangesPerSecondMetric string `property:"exchanges-per-second-metric" json:"exchangesPerSecondMetric,omitempty"`
+	// The stabilization window (seconds) used when scaling down, during which the highest recommendation is retained.
+	ScaleDownStabilizationWindow *int32 `property:"scale-down-stabilization-window" json:"scaleDownStabilizationWindow,omitempty"`
+}
@@ -0,0 +1,42 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The Idle trait scales the Integration down to zero replicas when it has not processed any exchange for a
+// given period, e.g. for low-traffic polling integrations, and wakes it up on a schedule.
+//
+// The activity of the Integration is detected from the Camel exchange metrics exposed with the Prometheus trait,
+// which must be enabled. The Integration, or the Pipe owning it, is scaled through its `scale` subresource:
+// scaling it up, e.g. with `kubectl scale`, also wakes it up. The `Idle` condition of the Integration reports
+// whether it is scaled down to zero.
+//
+// The idle Integration is not woken up by incoming events, e.g. HTTP requests or messages, but only on the wake
+// schedule, or when it is scaled up.
+//
+// WARNING: The Idle trait cannot be used together with the HPA or KEDA traits, and only applies to the `deployment` controller strategy.
+//
+// The Idle trait is disabled by default.
+//
+// +camel-k:trait=idle.
+type IdleTrait struct {
+	Trait `property:",squash" json:",inline"`
+	// The period without any exchange after which the Integration is scaled down to zero, e.g. `1h` (default `15m`).
+	Timeout string `property:"timeout" json:"timeout,omitempty"`
+	// The schedule, in standard cron format, at which the idle Integration is woken up, e.g. `0 * * * *`.
+	WakeSchedule string `property:"wake-schedule" json:"wakeSchedule,omitempty"`
+}
@@ -0,0 +1,43 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The Schedule trait restricts the Integration to be active only during the given time windows, e.g. the business hours
+// of a partner system, and excludes given dates, e.g. public holidays or maintenance windows.
+//
+// Out of its windows, the Integration is suspended: its workloads are scaled down to zero, while all its resources are
+// kept, and it is resumed automatically at the start of its next window. The `Scheduled` condition of the Integration
+// reports whether it is within its schedule, and the `nextScheduledTransition` status field the next time it is
+// suspended or resumed.
+//
+// A window is declared with the `<days> <start>-<end>` format, e.g. `Mon-Fri 08:00-18:00`, where the days are
+// comma separated day names (`Mon`, `Tue`, ...) or day ranges, or `*` for every day. A window ending before it starts
+// spans over midnight, e.g. `Fri 22:00-02:00`.
+//
+// The Schedule trait is disabled by default.
+//
+// +camel-k:trait=schedule.
+type ScheduleTrait struct {
+	Trait `property:",squash" json:",inline"`
+	// The windows during which the Integration is active, e.g. `Mon-Fri 08:00-18:00`.
+	Windows []string `property:"windows" json:"windows,omitempty"`
+	// The time zone of the windows and of the excluded dates, e.g. `Europe/Paris` (default `UTC`).
+	TimeZone string `property:"time-zone" json:"timeZone,omitempty"`
+	// The dates, in the `YYYY-MM-DD` format, on which no window starts, e.g. `2024-12-25`.
+	Exclusions []string `property:"exclusions" json:"exclusions,omitempty"`
+}
@@ -401,6 +401,47 @@ func (in *GCTrait) DeepCopy() *GCTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HPATrait) DeepCopyInto(out *HPATrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CPUUtilization != nil {
+		in, out := &in.CPUUtilization, &out.CPUUtilization
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MemoryUtilization != nil {
+		in, out := &in.MemoryUtilization, &out.MemoryUtilization
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScaleDownStabilizationWindow != nil {
+		in, out := &in.ScaleDownStabilizationWindow, &out.ScaleDownStabilizationWindow
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HPATrait.
+func (in *HPATrait) DeepCopy() *HPATrait {
+	if in == nil {
+		return nil
+	}
+	out := new(HPATrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthTrait) DeepCopyInto(out *HealthTrait) {
 	*out = *in
@@ -432,6 +473,22 @@ func (in *HealthTrait) DeepCopy() *HealthTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdleTrait) DeepCopyInto(out *IdleTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdleTrait.
+func (in *IdleTrait) DeepCopy() *IdleTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(IdleTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressTrait) DeepCopyInto(out *IngressTrait) {
 	*out = *in
@@ -1061,6 +1118,32 @@ func (in *RouteTrait) DeepCopy() *RouteTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleTrait) DeepCopyInto(out *ScheduleTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclusions != nil {
+		in, out := &in.Exclusions, &out.Exclusions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleTrait.
+func (in *ScheduleTrait) DeepCopy() *ScheduleTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityContextTrait) DeepCopyInto(out *SecurityContextTrait) {
 	*out = *in
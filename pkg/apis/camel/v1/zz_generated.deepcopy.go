@@ -209,6 +209,26 @@ func (in *BuildList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildLog) DeepCopyInto(out *BuildLog) {
+	*out = *in
+	if in.ConfigMaps != nil {
+		in, out := &in.ConfigMaps, &out.ConfigMaps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildLog.
+func (in *BuildLog) DeepCopy() *BuildLog {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BuildSpec) DeepCopyInto(out *BuildSpec) {
 	*out = *in
@@ -236,6 +256,11 @@ func (in *BuildSpec) DeepCopy() *BuildSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BuildStatus) DeepCopyInto(out *BuildStatus) {
 	*out = *in
+	if in.ImagePlatforms != nil {
+		in, out := &in.ImagePlatforms, &out.ImagePlatforms
+		*out = make([]ImagePlatform, len(*in))
+		copy(*out, *in)
+	}
 	if in.Artifacts != nil {
 		in, out := &in.Artifacts, &out.Artifacts
 		*out = make([]Artifact, len(*in))
@@ -257,6 +282,16 @@ func (in *BuildStatus) DeepCopyInto(out *BuildStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Log != nil {
+		in, out := &in.Log, &out.Log
+		*out = new(BuildLog)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MavenCache != nil {
+		in, out := &in.MavenCache, &out.MavenCache
+		*out = new(MavenCacheStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildStatus.
@@ -961,6 +996,21 @@ func (in *EventTypeSpec) DeepCopy() *EventTypeSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalBuilderSpec) DeepCopyInto(out *ExternalBuilderSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalBuilderSpec.
+func (in *ExternalBuilderSpec) DeepCopy() *ExternalBuilderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalBuilderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalDocumentation) DeepCopyInto(out *ExternalDocumentation) {
 	*out = *in
@@ -1128,6 +1178,21 @@ func (in *HealthCheckResponse) DeepCopy() *HealthCheckResponse {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePlatform) DeepCopyInto(out *ImagePlatform) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePlatform.
+func (in *ImagePlatform) DeepCopy() *ImagePlatform {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePlatform)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Integration) DeepCopyInto(out *Integration) {
 	*out = *in
@@ -1305,6 +1370,11 @@ func (in *IntegrationKitSpec) DeepCopy() *IntegrationKitSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IntegrationKitStatus) DeepCopyInto(out *IntegrationKitStatus) {
 	*out = *in
+	if in.ImagePlatforms != nil {
+		in, out := &in.ImagePlatforms, &out.ImagePlatforms
+		*out = make([]ImagePlatform, len(*in))
+		copy(*out, *in)
+	}
 	if in.Artifacts != nil {
 		in, out := &in.Artifacts, &out.Artifacts
 		*out = make([]Artifact, len(*in))
@@ -1458,6 +1528,16 @@ func (in *IntegrationPlatformBuildSpec) DeepCopyInto(out *IntegrationPlatformBui
 			(*out)[key] = val
 		}
 	}
+	if in.KitPooling != nil {
+		in, out := &in.KitPooling, &out.KitPooling
+		*out = new(KitPoolingSpec)
+		**out = **in
+	}
+	if in.ExternalBuilder != nil {
+		in, out := &in.ExternalBuilder, &out.ExternalBuilder
+		*out = new(ExternalBuilderSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationPlatformBuildSpec.
@@ -1592,6 +1672,96 @@ func (in *IntegrationPlatformStatus) DeepCopy() *IntegrationPlatformStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationPolicy) DeepCopyInto(out *IntegrationPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationPolicy.
+func (in *IntegrationPolicy) DeepCopy() *IntegrationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IntegrationPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationPolicyList) DeepCopyInto(out *IntegrationPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IntegrationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationPolicyList.
+func (in *IntegrationPolicyList) DeepCopy() *IntegrationPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IntegrationPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationPolicySpec) DeepCopyInto(out *IntegrationPolicySpec) {
+	*out = *in
+	if in.ForbiddenComponents != nil {
+		in, out := &in.ForbiddenComponents, &out.ForbiddenComponents
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedRegistries != nil {
+		in, out := &in.AllowedRegistries, &out.AllowedRegistries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Traits != nil {
+		in, out := &in.Traits, &out.Traits
+		*out = make([]TraitPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationPolicySpec.
+func (in *IntegrationPolicySpec) DeepCopy() *IntegrationPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IntegrationProfile) DeepCopyInto(out *IntegrationProfile) {
 	*out = *in
@@ -1629,6 +1799,16 @@ func (in *IntegrationProfileBuildSpec) DeepCopyInto(out *IntegrationProfileBuild
 		**out = **in
 	}
 	in.Maven.DeepCopyInto(&out.Maven)
+	if in.KitPooling != nil {
+		in, out := &in.KitPooling, &out.KitPooling
+		*out = new(KitPoolingSpec)
+		**out = **in
+	}
+	if in.ExternalBuilder != nil {
+		in, out := &in.ExternalBuilder, &out.ExternalBuilder
+		*out = new(ExternalBuilderSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationProfileBuildSpec.
@@ -1751,6 +1931,34 @@ func (in *IntegrationProfileStatus) DeepCopy() *IntegrationProfileStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationRuntimeMetrics) DeepCopyInto(out *IntegrationRuntimeMetrics) {
+	*out = *in
+	if in.CollectedAt != nil {
+		in, out := &in.CollectedAt, &out.CollectedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.MeanProcessingTime != nil {
+		in, out := &in.MeanProcessingTime, &out.MeanProcessingTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.LastActivity != nil {
+		in, out := &in.LastActivity, &out.LastActivity
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationRuntimeMetrics.
+func (in *IntegrationRuntimeMetrics) DeepCopy() *IntegrationRuntimeMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationRuntimeMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IntegrationSpec) DeepCopyInto(out *IntegrationSpec) {
 	*out = *in
@@ -1857,6 +2065,15 @@ func (in *IntegrationStatus) DeepCopyInto(out *IntegrationStatus) {
 		in, out := &in.InitializationTimestamp, &out.InitializationTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(IntegrationRuntimeMetrics)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NextScheduledTransition != nil {
+		in, out := &in.NextScheduledTransition, &out.NextScheduledTransition
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationStatus.
@@ -2252,6 +2469,21 @@ func (in *KanikoTaskCache) DeepCopy() *KanikoTaskCache {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KitPoolingSpec) DeepCopyInto(out *KitPoolingSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KitPoolingSpec.
+func (in *KitPoolingSpec) DeepCopy() *KitPoolingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KitPoolingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MavenArtifact) DeepCopyInto(out *MavenArtifact) {
 	*out = *in
@@ -2295,6 +2527,51 @@ func (in *MavenBuildSpec) DeepCopy() *MavenBuildSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MavenCacheSpec) DeepCopyInto(out *MavenCacheSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MavenCacheSpec.
+func (in *MavenCacheSpec) DeepCopy() *MavenCacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MavenCacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MavenCacheStatus) DeepCopyInto(out *MavenCacheStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MavenCacheStatus.
+func (in *MavenCacheStatus) DeepCopy() *MavenCacheStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MavenCacheStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MavenOfflineBundleSpec) DeepCopyInto(out *MavenOfflineBundleSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MavenOfflineBundleSpec.
+func (in *MavenOfflineBundleSpec) DeepCopy() *MavenOfflineBundleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MavenOfflineBundleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MavenSpec) DeepCopyInto(out *MavenSpec) {
 	*out = *in
@@ -2331,6 +2608,16 @@ func (in *MavenSpec) DeepCopyInto(out *MavenSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(MavenCacheSpec)
+		**out = **in
+	}
+	if in.OfflineBundle != nil {
+		in, out := &in.OfflineBundle, &out.OfflineBundle
+		*out = new(MavenOfflineBundleSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MavenSpec.
@@ -2515,6 +2802,11 @@ func (in *PipeStatus) DeepCopyInto(out *PipeStatus) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(IntegrationRuntimeMetrics)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipeStatus.
@@ -3067,6 +3359,26 @@ func (in *TraitConfiguration) DeepCopy() *TraitConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TraitPolicy) DeepCopyInto(out *TraitPolicy) {
+	*out = *in
+	if in.Forbidden != nil {
+		in, out := &in.Forbidden, &out.Forbidden
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraitPolicy.
+func (in *TraitPolicy) DeepCopy() *TraitPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TraitPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TraitSpec) DeepCopyInto(out *TraitSpec) {
 	*out = *in
@@ -3146,6 +3458,16 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.HealthTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HPA != nil {
+		in, out := &in.HPA, &out.HPA
+		*out = new(trait.HPATrait)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Idle != nil {
+		in, out := &in.Idle, &out.Idle
+		*out = new(trait.IdleTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Ingress != nil {
 		in, out := &in.Ingress, &out.Ingress
 		*out = new(trait.IngressTrait)
@@ -3241,6 +3563,11 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.RouteTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(trait.ScheduleTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SecurityContext != nil {
 		in, out := &in.SecurityContext, &out.SecurityContext
 		*out = new(trait.SecurityContextTrait)
@@ -0,0 +1,199 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// +kubebuilder:object:root=true
+
+// PipelineRun is a specification for a PipelineRun resource.
+type PipelineRun struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec PipelineRunSpec `json:"spec,omitempty"`
+	// +optional
+	Status PipelineRunStatus `json:"status,omitempty"`
+}
+
+// PipelineRunSpec is the spec for a PipelineRun resource.
+type PipelineRunSpec struct {
+	// +optional
+	PipelineSpec *PipelineSpec `json:"pipelineSpec,omitempty"`
+	// Used for cancelling a PipelineRun
+	// +optional
+	Status string `json:"status,omitempty"`
+	// +optional
+	Timeouts *TimeoutFields `json:"timeouts,omitempty"`
+	// +optional
+	TaskRunTemplate PipelineTaskRunTemplate `json:"taskRunTemplate,omitempty"`
+}
+
+// TimeoutFields allows granular specification of pipeline, task, and finally timeouts.
+type TimeoutFields struct {
+	// +optional
+	Pipeline *metav1.Duration `json:"pipeline,omitempty"`
+}
+
+// PipelineTaskRunTemplate is used to specify run specifications for all Task in pipelinerun.
+type PipelineTaskRunTemplate struct {
+	// +optional
+	PodTemplate *PodTemplate `json:"podTemplate,omitempty"`
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+}
+
+// PodTemplate holds pod specific configuration.
+type PodTemplate struct {
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// +optional
+	SecurityContext *corev1.PodSecurityContext `json:"securityContext,omitempty"`
+}
+
+// PipelineSpec defines the desired state of Pipeline.
+type PipelineSpec struct {
+	Tasks []PipelineTask `json:"tasks,omitempty"`
+}
+
+// PipelineTask defines a task in a Pipeline.
+type PipelineTask struct {
+	Name string `json:"name,omitempty"`
+	// +optional
+	TaskSpec *TaskSpec `json:"taskSpec,omitempty"`
+	// +optional
+	RunAfter []string `json:"runAfter,omitempty"`
+}
+
+// TaskSpec defines the desired state of Task.
+type TaskSpec struct {
+	// +optional
+	Steps []Step `json:"steps,omitempty"`
+	// +optional
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+	// +optional
+	Results []TaskResult `json:"results,omitempty"`
+}
+
+// Step runs a subcomponent of a Task.
+type Step struct {
+	Name  string `json:"name"`
+	Image string `json:"image,omitempty"`
+	// +optional
+	Command []string `json:"command,omitempty"`
+	// +optional
+	Args []string `json:"args,omitempty"`
+	// +optional
+	WorkingDir string `json:"workingDir,omitempty"`
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// +optional
+	ComputeResources corev1.ResourceRequirements `json:"computeResources,omitempty"`
+	// +optional
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+	// +optional
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// +optional
+	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
+}
+
+// TaskResult used to describe the results of a task.
+type TaskResult struct {
+	Name string `json:"name"`
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// PipelineRunStatus defines the observed state of PipelineRun.
+type PipelineRunStatus struct {
+	duckv1.Status `json:",inline"`
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// +optional
+	ChildReferences []ChildStatusReference `json:"childReferences,omitempty"`
+}
+
+// ChildStatusReference is used to point to the statuses of individual TaskRuns and Runs within this PipelineRun.
+type ChildStatusReference struct {
+	Kind             string `json:"kind,omitempty"`
+	Name             string `json:"name,omitempty"`
+	PipelineTaskName string `json:"pipelineTaskName,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PipelineRunList contains a list of PipelineRun.
+type PipelineRunList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PipelineRun `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+
+// TaskRun represents a single execution of a Task.
+type TaskRun struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Status TaskRunStatus `json:"status,omitempty"`
+}
+
+// TaskRunStatus defines the observed state of TaskRun.
+type TaskRunStatus struct {
+	duckv1.Status `json:",inline"`
+	// +optional
+	PodName string `json:"podName,omitempty"`
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// +optional
+	Steps []StepState `json:"steps,omitempty"`
+	// +optional
+	Results []TaskRunResult `json:"results,omitempty"`
+}
+
+// StepState reports the results of running a step in a Task.
+type StepState struct {
+	corev1.ContainerState `json:",inline"`
+	Name                  string `json:"name,omitempty"`
+	Container             string `json:"container,omitempty"`
+}
+
+// TaskRunResult used to describe the results of a task. Only string results are supported.
+type TaskRunResult struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// +kubebuilder:object:root=true
+
+// TaskRunList contains a list of TaskRun.
+type TaskRunList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TaskRun `json:"items"`
+}
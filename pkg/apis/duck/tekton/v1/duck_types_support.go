@@ -0,0 +1,47 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	PipelineRunKind = "PipelineRun"
+
+	// PipelineRunSpecStatusCancelled indicates that the user wants to cancel the PipelineRun.
+	PipelineRunSpecStatusCancelled = "Cancelled"
+
+	// PipelineRunReasonTimedOut is the reason set when the PipelineRun has timed out.
+	PipelineRunReasonTimedOut = "PipelineRunTimeout"
+	// PipelineRunReasonCancelled is the reason set when the PipelineRun has been cancelled.
+	PipelineRunReasonCancelled = "Cancelled"
+)
+
+func NewPipelineRun(namespace string, name string) PipelineRun {
+	return PipelineRun{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: SchemeGroupVersion.String(),
+			Kind:       PipelineRunKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+	}
+}
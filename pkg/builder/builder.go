@@ -28,6 +28,7 @@ import (
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/client"
 	"github.com/apache/camel-k/v2/pkg/util/log"
+	"github.com/apache/camel-k/v2/pkg/util/maven"
 )
 
 type builderTask struct {
@@ -63,6 +64,13 @@ func (t *builderTask) Do(ctx context.Context) v1.BuildStatus {
 		Build:     *t.task,
 		BaseImage: t.task.BaseImage,
 	}
+	// The Maven cache is the local repository of the builder Pods
+	var cacheUsage *maven.CacheUsage
+	if strategy := t.build.BuilderConfiguration().Strategy; t.task.Maven.Cache != nil && t.task.Maven.OfflineBundle == nil && (strategy == v1.BuildStrategyPod || strategy == v1.BuildStrategyTekton) {
+		cacheUsage = &maven.CacheUsage{}
+		c.C = maven.WithCacheUsage(ctx, cacheUsage)
+		c.Maven.SharedLocalRepository = true
+	}
 	t.log.Infof("running builder task %s in context directory: %s", c.Build.Name, c.Path)
 
 	steps, err := StepsFrom(t.task.Steps...)
@@ -115,6 +123,11 @@ steps:
 	}
 
 	result.BaseImage = c.BaseImage
+	if cacheUsage != nil {
+		result.MavenCache = &v1.MavenCacheStatus{
+			Downloaded: cacheUsage.Downloaded,
+		}
+	}
 	result.Artifacts = make([]v1.Artifact, 0, len(c.Artifacts))
 	result.Artifacts = append(result.Artifacts, c.Artifacts...)
 
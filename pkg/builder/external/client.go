@@ -0,0 +1,154 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+// clientTimeout bounds the requests to the external build service, so that an unresponsive service does not
+// block the reconciliation of the builds.
+const clientTimeout = 30 * time.Second
+
+// ErrBuildNotFound is returned when the external build service does not know the build.
+var ErrBuildNotFound = errors.New("build not found in the external build service")
+
+// Client talks to an external build service.
+type Client struct {
+	url        string
+	token      string
+	httpClient *http.Client
+}
+
+// NewClient returns a client for the external build service available at the given base URL, authenticating
+// with the given bearer token, if not empty.
+func NewClient(baseURL string, token string) *Client {
+	return &Client{
+		url:        strings.TrimSuffix(baseURL, "/"),
+		token:      token,
+		httpClient: &http.Client{Timeout: clientTimeout},
+	}
+}
+
+// Submit hands the Build to the external build service.
+func (c *Client) Submit(ctx context.Context, build *v1.Build) error {
+	request := BuildRequest{
+		Namespace: build.Namespace,
+		Name:      build.Name,
+		UID:       string(build.UID),
+		Spec:      build.Spec,
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	resp, err := c.do(ctx, http.MethodPut, buildPath(build), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return checkResponse(resp)
+}
+
+// Status returns the status of the Build in the external build service.
+func (c *Client) Status(ctx context.Context, build *v1.Build) (*BuildStatus, error) {
+	resp, err := c.do(ctx, http.MethodGet, buildPath(build), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+	var status BuildStatus
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return nil, fmt.Errorf("cannot decode the status of build %s: %w", build.Name, err)
+	}
+
+	return &status, nil
+}
+
+// Logs returns the log of the Build in the external build service.
+func (c *Client) Logs(ctx context.Context, build *v1.Build) ([]byte, error) {
+	resp, err := c.do(ctx, http.MethodGet, buildPath(build)+"/logs", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// Cancel cancels the Build in the external build service.
+func (c *Client) Cancel(ctx context.Context, build *v1.Build) error {
+	resp, err := c.do(ctx, http.MethodDelete, buildPath(build), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return checkResponse(resp)
+}
+
+func (c *Client) do(ctx context.Context, method string, path string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	return c.httpClient.Do(req)
+}
+
+func buildPath(build *v1.Build) string {
+	return "/v1/builds/" + url.PathEscape(build.Namespace) + "/" + url.PathEscape(build.Name)
+}
+
+func checkResponse(resp *http.Response) error {
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return ErrBuildNotFound
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("external build service error: %s %s", resp.Status, strings.TrimSpace(string(message)))
+	}
+
+	return nil
+}
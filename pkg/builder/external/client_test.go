@@ -0,0 +1,130 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/builder/external"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+)
+
+func TestClientBuildLifecycle(t *testing.T) {
+	ctx := context.TODO()
+	server := test.NewExternalBuilder("secret-token")
+	defer server.Close()
+
+	build := newBuild()
+	c := external.NewClient(server.URL()+"/", "secret-token")
+
+	_, err := c.Status(ctx, build)
+	require.ErrorIs(t, err, external.ErrBuildNotFound)
+
+	require.NoError(t, c.Submit(ctx, build))
+	request, ok := server.Request("ns", "my-build")
+	require.True(t, ok)
+	assert.Equal(t, "ns", request.Namespace)
+	assert.Equal(t, "my-build", request.Name)
+	assert.Equal(t, "build-uid", request.UID)
+	assert.Equal(t, build.Spec, request.Spec)
+
+	status, err := c.Status(ctx, build)
+	require.NoError(t, err)
+	assert.Equal(t, v1.BuildPhasePending, status.Phase)
+
+	server.SetStatus("ns", "my-build", external.BuildStatus{
+		Phase:  v1.BuildPhaseSucceeded,
+		Image:  "registry/my-image:1",
+		Digest: "sha256:1234",
+		ImagePlatforms: []v1.ImagePlatform{
+			{Platform: "linux/amd64", Digest: "sha256:5678"},
+		},
+	})
+	server.SetLog("ns", "my-build", "BUILD SUCCESS\n")
+
+	status, err = c.Status(ctx, build)
+	require.NoError(t, err)
+	assert.Equal(t, v1.BuildPhaseSucceeded, status.Phase)
+	assert.Equal(t, "registry/my-image:1", status.Image)
+	assert.Equal(t, "sha256:1234", status.Digest)
+	assert.Len(t, status.ImagePlatforms, 1)
+
+	log, err := c.Logs(ctx, build)
+	require.NoError(t, err)
+	assert.Equal(t, "BUILD SUCCESS\n", string(log))
+}
+
+func TestClientCancel(t *testing.T) {
+	ctx := context.TODO()
+	server := test.NewExternalBuilder("")
+	defer server.Close()
+
+	build := newBuild()
+	c := external.NewClient(server.URL(), "")
+
+	require.ErrorIs(t, c.Cancel(ctx, build), external.ErrBuildNotFound)
+	require.NoError(t, c.Submit(ctx, build))
+	require.NoError(t, c.Cancel(ctx, build))
+	assert.True(t, server.Cancelled("ns", "my-build"))
+
+	status, err := c.Status(ctx, build)
+	require.NoError(t, err)
+	assert.Equal(t, v1.BuildPhaseFailed, status.Phase)
+}
+
+func TestClientUnauthorized(t *testing.T) {
+	server := test.NewExternalBuilder("secret-token")
+	defer server.Close()
+
+	err := external.NewClient(server.URL(), "wrong-token").Submit(context.TODO(), newBuild())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "401")
+	_, ok := server.Request("ns", "my-build")
+	assert.False(t, ok)
+}
+
+func newBuild() *v1.Build {
+	return &v1.Build{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-build",
+			UID:       "build-uid",
+		},
+		Spec: v1.BuildSpec{
+			Tasks: []v1.Task{
+				{
+					Builder: &v1.BuilderTask{
+						BaseTask: v1.BaseTask{
+							Name: "builder",
+							Configuration: v1.BuildConfiguration{
+								Strategy: v1.BuildStrategyExternal,
+							},
+						},
+						Dependencies: []string{"camel:timer"},
+					},
+				},
+			},
+		},
+	}
+}
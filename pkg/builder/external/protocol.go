@@ -0,0 +1,65 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package external implements the protocol used by the operator to delegate the builds to an external build service.
+//
+// The external build service exposes the following HTTP endpoints, relative to its base URL, exchanging JSON documents:
+//
+//	PUT    /v1/builds/{namespace}/{name}       submits the build, with a BuildRequest body
+//	GET    /v1/builds/{namespace}/{name}       returns the BuildStatus of the build
+//	GET    /v1/builds/{namespace}/{name}/logs  returns the log of the build so far, as plain text
+//	DELETE /v1/builds/{namespace}/{name}       cancels the build
+//
+// Submitting a build which already exists is a no-op. The endpoints answer 404 for an unknown build.
+// When a token is configured, the requests carry it as a bearer token in the Authorization header.
+package external
+
+import (
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+// BuildRequest is the document submitted to the external build service.
+type BuildRequest struct {
+	// the namespace of the Build
+	Namespace string `json:"namespace"`
+	// the name of the Build
+	Name string `json:"name"`
+	// the UID of the Build, identifying the build among builds sharing the same name
+	UID string `json:"uid"`
+	// the tasks to execute, with the sources and the dependencies of the Integration
+	Spec v1.BuildSpec `json:"spec"`
+}
+
+// BuildStatus is the status of a build, as reported by the external build service.
+type BuildStatus struct {
+	// the phase of the build, one of Pending, Running, Succeeded, Failed or Error
+	Phase v1.BuildPhase `json:"phase"`
+	// the image built
+	Image string `json:"image,omitempty"`
+	// the digest of the image built
+	Digest string `json:"digest,omitempty"`
+	// the digest of the image built for each requested platform
+	ImagePlatforms []v1.ImagePlatform `json:"imagePlatforms,omitempty"`
+	// the base image used for the build
+	BaseImage string `json:"baseImage,omitempty"`
+	// the root image used for the build
+	RootImage string `json:"rootImage,omitempty"`
+	// the error description (if any)
+	Error string `json:"error,omitempty"`
+	// the conditions of the build, e.g. the progress of its tasks
+	Conditions []v1.BuildCondition `json:"conditions,omitempty"`
+}
@@ -25,6 +25,8 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/google/go-containerregistry/pkg/crane"
+
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/client"
 	"github.com/apache/camel-k/v2/pkg/util"
@@ -102,7 +104,8 @@ func (t *jibTask) Do(ctx context.Context) v1.BuildStatus {
 	cmd.Env = append(cmd.Env, fmt.Sprintf("XDG_CONFIG_HOME=%s/jib", mavenDir))
 	cmd.Dir = mavenDir
 
-	myerror := util.RunAndLog(ctx, cmd, maven.LogHandler, maven.LogHandler)
+	handler := maven.LogHandlerFor(ctx)
+	myerror := util.RunAndLog(ctx, cmd, handler, handler)
 
 	if myerror != nil {
 		log.Errorf(myerror, "jib integration image containerization did not run successfully")
@@ -119,6 +122,20 @@ func (t *jibTask) Do(ctx context.Context) v1.BuildStatus {
 			return status.Failed(errDigest)
 		}
 		status.Digest = string(mavenDigest)
+
+		if len(t.task.Configuration.ImagePlatforms) > 0 {
+			// Record the image built for each platform of the manifest list
+			var options []crane.Option
+			if t.task.Registry.Insecure {
+				options = append(options, crane.Insecure)
+			}
+			images, errPlatforms := registry.PlatformImages(ctx, t.task.Image+"@"+status.Digest, options...)
+			if errPlatforms != nil {
+				_ = cleanRegistryConfig(registryConfigDir)
+				return status.Failed(errPlatforms)
+			}
+			status.ImagePlatforms = images
+		}
 	}
 
 	if registryConfigDir != "" {
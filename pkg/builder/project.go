@@ -108,7 +108,7 @@ func generateProjectSettings(ctx *builderContext) error {
 		ctx.Maven.UserSettings = []byte(val)
 	}
 
-	settings, err := maven.NewSettings(maven.DefaultRepositories, maven.ProxyFromEnvironment)
+	settings, err := maven.NewSettings(maven.DefaultRepositories, maven.ProxyFromEnvironment, maven.OfflineBundle(ctx.Build.Maven.OfflineBundle))
 	if err != nil {
 		return err
 	}
@@ -130,7 +130,7 @@ func loadCamelQuarkusCatalog(ctx *builderContext) error {
 }
 
 func generateQuarkusProject(ctx *builderContext) error {
-	p := generateQuarkusProjectCommon(
+	p := GenerateQuarkusProjectCommon(
 		ctx.Build.Runtime.Version,
 		ctx.Build.Runtime.Metadata["quarkus.version"],
 	)
@@ -144,7 +144,7 @@ func generateQuarkusProject(ctx *builderContext) error {
 	return nil
 }
 
-func generateQuarkusProjectCommon(runtimeVersion string, quarkusPlatformVersion string) maven.Project {
+func GenerateQuarkusProjectCommon(runtimeVersion string, quarkusPlatformVersion string) maven.Project {
 	p := maven.NewProjectWithGAV("org.apache.camel.k.integration", "camel-k-integration", defaults.Version)
 	p.DependencyManagement = &maven.DependencyManagement{Dependencies: make([]maven.Dependency, 0)}
 	p.Dependencies = make([]maven.Dependency, 0)
@@ -190,6 +190,7 @@ func buildQuarkusRunner(ctx *builderContext) error {
 	mc.UserSettings = ctx.Maven.UserSettings
 	mc.SettingsSecurity = ctx.Maven.SettingsSecurity
 	mc.LocalRepository = ctx.Build.Maven.LocalRepository
+	mc.SharedLocalRepository = ctx.Maven.SharedLocalRepository
 	mc.AdditionalArguments = ctx.Build.Maven.CLIOptions
 
 	if ctx.Maven.TrustStoreName != "" {
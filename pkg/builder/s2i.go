@@ -22,14 +22,22 @@ import (
 	"bufio"
 	"compress/gzip"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
 
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/crane"
+	containerv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -47,9 +55,12 @@ import (
 	"github.com/apache/camel-k/v2/pkg/client"
 	"github.com/apache/camel-k/v2/pkg/util"
 	"github.com/apache/camel-k/v2/pkg/util/log"
+	"github.com/apache/camel-k/v2/pkg/util/registry"
 	"github.com/apache/camel-k/v2/pkg/util/s2i"
 )
 
+const serviceCAFile = "/var/run/secrets/kubernetes.io/serviceaccount/service-ca.crt"
+
 type s2iTask struct {
 	c     client.Client
 	build *v1.Build
@@ -61,34 +72,6 @@ var _ Task = &s2iTask{}
 func (t *s2iTask) Do(ctx context.Context) v1.BuildStatus {
 	status := initializeStatusFrom(t.build.Status, t.task.BaseImage)
 
-	bc := &buildv1.BuildConfig{
-		TypeMeta: metav1.TypeMeta{
-			APIVersion: buildv1.GroupVersion.String(),
-			Kind:       "BuildConfig",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      "camel-k-" + t.build.Name,
-			Namespace: t.build.Namespace,
-			Labels:    t.build.Labels,
-		},
-		Spec: buildv1.BuildConfigSpec{
-			CommonSpec: buildv1.CommonSpec{
-				Source: buildv1.BuildSource{
-					Type: buildv1.BuildSourceBinary,
-				},
-				Strategy: buildv1.BuildStrategy{
-					DockerStrategy: &buildv1.DockerBuildStrategy{},
-				},
-				Output: buildv1.BuildOutput{
-					To: &corev1.ObjectReference{
-						Kind: "ImageStreamTag",
-						Name: "camel-k-" + t.build.Name + ":" + t.task.Tag,
-					},
-				},
-			},
-		},
-	}
-
 	// Set the build controller as owner reference
 	owner := t.getControllerReference()
 	if owner == nil {
@@ -96,11 +79,6 @@ func (t *s2iTask) Do(ctx context.Context) v1.BuildStatus {
 		owner = t.build
 	}
 
-	err := s2i.BuildConfig(ctx, t.c, bc, owner)
-	if err != nil {
-		return status.Failed(err)
-	}
-
 	is := &imagev1.ImageStream{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: imagev1.GroupVersion.String(),
@@ -118,7 +96,7 @@ func (t *s2iTask) Do(ctx context.Context) v1.BuildStatus {
 		},
 	}
 
-	err = s2i.ImageStream(ctx, t.c, is, owner)
+	err := s2i.ImageStream(ctx, t.c, is, owner)
 	if err != nil {
 		return status.Failed(err)
 	}
@@ -149,78 +127,225 @@ func (t *s2iTask) Do(ctx context.Context) v1.BuildStatus {
 			return fmt.Errorf("cannot tar context directory: %w", err)
 		}
 
-		f, err := util.Open(archive)
-		if err != nil {
-			return err
+		platforms := t.task.Configuration.ImagePlatforms
+		switch len(platforms) {
+		case 0:
+			status.Digest, err = t.buildImage(ctx, owner, archive, t.task.Tag, "")
+			if err != nil {
+				return err
+			}
+		case 1:
+			status.Digest, err = t.buildImage(ctx, owner, archive, t.task.Tag, platforms[0])
+			if err != nil {
+				return err
+			}
+			status.ImagePlatforms = []v1.ImagePlatform{{Platform: platforms[0], Digest: status.Digest}}
+		default:
+			// Build an image for each platform, on a node of the platform architecture,
+			// then assemble the manifest list referencing them
+			images := make([]v1.ImagePlatform, 0, len(platforms))
+			for _, platform := range platforms {
+				digest, err := t.buildImage(ctx, owner, archive, platformTag(t.task.Tag, platform), platform)
+				if err != nil {
+					return err
+				}
+				images = append(images, v1.ImagePlatform{Platform: platform, Digest: digest})
+			}
+			repository, err := t.imageRepository(ctx, is)
+			if err != nil {
+				return err
+			}
+			options, err := t.registryOptions()
+			if err != nil {
+				return err
+			}
+			status.Digest, err = registry.WriteManifestList(ctx, repository+":"+t.task.Tag, images, options...)
+			if err != nil {
+				return err
+			}
+			status.ImagePlatforms = images
 		}
 
-		httpCli, err := rest.HTTPClientFor(t.c.GetConfig())
-		if err != nil {
-			return err
-		}
-		restClient, err := apiutil.RESTClientForGVK(
-			schema.GroupVersionKind{Group: "build.openshift.io", Version: "v1"}, false,
-			t.c.GetConfig(), serializer.NewCodecFactory(t.c.GetScheme()), httpCli)
+		repository, err := t.imageRepository(ctx, is)
 		if err != nil {
 			return err
 		}
+		status.Image = repository + ":" + t.task.Tag
 
-		r := restClient.Post().
-			Namespace(t.build.Namespace).
-			Body(bufio.NewReader(f)).
-			Resource("buildconfigs").
-			Name("camel-k-" + t.build.Name).
-			SubResource("instantiatebinary").
-			Do(ctx)
+		return nil
+	})
 
-		if r.Error() != nil {
-			return fmt.Errorf("cannot instantiate binary: %w", r.Error())
-		}
+	if err != nil {
+		return status.Failed(err)
+	}
 
-		data, err := r.Raw()
-		if err != nil {
-			return fmt.Errorf("no raw data retrieved: %w", err)
-		}
+	return *status
+}
 
-		s2iBuild := buildv1.Build{}
-		err = json.Unmarshal(data, &s2iBuild)
+// buildImage runs a binary build of the archive, restricted to the nodes of the given platform if any,
+// and returns the digest of the image pushed with the given tag.
+func (t *s2iTask) buildImage(ctx context.Context, owner metav1.Object, archive string, tag string, platform string) (string, error) {
+	bc := &buildv1.BuildConfig{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: buildv1.GroupVersion.String(),
+			Kind:       "BuildConfig",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "camel-k-" + t.build.Name,
+			Namespace: t.build.Namespace,
+			Labels:    t.build.Labels,
+		},
+		Spec: buildv1.BuildConfigSpec{
+			CommonSpec: buildv1.CommonSpec{
+				Source: buildv1.BuildSource{
+					Type: buildv1.BuildSourceBinary,
+				},
+				Strategy: buildv1.BuildStrategy{
+					DockerStrategy: &buildv1.DockerBuildStrategy{},
+				},
+				Output: buildv1.BuildOutput{
+					To: &corev1.ObjectReference{
+						Kind: "ImageStreamTag",
+						Name: "camel-k-" + t.build.Name + ":" + tag,
+					},
+				},
+			},
+		},
+	}
+	if platform != "" {
+		nodeSelector, err := platformNodeSelector(platform)
 		if err != nil {
-			return fmt.Errorf("cannot unmarshal instantiated binary response: %w", err)
+			return "", err
 		}
+		bc.Spec.NodeSelector = nodeSelector
+	}
 
-		err = s2i.WaitForS2iBuildCompletion(ctx, t.c, &s2iBuild)
-		if err != nil {
-			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
-				//nolint:contextcheck
-				if err := s2i.CancelBuild(context.Background(), t.c, &s2iBuild); err != nil {
-					log.Errorf(err, "cannot cancel s2i Build: %s/%s", s2iBuild.Namespace, s2iBuild.Name)
-				}
+	err := s2i.BuildConfig(ctx, t.c, bc, owner)
+	if err != nil {
+		return "", err
+	}
+
+	f, err := util.Open(archive)
+	if err != nil {
+		return "", err
+	}
+	defer util.CloseQuietly(f)
+
+	httpCli, err := rest.HTTPClientFor(t.c.GetConfig())
+	if err != nil {
+		return "", err
+	}
+	restClient, err := apiutil.RESTClientForGVK(
+		schema.GroupVersionKind{Group: "build.openshift.io", Version: "v1"}, false,
+		t.c.GetConfig(), serializer.NewCodecFactory(t.c.GetScheme()), httpCli)
+	if err != nil {
+		return "", err
+	}
+
+	r := restClient.Post().
+		Namespace(t.build.Namespace).
+		Body(bufio.NewReader(f)).
+		Resource("buildconfigs").
+		Name(bc.Name).
+		SubResource("instantiatebinary").
+		Do(ctx)
+
+	if r.Error() != nil {
+		return "", fmt.Errorf("cannot instantiate binary: %w", r.Error())
+	}
+
+	data, err := r.Raw()
+	if err != nil {
+		return "", fmt.Errorf("no raw data retrieved: %w", err)
+	}
+
+	s2iBuild := buildv1.Build{}
+	err = json.Unmarshal(data, &s2iBuild)
+	if err != nil {
+		return "", fmt.Errorf("cannot unmarshal instantiated binary response: %w", err)
+	}
+
+	err = s2i.WaitForS2iBuildCompletion(ctx, t.c, &s2iBuild)
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			//nolint:contextcheck
+			if err := s2i.CancelBuild(context.Background(), t.c, &s2iBuild); err != nil {
+				log.Errorf(err, "cannot cancel s2i Build: %s/%s", s2iBuild.Namespace, s2iBuild.Name)
 			}
-			return err
-		}
-		if s2iBuild.Status.Output.To != nil {
-			status.Digest = s2iBuild.Status.Output.To.ImageDigest
 		}
+		return "", err
+	}
+	if s2iBuild.Status.Output.To != nil {
+		return s2iBuild.Status.Output.To.ImageDigest, nil
+	}
+
+	return "", nil
+}
 
-		err = t.c.Get(ctx, ctrl.ObjectKeyFromObject(is), is)
+func (t *s2iTask) imageRepository(ctx context.Context, is *imagev1.ImageStream) (string, error) {
+	err := t.c.Get(ctx, ctrl.ObjectKeyFromObject(is), is)
+	if err != nil {
+		return "", err
+	}
+
+	if is.Status.DockerImageRepository == "" {
+		return "", errors.New("dockerImageRepository not available in ImageStream")
+	}
+
+	return is.Status.DockerImageRepository, nil
+}
+
+// registryOptions authenticates to the OpenShift internal registry with the token of the current service account.
+func (t *s2iTask) registryOptions() ([]crane.Option, error) {
+	config := t.c.GetConfig()
+	token := config.BearerToken
+	if token == "" && config.BearerTokenFile != "" {
+		data, err := util.ReadFile(config.BearerTokenFile)
 		if err != nil {
-			return err
+			return nil, err
 		}
+		token = strings.TrimSpace(string(data))
+	}
 
-		if is.Status.DockerImageRepository == "" {
-			return errors.New("dockerImageRepository not available in ImageStream")
+	options := make([]crane.Option, 0)
+	if token != "" {
+		options = append(options, crane.WithAuth(&authn.Basic{Username: "serviceaccount", Password: token}))
+	}
+
+	// The internal registry certificate is signed by the service serving CA
+	if ca, err := util.ReadFile(serviceCAFile); err == nil {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			return nil, err
 		}
+		pool.AppendCertsFromPEM(ca)
+		transport := remote.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+		options = append(options, crane.WithTransport(transport))
+	}
 
-		status.Image = is.Status.DockerImageRepository + ":" + t.task.Tag
+	return options, nil
+}
 
-		return f.Close()
-	})
+// platformTag returns the tag of the image built for the given platform, e.g. `1234-linux-arm64`.
+func platformTag(tag string, platform string) string {
+	return tag + "-" + strings.ReplaceAll(platform, "/", "-")
+}
 
+// platformNodeSelector returns the node selector matching the nodes of the given platform.
+func platformNodeSelector(platform string) (map[string]string, error) {
+	p, err := containerv1.ParsePlatform(platform)
 	if err != nil {
-		return status.Failed(err)
+		return nil, fmt.Errorf("invalid image platform %s: %w", platform, err)
+	}
+	nodeSelector := map[string]string{
+		corev1.LabelArchStable: p.Architecture,
+	}
+	if p.OS != "" {
+		nodeSelector[corev1.LabelOSStable] = p.OS
 	}
 
-	return *status
+	return nodeSelector, nil
 }
 
 func (t *s2iTask) getControllerReference() metav1.Object {
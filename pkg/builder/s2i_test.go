@@ -0,0 +1,40 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestS2iPlatformTag(t *testing.T) {
+	assert.Equal(t, "1234-linux-arm64", platformTag("1234", "linux/arm64"))
+	assert.Equal(t, "1234-linux-arm64-v8", platformTag("1234", "linux/arm64/v8"))
+}
+
+func TestS2iPlatformNodeSelector(t *testing.T) {
+	nodeSelector, err := platformNodeSelector("linux/arm64/v8")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		corev1.LabelOSStable:   "linux",
+		corev1.LabelArchStable: "arm64",
+	}, nodeSelector)
+}
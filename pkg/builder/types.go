@@ -91,5 +91,7 @@ type builderContext struct {
 		SettingsSecurity []byte
 		TrustStoreName   string
 		TrustStorePass   string
+		// SharedLocalRepository is set when the local repository is a cache shared by the builder Pods
+		SharedLocalRepository bool
 	}
 }
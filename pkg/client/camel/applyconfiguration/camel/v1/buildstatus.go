@@ -27,18 +27,21 @@ import (
 // BuildStatusApplyConfiguration represents an declarative configuration of the BuildStatus type for use
 // with apply.
 type BuildStatusApplyConfiguration struct {
-	ObservedGeneration *int64                             `json:"observedGeneration,omitempty"`
-	Phase              *v1.BuildPhase                     `json:"phase,omitempty"`
-	Image              *string                            `json:"image,omitempty"`
-	Digest             *string                            `json:"digest,omitempty"`
-	RootImage          *string                            `json:"rootImage,omitempty"`
-	BaseImage          *string                            `json:"baseImage,omitempty"`
-	Artifacts          []ArtifactApplyConfiguration       `json:"artifacts,omitempty"`
-	Error              *string                            `json:"error,omitempty"`
-	Failure            *FailureApplyConfiguration         `json:"failure,omitempty"`
-	StartedAt          *metav1.Time                       `json:"startedAt,omitempty"`
-	Conditions         []BuildConditionApplyConfiguration `json:"conditions,omitempty"`
-	Duration           *string                            `json:"duration,omitempty"`
+	ObservedGeneration *int64                              `json:"observedGeneration,omitempty"`
+	Phase              *v1.BuildPhase                      `json:"phase,omitempty"`
+	Image              *string                             `json:"image,omitempty"`
+	Digest             *string                             `json:"digest,omitempty"`
+	ImagePlatforms     []ImagePlatformApplyConfiguration   `json:"imagePlatforms,omitempty"`
+	RootImage          *string                             `json:"rootImage,omitempty"`
+	BaseImage          *string                             `json:"baseImage,omitempty"`
+	Artifacts          []ArtifactApplyConfiguration        `json:"artifacts,omitempty"`
+	Error              *string                             `json:"error,omitempty"`
+	Failure            *FailureApplyConfiguration          `json:"failure,omitempty"`
+	StartedAt          *metav1.Time                        `json:"startedAt,omitempty"`
+	Conditions         []BuildConditionApplyConfiguration  `json:"conditions,omitempty"`
+	Duration           *string                             `json:"duration,omitempty"`
+	Log                *BuildLogApplyConfiguration         `json:"log,omitempty"`
+	MavenCache         *MavenCacheStatusApplyConfiguration `json:"mavenCache,omitempty"`
 }
 
 // BuildStatusApplyConfiguration constructs an declarative configuration of the BuildStatus type for use with
@@ -79,6 +82,19 @@ func (b *BuildStatusApplyConfiguration) WithDigest(value string) *BuildStatusApp
 	return b
 }
 
+// WithImagePlatforms adds the given value to the ImagePlatforms field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ImagePlatforms field.
+func (b *BuildStatusApplyConfiguration) WithImagePlatforms(values ...*ImagePlatformApplyConfiguration) *BuildStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithImagePlatforms")
+		}
+		b.ImagePlatforms = append(b.ImagePlatforms, *values[i])
+	}
+	return b
+}
+
 // WithRootImage sets the RootImage field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the RootImage field is set to the value of the last call.
@@ -152,3 +168,19 @@ func (b *BuildStatusApplyConfiguration) WithDuration(value string) *BuildStatusA
 	b.Duration = &value
 	return b
 }
+
+// WithLog sets the Log field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Log field is set to the value of the last call.
+func (b *BuildStatusApplyConfiguration) WithLog(value *BuildLogApplyConfiguration) *BuildStatusApplyConfiguration {
+	b.Log = value
+	return b
+}
+
+// WithMavenCache sets the MavenCache field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MavenCache field is set to the value of the last call.
+func (b *BuildStatusApplyConfiguration) WithMavenCache(value *MavenCacheStatusApplyConfiguration) *BuildStatusApplyConfiguration {
+	b.MavenCache = value
+	return b
+}
@@ -32,6 +32,7 @@ type IntegrationKitStatusApplyConfiguration struct {
 	BaseImage          *string                                     `json:"baseImage,omitempty"`
 	Image              *string                                     `json:"image,omitempty"`
 	Digest             *string                                     `json:"digest,omitempty"`
+	ImagePlatforms     []ImagePlatformApplyConfiguration           `json:"imagePlatforms,omitempty"`
 	Artifacts          []ArtifactApplyConfiguration                `json:"artifacts,omitempty"`
 	Failure            *FailureApplyConfiguration                  `json:"failure,omitempty"`
 	RuntimeVersion     *string                                     `json:"runtimeVersion,omitempty"`
@@ -95,6 +96,19 @@ func (b *IntegrationKitStatusApplyConfiguration) WithDigest(value string) *Integ
 	return b
 }
 
+// WithImagePlatforms adds the given value to the ImagePlatforms field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ImagePlatforms field.
+func (b *IntegrationKitStatusApplyConfiguration) WithImagePlatforms(values ...*ImagePlatformApplyConfiguration) *IntegrationKitStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithImagePlatforms")
+		}
+		b.ImagePlatforms = append(b.ImagePlatforms, *values[i])
+	}
+	return b
+}
+
 // WithArtifacts adds the given value to the Artifacts field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Artifacts field.
@@ -38,6 +38,8 @@ type IntegrationPlatformBuildSpecApplyConfiguration struct {
 	Maven                   *MavenSpecApplyConfiguration                     `json:"maven,omitempty"`
 	PublishStrategyOptions  map[string]string                                `json:"PublishStrategyOptions,omitempty"`
 	MaxRunningBuilds        *int32                                           `json:"maxRunningBuilds,omitempty"`
+	KitPooling              *KitPoolingSpecApplyConfiguration                `json:"kitPooling,omitempty"`
+	ExternalBuilder         *ExternalBuilderSpecApplyConfiguration           `json:"externalBuilder,omitempty"`
 }
 
 // IntegrationPlatformBuildSpecApplyConfiguration constructs an declarative configuration of the IntegrationPlatformBuildSpec type for use with
@@ -139,3 +141,19 @@ func (b *IntegrationPlatformBuildSpecApplyConfiguration) WithMaxRunningBuilds(va
 	b.MaxRunningBuilds = &value
 	return b
 }
+
+// WithKitPooling sets the KitPooling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the KitPooling field is set to the value of the last call.
+func (b *IntegrationPlatformBuildSpecApplyConfiguration) WithKitPooling(value *KitPoolingSpecApplyConfiguration) *IntegrationPlatformBuildSpecApplyConfiguration {
+	b.KitPooling = value
+	return b
+}
+
+// WithExternalBuilder sets the ExternalBuilder field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExternalBuilder field is set to the value of the last call.
+func (b *IntegrationPlatformBuildSpecApplyConfiguration) WithExternalBuilder(value *ExternalBuilderSpecApplyConfiguration) *IntegrationPlatformBuildSpecApplyConfiguration {
+	b.ExternalBuilder = value
+	return b
+}
@@ -27,12 +27,14 @@ import (
 // IntegrationProfileBuildSpecApplyConfiguration represents an declarative configuration of the IntegrationProfileBuildSpec type for use
 // with apply.
 type IntegrationProfileBuildSpecApplyConfiguration struct {
-	RuntimeVersion  *string                         `json:"runtimeVersion,omitempty"`
-	RuntimeProvider *v1.RuntimeProvider             `json:"runtimeProvider,omitempty"`
-	BaseImage       *string                         `json:"baseImage,omitempty"`
-	Registry        *RegistrySpecApplyConfiguration `json:"registry,omitempty"`
-	Timeout         *metav1.Duration                `json:"timeout,omitempty"`
-	Maven           *MavenSpecApplyConfiguration    `json:"maven,omitempty"`
+	RuntimeVersion  *string                                `json:"runtimeVersion,omitempty"`
+	RuntimeProvider *v1.RuntimeProvider                    `json:"runtimeProvider,omitempty"`
+	BaseImage       *string                                `json:"baseImage,omitempty"`
+	Registry        *RegistrySpecApplyConfiguration        `json:"registry,omitempty"`
+	Timeout         *metav1.Duration                       `json:"timeout,omitempty"`
+	Maven           *MavenSpecApplyConfiguration           `json:"maven,omitempty"`
+	KitPooling      *KitPoolingSpecApplyConfiguration      `json:"kitPooling,omitempty"`
+	ExternalBuilder *ExternalBuilderSpecApplyConfiguration `json:"externalBuilder,omitempty"`
 }
 
 // IntegrationProfileBuildSpecApplyConfiguration constructs an declarative configuration of the IntegrationProfileBuildSpec type for use with
@@ -88,3 +90,19 @@ func (b *IntegrationProfileBuildSpecApplyConfiguration) WithMaven(value *MavenSp
 	b.Maven = value
 	return b
 }
+
+// WithKitPooling sets the KitPooling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the KitPooling field is set to the value of the last call.
+func (b *IntegrationProfileBuildSpecApplyConfiguration) WithKitPooling(value *KitPoolingSpecApplyConfiguration) *IntegrationProfileBuildSpecApplyConfiguration {
+	b.KitPooling = value
+	return b
+}
+
+// WithExternalBuilder sets the ExternalBuilder field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExternalBuilder field is set to the value of the last call.
+func (b *IntegrationProfileBuildSpecApplyConfiguration) WithExternalBuilder(value *ExternalBuilderSpecApplyConfiguration) *IntegrationProfileBuildSpecApplyConfiguration {
+	b.ExternalBuilder = value
+	return b
+}
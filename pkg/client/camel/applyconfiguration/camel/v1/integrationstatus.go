@@ -28,24 +28,27 @@ import (
 // IntegrationStatusApplyConfiguration represents an declarative configuration of the IntegrationStatus type for use
 // with apply.
 type IntegrationStatusApplyConfiguration struct {
-	ObservedGeneration      *int64                                   `json:"observedGeneration,omitempty"`
-	Phase                   *v1.IntegrationPhase                     `json:"phase,omitempty"`
-	Digest                  *string                                  `json:"digest,omitempty"`
-	Image                   *string                                  `json:"image,omitempty"`
-	Dependencies            []string                                 `json:"dependencies,omitempty"`
-	Profile                 *v1.TraitProfile                         `json:"profile,omitempty"`
-	IntegrationKit          *corev1.ObjectReference                  `json:"integrationKit,omitempty"`
-	Platform                *string                                  `json:"platform,omitempty"`
-	GeneratedSources        []SourceSpecApplyConfiguration           `json:"generatedSources,omitempty"`
-	RuntimeVersion          *string                                  `json:"runtimeVersion,omitempty"`
-	RuntimeProvider         *v1.RuntimeProvider                      `json:"runtimeProvider,omitempty"`
-	Configuration           []ConfigurationSpecApplyConfiguration    `json:"configuration,omitempty"`
-	Conditions              []IntegrationConditionApplyConfiguration `json:"conditions,omitempty"`
-	Version                 *string                                  `json:"version,omitempty"`
-	Replicas                *int32                                   `json:"replicas,omitempty"`
-	Selector                *string                                  `json:"selector,omitempty"`
-	Capabilities            []string                                 `json:"capabilities,omitempty"`
-	InitializationTimestamp *metav1.Time                             `json:"lastInitTimestamp,omitempty"`
+	ObservedGeneration      *int64                                       `json:"observedGeneration,omitempty"`
+	Phase                   *v1.IntegrationPhase                         `json:"phase,omitempty"`
+	Digest                  *string                                      `json:"digest,omitempty"`
+	Image                   *string                                      `json:"image,omitempty"`
+	Dependencies            []string                                     `json:"dependencies,omitempty"`
+	Profile                 *v1.TraitProfile                             `json:"profile,omitempty"`
+	IntegrationKit          *corev1.ObjectReference                      `json:"integrationKit,omitempty"`
+	Platform                *string                                      `json:"platform,omitempty"`
+	GeneratedSources        []SourceSpecApplyConfiguration               `json:"generatedSources,omitempty"`
+	RuntimeVersion          *string                                      `json:"runtimeVersion,omitempty"`
+	RuntimeProvider         *v1.RuntimeProvider                          `json:"runtimeProvider,omitempty"`
+	Configuration           []ConfigurationSpecApplyConfiguration        `json:"configuration,omitempty"`
+	Conditions              []IntegrationConditionApplyConfiguration     `json:"conditions,omitempty"`
+	Version                 *string                                      `json:"version,omitempty"`
+	Replicas                *int32                                       `json:"replicas,omitempty"`
+	Selector                *string                                      `json:"selector,omitempty"`
+	Capabilities            []string                                     `json:"capabilities,omitempty"`
+	InitializationTimestamp *metav1.Time                                 `json:"lastInitTimestamp,omitempty"`
+	Metrics                 *IntegrationRuntimeMetricsApplyConfiguration `json:"metrics,omitempty"`
+	NextScheduledTransition *metav1.Time                                 `json:"nextScheduledTransition,omitempty"`
+	AuditedGeneration       *int64                                       `json:"auditedGeneration,omitempty"`
 }
 
 // IntegrationStatusApplyConfiguration constructs an declarative configuration of the IntegrationStatus type for use with
@@ -216,3 +219,27 @@ func (b *IntegrationStatusApplyConfiguration) WithInitializationTimestamp(value
 	b.InitializationTimestamp = &value
 	return b
 }
+
+// WithMetrics sets the Metrics field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Metrics field is set to the value of the last call.
+func (b *IntegrationStatusApplyConfiguration) WithMetrics(value *IntegrationRuntimeMetricsApplyConfiguration) *IntegrationStatusApplyConfiguration {
+	b.Metrics = value
+	return b
+}
+
+// WithNextScheduledTransition sets the NextScheduledTransition field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NextScheduledTransition field is set to the value of the last call.
+func (b *IntegrationStatusApplyConfiguration) WithNextScheduledTransition(value metav1.Time) *IntegrationStatusApplyConfiguration {
+	b.NextScheduledTransition = &value
+	return b
+}
+
+// WithAuditedGeneration sets the AuditedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AuditedGeneration field is set to the value of the last call.
+func (b *IntegrationStatusApplyConfiguration) WithAuditedGeneration(value int64) *IntegrationStatusApplyConfiguration {
+	b.AuditedGeneration = &value
+	return b
+}
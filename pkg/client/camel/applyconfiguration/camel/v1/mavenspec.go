@@ -26,14 +26,16 @@ import (
 // MavenSpecApplyConfiguration represents an declarative configuration of the MavenSpec type for use
 // with apply.
 type MavenSpecApplyConfiguration struct {
-	LocalRepository  *string                           `json:"localRepository,omitempty"`
-	Properties       map[string]string                 `json:"properties,omitempty"`
-	Profiles         []ValueSourceApplyConfiguration   `json:"profiles,omitempty"`
-	Settings         *ValueSourceApplyConfiguration    `json:"settings,omitempty"`
-	SettingsSecurity *ValueSourceApplyConfiguration    `json:"settingsSecurity,omitempty"`
-	CASecrets        []corev1.SecretKeySelector        `json:"caSecrets,omitempty"`
-	Extension        []MavenArtifactApplyConfiguration `json:"extension,omitempty"`
-	CLIOptions       []string                          `json:"cliOptions,omitempty"`
+	LocalRepository  *string                                   `json:"localRepository,omitempty"`
+	Properties       map[string]string                         `json:"properties,omitempty"`
+	Profiles         []ValueSourceApplyConfiguration           `json:"profiles,omitempty"`
+	Settings         *ValueSourceApplyConfiguration            `json:"settings,omitempty"`
+	SettingsSecurity *ValueSourceApplyConfiguration            `json:"settingsSecurity,omitempty"`
+	CASecrets        []corev1.SecretKeySelector                `json:"caSecrets,omitempty"`
+	Extension        []MavenArtifactApplyConfiguration         `json:"extension,omitempty"`
+	CLIOptions       []string                                  `json:"cliOptions,omitempty"`
+	Cache            *MavenCacheSpecApplyConfiguration         `json:"cache,omitempty"`
+	OfflineBundle    *MavenOfflineBundleSpecApplyConfiguration `json:"offlineBundle,omitempty"`
 }
 
 // MavenSpecApplyConfiguration constructs an declarative configuration of the MavenSpec type for use with
@@ -125,3 +127,19 @@ func (b *MavenSpecApplyConfiguration) WithCLIOptions(values ...string) *MavenSpe
 	}
 	return b
 }
+
+// WithCache sets the Cache field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cache field is set to the value of the last call.
+func (b *MavenSpecApplyConfiguration) WithCache(value *MavenCacheSpecApplyConfiguration) *MavenSpecApplyConfiguration {
+	b.Cache = value
+	return b
+}
+
+// WithOfflineBundle sets the OfflineBundle field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OfflineBundle field is set to the value of the last call.
+func (b *MavenSpecApplyConfiguration) WithOfflineBundle(value *MavenOfflineBundleSpecApplyConfiguration) *MavenSpecApplyConfiguration {
+	b.OfflineBundle = value
+	return b
+}
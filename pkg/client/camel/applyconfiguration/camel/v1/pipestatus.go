@@ -26,11 +26,12 @@ import (
 // PipeStatusApplyConfiguration represents an declarative configuration of the PipeStatus type for use
 // with apply.
 type PipeStatusApplyConfiguration struct {
-	ObservedGeneration *int64                            `json:"observedGeneration,omitempty"`
-	Phase              *v1.PipePhase                     `json:"phase,omitempty"`
-	Conditions         []PipeConditionApplyConfiguration `json:"conditions,omitempty"`
-	Replicas           *int32                            `json:"replicas,omitempty"`
-	Selector           *string                           `json:"selector,omitempty"`
+	ObservedGeneration *int64                                       `json:"observedGeneration,omitempty"`
+	Phase              *v1.PipePhase                                `json:"phase,omitempty"`
+	Conditions         []PipeConditionApplyConfiguration            `json:"conditions,omitempty"`
+	Replicas           *int32                                       `json:"replicas,omitempty"`
+	Selector           *string                                      `json:"selector,omitempty"`
+	Metrics            *IntegrationRuntimeMetricsApplyConfiguration `json:"metrics,omitempty"`
 }
 
 // PipeStatusApplyConfiguration constructs an declarative configuration of the PipeStatus type for use with
@@ -83,3 +84,11 @@ func (b *PipeStatusApplyConfiguration) WithSelector(value string) *PipeStatusApp
 	b.Selector = &value
 	return b
 }
+
+// WithMetrics sets the Metrics field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Metrics field is set to the value of the last call.
+func (b *PipeStatusApplyConfiguration) WithMetrics(value *IntegrationRuntimeMetricsApplyConfiguration) *PipeStatusApplyConfiguration {
+	b.Metrics = value
+	return b
+}
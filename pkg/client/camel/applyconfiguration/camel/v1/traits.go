@@ -38,6 +38,8 @@ type TraitsApplyConfiguration struct {
 	ErrorHandler    *trait.ErrorHandlerTrait                `json:"error-handler,omitempty"`
 	GC              *trait.GCTrait                          `json:"gc,omitempty"`
 	Health          *trait.HealthTrait                      `json:"health,omitempty"`
+	HPA             *trait.HPATrait                         `json:"hpa,omitempty"`
+	Idle            *trait.IdleTrait                        `json:"idle,omitempty"`
 	Ingress         *trait.IngressTrait                     `json:"ingress,omitempty"`
 	Istio           *trait.IstioTrait                       `json:"istio,omitempty"`
 	Jolokia         *trait.JolokiaTrait                     `json:"jolokia,omitempty"`
@@ -57,6 +59,7 @@ type TraitsApplyConfiguration struct {
 	Quarkus         *trait.QuarkusTrait                     `json:"quarkus,omitempty"`
 	Registry        *trait.RegistryTrait                    `json:"registry,omitempty"`
 	Route           *trait.RouteTrait                       `json:"route,omitempty"`
+	Schedule        *trait.ScheduleTrait                    `json:"schedule,omitempty"`
 	SecurityContext *trait.SecurityContextTrait             `json:"security-context,omitempty"`
 	Service         *trait.ServiceTrait                     `json:"service,omitempty"`
 	ServiceBinding  *trait.ServiceBindingTrait              `json:"service-binding,omitempty"`
@@ -171,6 +174,22 @@ func (b *TraitsApplyConfiguration) WithHealth(value trait.HealthTrait) *TraitsAp
 	return b
 }
 
+// WithHPA sets the HPA field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HPA field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithHPA(value trait.HPATrait) *TraitsApplyConfiguration {
+	b.HPA = &value
+	return b
+}
+
+// WithIdle sets the Idle field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Idle field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithIdle(value trait.IdleTrait) *TraitsApplyConfiguration {
+	b.Idle = &value
+	return b
+}
+
 // WithIngress sets the Ingress field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Ingress field is set to the value of the last call.
@@ -323,6 +342,14 @@ func (b *TraitsApplyConfiguration) WithRoute(value trait.RouteTrait) *TraitsAppl
 	return b
 }
 
+// WithSchedule sets the Schedule field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Schedule field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithSchedule(value trait.ScheduleTrait) *TraitsApplyConfiguration {
+	b.Schedule = &value
+	return b
+}
+
 // WithSecurityContext sets the SecurityContext field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the SecurityContext field is set to the value of the last call.
@@ -40,6 +40,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.BaseTaskApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Build"):
 		return &camelv1.BuildApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("BuildLog"):
+		return &camelv1.BuildLogApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("BuildahTask"):
 		return &camelv1.BuildahTaskApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("BuildCondition"):
@@ -94,6 +96,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.ErrorHandlerSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EventTypeSpec"):
 		return &camelv1.EventTypeSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ExternalBuilderSpec"):
+		return &camelv1.ExternalBuilderSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ExternalDocumentation"):
 		return &camelv1.ExternalDocumentationApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Failure"):
@@ -106,6 +110,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.HeaderSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HealthCheckResponse"):
 		return &camelv1.HealthCheckResponseApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ImagePlatform"):
+		return &camelv1.ImagePlatformApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Integration"):
 		return &camelv1.IntegrationApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IntegrationCondition"):
@@ -132,6 +138,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.IntegrationPlatformSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IntegrationPlatformStatus"):
 		return &camelv1.IntegrationPlatformStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IntegrationPolicy"):
+		return &camelv1.IntegrationPolicyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IntegrationPolicySpec"):
+		return &camelv1.IntegrationPolicySpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IntegrationPolicySpec"):
+		return &camelv1.IntegrationPolicySpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IntegrationProfile"):
 		return &camelv1.IntegrationProfileApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IntegrationProfileBuildSpec"):
@@ -144,6 +156,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.IntegrationProfileSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IntegrationProfileStatus"):
 		return &camelv1.IntegrationProfileStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IntegrationRuntimeMetrics"):
+		return &camelv1.IntegrationRuntimeMetricsApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IntegrationSpec"):
 		return &camelv1.IntegrationSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IntegrationStatus"):
@@ -172,10 +186,18 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.KanikoTaskApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("KanikoTaskCache"):
 		return &camelv1.KanikoTaskCacheApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("KitPoolingSpec"):
+		return &camelv1.KitPoolingSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("MavenArtifact"):
 		return &camelv1.MavenArtifactApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("MavenBuildSpec"):
 		return &camelv1.MavenBuildSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("MavenCacheSpec"):
+		return &camelv1.MavenCacheSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("MavenCacheStatus"):
+		return &camelv1.MavenCacheStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("MavenOfflineBundleSpec"):
+		return &camelv1.MavenOfflineBundleSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("MavenSpec"):
 		return &camelv1.MavenSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Pipe"):
@@ -216,6 +238,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.TemplateApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("TraitConfiguration"):
 		return &camelv1.TraitConfigurationApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("TraitPolicy"):
+		return &camelv1.TraitPolicyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("TraitPolicy"):
+		return &camelv1.TraitPolicyApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Traits"):
 		return &camelv1.TraitsApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("TraitSpec"):
@@ -34,6 +34,7 @@ type CamelV1Interface interface {
 	IntegrationsGetter
 	IntegrationKitsGetter
 	IntegrationPlatformsGetter
+	IntegrationPoliciesGetter
 	IntegrationProfilesGetter
 	KameletsGetter
 	PipesGetter
@@ -64,6 +65,10 @@ func (c *CamelV1Client) IntegrationPlatforms(namespace string) IntegrationPlatfo
 	return newIntegrationPlatforms(c, namespace)
 }
 
+func (c *CamelV1Client) IntegrationPolicies(namespace string) IntegrationPolicyInterface {
+	return newIntegrationPolicies(c, namespace)
+}
+
 func (c *CamelV1Client) IntegrationProfiles(namespace string) IntegrationProfileInterface {
 	return newIntegrationProfiles(c, namespace)
 }
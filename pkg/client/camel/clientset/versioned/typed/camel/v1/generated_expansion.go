@@ -29,6 +29,8 @@ type IntegrationKitExpansion interface{}
 
 type IntegrationPlatformExpansion interface{}
 
+type IntegrationPolicyExpansion interface{}
+
 type IntegrationProfileExpansion interface{}
 
 type KameletExpansion interface{}
@@ -35,6 +35,8 @@ type Interface interface {
 	IntegrationKits() IntegrationKitInformer
 	// IntegrationPlatforms returns a IntegrationPlatformInformer.
 	IntegrationPlatforms() IntegrationPlatformInformer
+	// IntegrationPolicies returns a IntegrationPolicyInformer.
+	IntegrationPolicies() IntegrationPolicyInformer
 	// IntegrationProfiles returns a IntegrationProfileInformer.
 	IntegrationProfiles() IntegrationProfileInformer
 	// Kamelets returns a KameletInformer.
@@ -79,6 +81,11 @@ func (v *version) IntegrationPlatforms() IntegrationPlatformInformer {
 	return &integrationPlatformInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// IntegrationPolicies returns a IntegrationPolicyInformer.
+func (v *version) IntegrationPolicies() IntegrationPolicyInformer {
+	return &integrationPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // IntegrationProfiles returns a IntegrationProfileInformer.
 func (v *version) IntegrationProfiles() IntegrationProfileInformer {
 	return &integrationProfileInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -65,6 +65,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Camel().V1().IntegrationKits().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("integrationplatforms"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Camel().V1().IntegrationPlatforms().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("integrationpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Camel().V1().IntegrationPolicies().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("integrationprofiles"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Camel().V1().IntegrationProfiles().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("kamelets"):
@@ -59,6 +59,14 @@ type IntegrationPlatformListerExpansion interface{}
 // IntegrationPlatformNamespaceLister.
 type IntegrationPlatformNamespaceListerExpansion interface{}
 
+// IntegrationPolicyListerExpansion allows custom methods to be added to
+// IntegrationPolicyLister.
+type IntegrationPolicyListerExpansion interface{}
+
+// IntegrationPolicyNamespaceListerExpansion allows custom methods to be added to
+// IntegrationPolicyNamespaceLister.
+type IntegrationPolicyNamespaceListerExpansion interface{}
+
 // IntegrationProfileListerExpansion allows custom methods to be added to
 // IntegrationProfileLister.
 type IntegrationProfileListerExpansion interface{}
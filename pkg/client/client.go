@@ -118,6 +118,24 @@ func NewOutOfClusterClient(kubeconfig string) (Client, error) {
 	return NewClient(true)
 }
 
+// NewOutOfClusterClientForContext creates a new k8s client targeting the given kube config file and context.
+// Unlike NewOutOfClusterClient, it does not alter the process wide configuration, so that it can be used along
+// with the default client to reach a different cluster.
+func NewOutOfClusterClientForContext(kubeconfig string, kubeContext string) (Client, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if kubeconfig != "" {
+		rules.ExplicitPath = kubeconfig
+	}
+	overrides := &clientcmd.ConfigOverrides{
+		CurrentContext: kubeContext,
+	}
+	cfg, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides).ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	return NewClientWithConfig(true, cfg)
+}
+
 // NewClient creates a new k8s client that can be used from outside or in the cluster.
 func NewClient(fastDiscovery bool) (Client, error) {
 	cfg, err := config.GetConfig()
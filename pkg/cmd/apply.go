@@ -0,0 +1,88 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// newCmdApply --.
+func newCmdApply(rootCmdOptions *RootCmdOptions) (*cobra.Command, *applyCmdOptions) {
+	options := applyCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+	cmd := cobra.Command{
+		Use:     "apply -f project.yaml",
+		Short:   "Apply a project manifest declaring several Integrations, Pipes and Kamelets",
+		Long:    "Create, update and prune the Integrations, Pipes and Kamelets declared by a project manifest, so that the cluster matches the manifest.",
+		Args:    cobra.NoArgs,
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(); err != nil {
+				return err
+			}
+			return options.run(cmd)
+		},
+	}
+
+	cmd.Flags().StringP("file", "f", "", "The project manifest to apply")
+	cmd.Flags().Bool("dry-run", false, "Only print the plan, without changing any resource")
+	cmd.Flags().Bool("prune", false, "Delete the resources of the project which are no longer declared in the manifest")
+
+	return &cmd, &options
+}
+
+type applyCmdOptions struct {
+	*RootCmdOptions
+	File   string `mapstructure:"file" yaml:",omitempty"`
+	DryRun bool   `mapstructure:"dry-run" yaml:",omitempty"`
+	Prune  bool   `mapstructure:"prune" yaml:",omitempty"`
+}
+
+func (o *applyCmdOptions) validate() error {
+	if o.File == "" {
+		return errMissingProjectFile
+	}
+
+	return nil
+}
+
+func (o *applyCmdOptions) run(cmd *cobra.Command) error {
+	p, err := loadProject(o.File)
+	if err != nil {
+		return err
+	}
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+	desired, err := p.resources(o.Context, cmd, c, o.Namespace)
+	if err != nil {
+		return err
+	}
+	plan, err := planProject(o.Context, c, p, o.Namespace, desired, o.Prune)
+	if err != nil {
+		return err
+	}
+	printProjectPlan(cmd, p, plan)
+	if o.DryRun {
+		return nil
+	}
+
+	return executeProjectPlan(o.Context, cmd, c, plan)
+}
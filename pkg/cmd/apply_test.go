@@ -0,0 +1,253 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const cmdApply = "apply"
+
+const projectTestRoute = `- from:
+    uri: "timer:tick"
+    steps:
+      - to: "log:info"
+`
+
+const projectTestPipe = `apiVersion: camel.apache.org/v1
+kind: Pipe
+metadata:
+  name: my-pipe
+spec:
+  source:
+    uri: timer:tick
+  sink:
+    uri: log:info
+`
+
+func initializeApplyCmdOptions(t *testing.T, initObjs ...runtime.Object) (*cobra.Command, client.Client) {
+	t.Helper()
+	defaultPlatform := v1.NewIntegrationPlatform("default", platform.DefaultPlatformName)
+	fakeClient, err := test.NewFakeClient(append(initObjs, &defaultPlatform)...)
+	require.NoError(t, err)
+
+	return newTestApplyCmd(t, fakeClient), fakeClient
+}
+
+func newTestApplyCmd(t *testing.T, c client.Client) *cobra.Command {
+	t.Helper()
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(c)
+	options.Namespace = "default"
+	addTestApplyCmd(*options, rootCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return rootCmd
+}
+
+func addTestApplyCmd(options RootCmdOptions, rootCmd *cobra.Command) *applyCmdOptions {
+	applyCmd, applyOptions := newCmdApply(&options)
+	applyCmd.Args = test.ArbitraryArgs
+	rootCmd.AddCommand(applyCmd)
+	return applyOptions
+}
+
+func writeTestProject(t *testing.T, manifest string) string {
+	t.Helper()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "route.yaml"), []byte(projectTestRoute), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pipe.yaml"), []byte(projectTestPipe), 0o600))
+	file := filepath.Join(dir, "project.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(manifest), 0o600))
+	return file
+}
+
+func TestApplyMissingFile(t *testing.T) {
+	rootCmd, _ := initializeApplyCmdOptions(t)
+	_, err := test.ExecuteCommand(rootCmd, cmdApply)
+	require.Error(t, err)
+	assert.Equal(t, errMissingProjectFile, err)
+}
+
+func TestApplyInvalidProject(t *testing.T) {
+	file := writeTestProject(t, `
+name: my-project
+integrations:
+- name: first
+  sources: [route.yaml]
+- name: first
+  sources: [route.yaml]
+`)
+	rootCmd, _ := initializeApplyCmdOptions(t)
+	_, err := test.ExecuteCommand(rootCmd, cmdApply, "-f", file)
+	require.Error(t, err)
+	assert.Equal(t, "integration first is declared more than once", err.Error())
+}
+
+func TestApplyProject(t *testing.T) {
+	file := writeTestProject(t, `
+name: my-project
+traits:
+- container.limit-memory=1Gi
+properties:
+- greeting=hello
+dependencies:
+- mvn:org.acme:my-model:1.0.0
+integrations:
+- name: first
+  sources: [route.yaml]
+  traits:
+  - logging.level=DEBUG
+pipes:
+- pipe.yaml
+`)
+	rootCmd, c := initializeApplyCmdOptions(t)
+
+	output, err := test.ExecuteCommand(rootCmd, cmdApply, "-f", file, "--dry-run")
+	require.NoError(t, err)
+	assert.Equal(t, `Plan for project my-project:
+  + Integration first (create)
+  + Pipe my-pipe (create)
+`, output)
+
+	output, err = test.ExecuteCommand(newTestApplyCmd(t, c), cmdApply, "-f", file)
+	require.NoError(t, err)
+	assert.Equal(t, `Plan for project my-project:
+  + Integration first (create)
+  + Pipe my-pipe (create)
+Integration "first" created
+Pipe "my-pipe" created
+`, output)
+
+	it := v1.NewIntegration("default", "first")
+	require.NoError(t, c.Get(context.TODO(), ctrl.ObjectKeyFromObject(&it), &it))
+	assert.Equal(t, "my-project", it.Labels[kubernetes.CamelProjectLabel])
+	assert.Len(t, it.Spec.Flows, 1)
+	assert.Equal(t, "1Gi", it.Spec.Traits.Container.LimitMemory)
+	assert.Equal(t, "DEBUG", it.Spec.Traits.Logging.Level)
+	assert.Equal(t, []string{"greeting=hello"}, it.Spec.Traits.Camel.Properties)
+
+	pipe := v1.NewPipe("default", "my-pipe")
+	require.NoError(t, c.Get(context.TODO(), ctrl.ObjectKeyFromObject(&pipe), &pipe))
+	assert.Equal(t, "my-project", pipe.Labels[kubernetes.CamelProjectLabel])
+	assert.Equal(t, "1Gi", pipe.Spec.Integration.Traits.Container.LimitMemory)
+	assert.Equal(t, []string{"mvn:org.acme:my-model:1.0.0"}, pipe.Spec.Integration.Dependencies)
+
+	// Applying the same manifest is a no-op
+	output, err = test.ExecuteCommand(newTestApplyCmd(t, c), cmdApply, "-f", file)
+	require.NoError(t, err)
+	assert.Equal(t, `Plan for project my-project:
+  = Integration first (unchanged)
+  = Pipe my-pipe (unchanged)
+`, output)
+}
+
+func TestApplyProjectUpdateAndPrune(t *testing.T) {
+	file := writeTestProject(t, `
+name: my-project
+integrations:
+- name: first
+  sources: [route.yaml]
+`)
+	stale := v1.NewIntegration("default", "stale")
+	stale.Labels = map[string]string{kubernetes.CamelProjectLabel: "my-project"}
+	first := v1.NewIntegration("default", "first")
+	first.Labels = map[string]string{kubernetes.CamelProjectLabel: "my-project"}
+	other := v1.NewIntegration("default", "other")
+	rootCmd, c := initializeApplyCmdOptions(t, &stale, &first, &other)
+
+	// The resources are only pruned on demand
+	output, err := test.ExecuteCommand(rootCmd, cmdApply, "-f", file, "--dry-run")
+	require.NoError(t, err)
+	assert.Equal(t, `Plan for project my-project:
+  ~ Integration first (update)
+`, output)
+
+	output, err = test.ExecuteCommand(newTestApplyCmd(t, c), cmdApply, "-f", file, "--prune")
+	require.NoError(t, err)
+	assert.Equal(t, `Plan for project my-project:
+  ~ Integration first (update)
+  - Integration stale (prune)
+Integration "first" updated
+Integration "stale" deleted
+`, output)
+
+	list := v1.NewIntegrationList()
+	require.NoError(t, c.List(context.TODO(), &list))
+	names := make([]string, 0)
+	for _, it := range list.Items {
+		names = append(names, it.Name)
+	}
+	assert.ElementsMatch(t, []string{"first", "other"}, names)
+}
+
+func TestApplyProjectNotOwned(t *testing.T) {
+	file := writeTestProject(t, `
+name: my-project
+integrations:
+- name: first
+  sources: [route.yaml]
+`)
+	first := v1.NewIntegration("default", "first")
+	first.Labels = map[string]string{kubernetes.CamelProjectLabel: "another-project"}
+	rootCmd, _ := initializeApplyCmdOptions(t, &first)
+
+	_, err := test.ExecuteCommand(rootCmd, cmdApply, "-f", file)
+	require.Error(t, err)
+	assert.Equal(t, "Integration first already exists and is not part of project my-project", err.Error())
+}
+
+func TestDeleteProject(t *testing.T) {
+	file := writeTestProject(t, `
+name: my-project
+integrations:
+- name: first
+  sources: [route.yaml]
+`)
+	first := v1.NewIntegration("default", "first")
+	first.Labels = map[string]string{kubernetes.CamelProjectLabel: "my-project"}
+	other := v1.NewIntegration("default", "other")
+	defaultPlatform := v1.NewIntegrationPlatform("default", platform.DefaultPlatformName)
+	fakeClient, err := test.NewFakeClient(&first, &other, &defaultPlatform)
+	require.NoError(t, err)
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	deleteCmd, _ := newCmdDelete(options)
+	rootCmd.AddCommand(deleteCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	output, err := test.ExecuteCommand(rootCmd, cmdDelete, "-f", file)
+	require.NoError(t, err)
+	assert.Equal(t, "Integration \"first\" deleted\n", output)
+
+	_, err = test.ExecuteCommand(rootCmd, cmdDelete, "-f", file, "--all")
+	require.Error(t, err)
+}
@@ -0,0 +1,265 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/util/audit"
+)
+
+func newCmdAudit(rootCmdOptions *RootCmdOptions) (*cobra.Command, *auditCmdOptions) {
+	options := auditCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+	cmd := cobra.Command{
+		Use:   "audit <integration>",
+		Short: "Show the audit trail of an integration",
+		Long: `Show who changed an integration and what was changed, from the audit trail recorded by the operator.
+The entries are read from the audit sink the operator is configured with: from the logs of the current operator Pod
+with the stdout sink, or from the file of the entries, through the operator Pod, with the file sink. The entries
+posted to a webhook sink cannot be read back, and must be queried from the webhook, or from a local file of entries.
+The logs only hold the entries recorded since the operator Pod started: the entries recorded by a previous operator
+Pod, or before a restart, are not shown.`,
+		Args:    options.validateArgs,
+		PreRunE: decode(&options, options.Flags),
+		RunE:    options.run,
+	}
+
+	cmd.Flags().String("operator-namespace", "", "The namespace of the operator recording the audit trail, defaults to the integration namespace")
+	cmd.Flags().String("file", "", "Read the audit entries from the given local file instead of the operator audit sink")
+	cmd.Flags().StringP("output", "o", "", "Output format. One of: json")
+
+	return &cmd, &options
+}
+
+type auditCmdOptions struct {
+	*RootCmdOptions   `json:"-"`
+	OperatorNamespace string `mapstructure:"operator-namespace" yaml:",omitempty"`
+	File              string `mapstructure:"file" yaml:",omitempty"`
+	OutputFormat      string `mapstructure:"output" yaml:",omitempty"`
+}
+
+func (o *auditCmdOptions) validateArgs(_ *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return errors.New("audit expects an integration name argument")
+	}
+	return nil
+}
+
+func (o *auditCmdOptions) run(cmd *cobra.Command, args []string) error {
+	if o.OutputFormat != "" && o.OutputFormat != "json" {
+		return fmt.Errorf("invalid output format %s, only json is supported", o.OutputFormat)
+	}
+
+	var source io.ReadCloser
+	if o.File != "" {
+		f, err := os.Open(o.File)
+		if err != nil {
+			return err
+		}
+		source = f
+	} else {
+		c, err := o.GetCmdClient()
+		if err != nil {
+			return err
+		}
+		if source, err = o.operatorEntries(c); err != nil {
+			return err
+		}
+	}
+	defer source.Close()
+
+	entries, err := readAuditEntries(source, o.Namespace, args[0])
+	if err != nil {
+		return err
+	}
+
+	if o.OutputFormat == "json" {
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		for _, entry := range entries {
+			if err := encoder.Encode(entry); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	entries = withDigests(entries)
+	if len(entries) == 0 {
+		fmt.Fprintf(cmd.OutOrStdout(), "No audit entries found for integration %s\n", args[0])
+		return nil
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "TIMESTAMP\tACTION\tUSER\tGENERATION\tDIGEST\tCHANGES")
+	for _, entry := range entries {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\n", entry.Timestamp.Format(time.RFC3339), entry.Action, entry.User,
+			entry.Generation, entry.Digest, strings.Join(entry.Changes, ", "))
+	}
+
+	return w.Flush()
+}
+
+// operatorEntries returns the audit entries recorded by the operator, read from the sink it is configured with.
+func (o *auditCmdOptions) operatorEntries(c client.Client) (io.ReadCloser, error) {
+	ns := o.OperatorNamespace
+	if ns == "" {
+		ns = o.Namespace
+	}
+	pod := platform.GetOperatorPod(o.Context, c, ns)
+	if pod == nil {
+		return nil, fmt.Errorf("could not find the operator Pod in namespace %s, use the --operator-namespace flag", ns)
+	}
+
+	sink := operatorAuditSink(pod)
+	switch {
+	case sink == "stdout":
+		// The entries logged by the previous operator Pods, or before the operator container restarted, are not included
+		return c.CoreV1().Pods(ns).GetLogs(pod.Name, &corev1.PodLogOptions{}).Stream(o.Context)
+	case strings.HasPrefix(sink, "file:"):
+		return o.operatorFile(c, pod, strings.TrimPrefix(sink, "file:"))
+	case sink == "":
+		return nil, fmt.Errorf("the audit trail is not enabled on the operator Pod %s, the %s environment variable is not set",
+			pod.Name, audit.SinkEnvVariable)
+	default:
+		return nil, fmt.Errorf("the audit entries are posted to the webhook %s, they must be queried from the webhook, "+
+			"or from a local file of entries with the --file flag", sink)
+	}
+}
+
+// operatorAuditSink returns the audit sink the operator container is configured with.
+func operatorAuditSink(pod *corev1.Pod) string {
+	for _, env := range pod.Spec.Containers[0].Env {
+		if env.Name == audit.SinkEnvVariable {
+			return env.Value
+		}
+	}
+	return ""
+}
+
+// operatorFile streams the file of the audit entries from the operator container, e.g. on a persistent volume.
+func (o *auditCmdOptions) operatorFile(c client.Client, pod *corev1.Pod, path string) (io.ReadCloser, error) {
+	r := c.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("exec")
+
+	r.VersionedParams(&corev1.PodExecOptions{
+		Container: pod.Spec.Containers[0].Name,
+		Command:   []string{"cat", path},
+		Stdout:    true,
+		Stderr:    true,
+		TTY:       false,
+	}, scheme.ParameterCodec)
+
+	exec, err := remotecommand.NewSPDYExecutor(c.GetConfig(), "POST", r.URL())
+	if err != nil {
+		return nil, err
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		var stderr bytes.Buffer
+		if err := exec.StreamWithContext(o.Context, remotecommand.StreamOptions{
+			Stdout: writer,
+			Stderr: &stderr,
+			Tty:    false,
+		}); err != nil {
+			_ = writer.CloseWithError(fmt.Errorf("cannot read the audit entries from %s in the operator Pod %s: %w: %s",
+				path, pod.Name, err, strings.TrimSpace(stderr.String())))
+			return
+		}
+		_ = writer.Close()
+	}()
+
+	return reader, nil
+}
+
+// readAuditEntries reads the audit entries of the Integration, skipping any other line.
+func readAuditEntries(r io.Reader, namespace string, name string) ([]audit.Entry, error) {
+	entries := make([]audit.Entry, 0)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		entry, ok := audit.Parse(scanner.Bytes())
+		if ok && entry.Namespace == namespace && entry.Integration == name {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, scanner.Err()
+}
+
+// withDigests sets the digest recorded by the reconciled entries on the changes they result from, and drops them.
+func withDigests(entries []audit.Entry) []audit.Entry {
+	res := make([]audit.Entry, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Action != audit.ActionReconciled {
+			res = append(res, entry)
+			continue
+		}
+		for i := len(res) - 1; i >= 0 && res[i].Digest == ""; i-- {
+			if res[i].Action != audit.ActionDeleted && res[i].Generation <= entry.Generation {
+				res[i].Digest = entry.Digest
+			}
+		}
+	}
+
+	return res
+}
+
+// currentUser returns the name of the user kamel is authenticated as, or an empty string if it cannot be determined.
+func currentUser(ctx context.Context, c client.Client) string {
+	review, err := c.AuthenticationV1().SelfSubjectReviews().Create(ctx, &authenticationv1.SelfSubjectReview{}, metav1.CreateOptions{})
+	if err != nil {
+		return ""
+	}
+	return review.Status.UserInfo.Username
+}
+
+// setChangedBy records the user in the Integration, for the operator audit trail, on every write made with kamel.
+// The user recorded by a previous write is cleared when the current user cannot be determined, so that the change
+// is not attributed to them.
+func setChangedBy(it *v1.Integration, user string) {
+	if user == "" {
+		delete(it.Annotations, v1.IntegrationChangedByAnnotation)
+		return
+	}
+	v1.SetAnnotation(&it.ObjectMeta, v1.IntegrationChangedByAnnotation, user)
+}
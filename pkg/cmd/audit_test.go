@@ -0,0 +1,161 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/util/audit"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+)
+
+const auditTestEntries = `{"level":"info","ts":1704067200,"logger":"camel-k.controller.integration","msg":"Reconciling Integration"}
+{"kind":"IntegrationAuditEntry","timestamp":"2024-01-01T00:00:00Z","action":"created","namespace":"default","integration":"my-it","generation":1,"user":"alice"}
+{"kind":"IntegrationAuditEntry","timestamp":"2024-01-01T00:00:00Z","action":"created","namespace":"default","integration":"other-it","generation":1,"user":"alice"}
+{"kind":"IntegrationAuditEntry","timestamp":"2024-01-01T00:01:00Z","action":"reconciled","namespace":"default","integration":"my-it","generation":1,"digest":"d1"}
+{"kind":"IntegrationAuditEntry","timestamp":"2024-01-02T10:30:00Z","action":"updated","namespace":"default","integration":"my-it","generation":2,"user":"bob","changes":["trait cron added","dependency camel:kafka added"]}
+{"kind":"IntegrationAuditEntry","timestamp":"2024-01-02T10:31:00Z","action":"reconciled","namespace":"default","integration":"my-it","generation":2,"digest":"d2"}
+{"kind":"IntegrationAuditEntry","timestamp":"2024-01-02T11:00:00Z","action":"created","namespace":"other","integration":"my-it","generation":1,"user":"carol"}
+{"kind":"IntegrationAuditEntry","timestamp":"2024-01-03T09:00:00Z","action":"updated","namespace":"default","integration":"my-it","generation":3,"user":"carol"}
+`
+
+func initializeAuditCmdOptions(t *testing.T, initObjs ...runtime.Object) *cobra.Command {
+	t.Helper()
+	defaultPlatform := v1.NewIntegrationPlatform("default", platform.DefaultPlatformName)
+	fakeClient, err := test.NewFakeClient(append(initObjs, &defaultPlatform)...)
+	require.NoError(t, err)
+
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	auditCmd, _ := newCmdAudit(options)
+	rootCmd.AddCommand(auditCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return rootCmd
+}
+
+func writeAuditTestEntries(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "audit.log")
+	require.NoError(t, os.WriteFile(path, []byte(auditTestEntries), 0o600))
+	return path
+}
+
+func TestAuditFromFile(t *testing.T) {
+	rootCmd := initializeAuditCmdOptions(t)
+
+	output, err := test.ExecuteCommand(rootCmd, "audit", "my-it", "--file", writeAuditTestEntries(t))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	require.Len(t, lines, 4)
+	assert.Equal(t, []string{"TIMESTAMP", "ACTION", "USER", "GENERATION", "DIGEST", "CHANGES"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"2024-01-01T00:00:00Z", "created", "alice", "1", "d1"}, strings.Fields(lines[1]))
+	assert.Contains(t, lines[2], "bob")
+	assert.Contains(t, lines[2], "d2")
+	assert.Contains(t, lines[2], "trait cron added, dependency camel:kafka added")
+	// Not reconciled yet
+	assert.Equal(t, []string{"2024-01-03T09:00:00Z", "updated", "carol", "3"}, strings.Fields(lines[3]))
+}
+
+func TestAuditJSONOutput(t *testing.T) {
+	rootCmd := initializeAuditCmdOptions(t)
+
+	output, err := test.ExecuteCommand(rootCmd, "audit", "my-it", "--file", writeAuditTestEntries(t), "-o", "json")
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	require.Len(t, lines, 5)
+	assert.Contains(t, lines[1], `"action":"reconciled"`)
+	assert.Contains(t, lines[2], `"action":"updated"`)
+	assert.Contains(t, lines[2], `"user":"bob"`)
+}
+
+func TestAuditNoEntries(t *testing.T) {
+	rootCmd := initializeAuditCmdOptions(t)
+
+	output, err := test.ExecuteCommand(rootCmd, "audit", "unknown-it", "--file", writeAuditTestEntries(t))
+	require.NoError(t, err)
+	assert.Equal(t, "No audit entries found for integration unknown-it\n", output)
+}
+
+func TestAuditErrors(t *testing.T) {
+	rootCmd := initializeAuditCmdOptions(t)
+
+	_, err := test.ExecuteCommand(rootCmd, "audit")
+	require.EqualError(t, err, "audit expects an integration name argument")
+
+	_, err = test.ExecuteCommand(rootCmd, "audit", "my-it", "-o", "yaml")
+	require.EqualError(t, err, "invalid output format yaml, only json is supported")
+
+	_, err = test.ExecuteCommand(rootCmd, "audit", "my-it", "-o", "", "--operator-namespace", "camel-k")
+	require.EqualError(t, err, "could not find the operator Pod in namespace camel-k, use the --operator-namespace flag")
+}
+
+func auditOperatorPod(sink string) *corev1.Pod {
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "camel-k",
+			Name:      "camel-k-operator",
+			Labels:    map[string]string{"camel.apache.org/component": "operator"},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "camel-k-operator"}},
+		},
+	}
+	if sink != "" {
+		pod.Spec.Containers[0].Env = []corev1.EnvVar{{Name: audit.SinkEnvVariable, Value: sink}}
+	}
+	return &pod
+}
+
+func TestAuditOperatorSink(t *testing.T) {
+	rootCmd := initializeAuditCmdOptions(t, auditOperatorPod(""))
+	_, err := test.ExecuteCommand(rootCmd, "audit", "my-it", "--operator-namespace", "camel-k")
+	require.EqualError(t, err, "the audit trail is not enabled on the operator Pod camel-k-operator, the CAMEL_K_AUDIT_SINK environment variable is not set")
+
+	rootCmd = initializeAuditCmdOptions(t, auditOperatorPod("https://audit/entries"))
+	_, err = test.ExecuteCommand(rootCmd, "audit", "my-it", "--operator-namespace", "camel-k")
+	require.EqualError(t, err, "the audit entries are posted to the webhook https://audit/entries, they must be queried from the webhook, "+
+		"or from a local file of entries with the --file flag")
+
+	assert.Equal(t, "file:/audit/entries.log", operatorAuditSink(auditOperatorPod("file:/audit/entries.log")))
+}
+
+func TestSetChangedBy(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	setChangedBy(&it, "alice")
+	assert.Equal(t, "alice", it.Annotations[v1.IntegrationChangedByAnnotation])
+	setChangedBy(&it, "bob")
+	assert.Equal(t, "bob", it.Annotations[v1.IntegrationChangedByAnnotation])
+
+	// The previous user is cleared when the current user cannot be determined
+	setChangedBy(&it, "")
+	assert.NotContains(t, it.Annotations, v1.IntegrationChangedByAnnotation)
+}
@@ -0,0 +1,78 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+)
+
+func newCmdBuild(rootCmdOptions *RootCmdOptions) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "build",
+		Short: "Inspect and manage the Builds of the Integration Kits",
+		Long:  `Inspect and manage the Builds of the Integration Kits.`,
+	}
+
+	cmd.AddCommand(cmdOnly(newBuildGetCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newBuildDescribeCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newBuildLogsCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newBuildCancelCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newBuildRetryCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newBuildDeleteCmd(rootCmdOptions)))
+
+	return &cmd
+}
+
+func getBuild(ctx context.Context, c ctrl.Reader, namespace string, name string) (*v1.Build, error) {
+	build := v1.NewBuild(namespace, name)
+	if err := c.Get(ctx, ctrl.ObjectKeyFromObject(build), build); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, fmt.Errorf("no build found with name \"%s\"", name)
+		}
+		return nil, err
+	}
+
+	return build, nil
+}
+
+// getBuilderPod returns the Pod running the Build, if any.
+func getBuilderPod(ctx context.Context, c client.Client, build *v1.Build) (*corev1.Pod, error) {
+	if build.BuilderConfiguration().Strategy != v1.BuildStrategyPod {
+		return nil, nil
+	}
+	pods, err := c.CoreV1().Pods(build.BuilderPodNamespace()).List(ctx, metav1.ListOptions{
+		LabelSelector: v1.BuildLabel + "=" + build.Name,
+	})
+	if err != nil || len(pods.Items) == 0 {
+		return nil, err
+	}
+
+	return &pods.Items[0], nil
+}
@@ -0,0 +1,76 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+func newBuildCancelCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *buildCancelCommandOptions) {
+	options := buildCancelCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "cancel <build>",
+		Short:   "Cancel a Build",
+		Long:    `Cancel a Build which has not completed, whatever its build strategy (routine, pod, tekton or external). The operator interrupts the Build, which ends up in the Cancelled phase.`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.run(cmd, args[0])
+		},
+	}
+
+	return &cmd, &options
+}
+
+type buildCancelCommandOptions struct {
+	*RootCmdOptions
+}
+
+func (command *buildCancelCommandOptions) run(cmd *cobra.Command, name string) error {
+	c, err := command.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	build, err := getBuild(command.Context, c, command.Namespace, name)
+	if err != nil {
+		return err
+	}
+	if build.Status.IsFinished() {
+		return fmt.Errorf("build \"%s\" has already completed (phase %s)", name, build.Status.Phase)
+	}
+
+	if build.Status.Phase == v1.BuildPhaseCancelling {
+		return fmt.Errorf("build \"%s\" is already being cancelled", name)
+	}
+	if err := kubernetes.CancelBuild(command.Context, c, build, "Build cancelled by the user"); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Build %s cancellation requested\n", name)
+
+	return nil
+}
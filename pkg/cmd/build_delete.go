@@ -0,0 +1,105 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+func newBuildDeleteCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *buildDeleteCommandOptions) {
+	options := buildDeleteCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "delete [build1] [build2] ...",
+		Short:   "Delete Builds",
+		Long:    `Delete Builds, along with their archived logs.`,
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(args); err != nil {
+				return err
+			}
+			return options.run(cmd, args)
+		},
+	}
+
+	cmd.Flags().Bool("all", false, "Delete all the completed Builds")
+
+	return &cmd, &options
+}
+
+type buildDeleteCommandOptions struct {
+	*RootCmdOptions
+	All bool `mapstructure:"all"`
+}
+
+func (command *buildDeleteCommandOptions) validate(args []string) error {
+	if command.All && len(args) > 0 {
+		return errors.New("invalid combination: --all flag is set and at least one build name is provided")
+	}
+	if !command.All && len(args) == 0 {
+		return errors.New("invalid combination: provide one or several build names or set --all flag for all builds")
+	}
+
+	return nil
+}
+
+func (command *buildDeleteCommandOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := command.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	names := args
+	if command.All {
+		list := v1.NewBuildList()
+		if err := c.List(command.Context, &list, ctrl.InNamespace(command.Namespace)); err != nil {
+			return err
+		}
+		for _, build := range list.Items {
+			build := build
+			// Running Builds are awaited by their Integration Kits
+			if build.Status.IsFinished() {
+				names = append(names, build.Name)
+			}
+		}
+	}
+
+	for _, name := range names {
+		build := v1.NewBuild(command.Namespace, name)
+		if err := c.Delete(command.Context, build); err != nil {
+			if k8serrors.IsNotFound(err) {
+				return fmt.Errorf("no build found with name \"%s\"", name)
+			}
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Build %s deleted\n", name)
+	}
+
+	return nil
+}
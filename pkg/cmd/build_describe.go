@@ -0,0 +1,223 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util/indentedwriter"
+	k8slog "github.com/apache/camel-k/v2/pkg/util/kubernetes/log"
+	"github.com/apache/camel-k/v2/pkg/util/maven"
+)
+
+func newBuildDescribeCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *buildDescribeCommandOptions) {
+	options := buildDescribeCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "describe <build>",
+		Short:   "Describe a Build",
+		Long:    `Describe a Build, with its tasks, its scheduling and the cause of its failure.`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.run(cmd, args[0])
+		},
+	}
+
+	return &cmd, &options
+}
+
+type buildDescribeCommandOptions struct {
+	*RootCmdOptions
+}
+
+func (command *buildDescribeCommandOptions) run(cmd *cobra.Command, name string) error {
+	c, err := command.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	build, err := getBuild(command.Context, c, command.Namespace, name)
+	if err != nil {
+		return err
+	}
+	pod, err := getBuilderPod(command.Context, c, build)
+	if err != nil {
+		return err
+	}
+
+	desc, err := indentedwriter.IndentedString(func(out io.Writer) error {
+		describeBuild(command.Context, indentedwriter.NewWriter(out), c, build, pod)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(cmd.OutOrStdout(), desc)
+
+	return nil
+}
+
+func describeBuild(ctx context.Context, w *indentedwriter.Writer, c client.Client, build *v1.Build, pod *corev1.Pod) {
+	describeObjectMeta(w, build.ObjectMeta)
+
+	w.Writef(0, "Phase:\t%s\n", build.Status.Phase)
+	if conf := build.BuilderConfiguration(); conf.Strategy != "" {
+		w.Writef(0, "Strategy:\t%s\n", conf.Strategy)
+	}
+	if build.Status.StartedAt != nil {
+		w.Writef(0, "Started At:\t%s\n", build.Status.StartedAt.Format(time.RFC1123Z))
+	}
+	if build.Status.Duration != "" {
+		w.Writef(0, "Duration:\t%s\n", build.Status.Duration)
+	}
+	if build.Status.Image != "" {
+		w.Writef(0, "Image:\t%s\n", build.Status.Image)
+	}
+	if build.Status.Digest != "" {
+		w.Writef(0, "Digest:\t%s\n", build.Status.Digest)
+	}
+
+	if condition := build.Status.GetCondition(v1.BuildConditionScheduled); condition != nil {
+		w.Writef(0, "Scheduling:\t%s\n", condition.Reason)
+		w.Writef(1, "%s\n", condition.Message)
+	}
+
+	if len(build.Spec.Tasks) > 0 {
+		w.Writef(0, "Tasks:\n")
+		for _, task := range build.Spec.Tasks {
+			describeBuildTask(w, build, pod, task)
+		}
+	}
+
+	if build.Status.Error != "" {
+		w.Writef(0, "Error:\t%s\n", build.Status.Error)
+	}
+	if f := build.Status.Failure; f != nil {
+		w.Writef(0, "Failure:\n")
+		w.Writef(1, "Reason:\t%s\n", f.Reason)
+		w.Writef(1, "Time:\t%s\n", f.Time.Format(time.RFC1123Z))
+		w.Writef(1, "Recovery Attempts:\t%d/%d\n", f.Recovery.Attempt, f.Recovery.AttemptMax)
+	}
+
+	if build.Status.Log != nil {
+		w.Writef(0, "Log:\t%d bytes, see kamel build logs %s\n", build.Status.Log.Size, build.Name)
+		if build.Status.Phase == v1.BuildPhaseFailed || build.Status.Phase == v1.BuildPhaseError {
+			if goal := failedBuildGoal(ctx, c, build); goal != "" {
+				w.Writef(0, "Failed Maven Step:\t%s\n", goal)
+			}
+		}
+	}
+}
+
+func describeBuildTask(w *indentedwriter.Writer, build *v1.Build, pod *corev1.Pod, task v1.Task) {
+	name := v1.TaskName(task)
+	w.Writef(1, "%s:\t%s\n", name, buildTaskKind(task))
+
+	if pod != nil {
+		var statuses []corev1.ContainerStatus
+		statuses = append(statuses, pod.Status.InitContainerStatuses...)
+		statuses = append(statuses, pod.Status.ContainerStatuses...)
+		for _, s := range statuses {
+			if s.Name != name {
+				continue
+			}
+			switch {
+			case s.State.Terminated != nil:
+				t := s.State.Terminated
+				w.Writef(2, "Started At:\t%s\n", t.StartedAt.Format(time.RFC1123Z))
+				w.Writef(2, "Finished At:\t%s\n", t.FinishedAt.Format(time.RFC1123Z))
+				w.Writef(2, "Duration:\t%s\n", t.FinishedAt.Sub(t.StartedAt.Time))
+				if t.ExitCode == 0 {
+					w.Writef(2, "Status:\tSucceeded\n")
+				} else {
+					w.Writef(2, "Status:\tFailed (%s, exit code %d)\n", t.Reason, t.ExitCode)
+				}
+			case s.State.Running != nil:
+				w.Writef(2, "Started At:\t%s\n", s.State.Running.StartedAt.Format(time.RFC1123Z))
+				w.Writef(2, "Status:\tRunning\n")
+			default:
+				w.Writef(2, "Status:\tWaiting\n")
+			}
+			return
+		}
+	}
+
+	// The builder Pod may be gone, the termination of its containers is recorded as conditions
+	if condition := build.Status.GetCondition(v1.BuildConditionType(fmt.Sprintf("Container%sSucceeded", name))); condition != nil {
+		w.Writef(2, "Finished At:\t%s\n", condition.LastTransitionTime.Format(time.RFC1123Z))
+		if condition.Status == corev1.ConditionTrue {
+			w.Writef(2, "Status:\tSucceeded\n")
+		} else {
+			w.Writef(2, "Status:\tFailed (%s)\n", condition.Reason)
+		}
+	}
+}
+
+func buildTaskKind(task v1.Task) string {
+	switch {
+	case task.Builder != nil:
+		return "Builder"
+	case task.Custom != nil:
+		return "Custom"
+	case task.Package != nil:
+		return "Package"
+	case task.Buildah != nil:
+		return "Buildah"
+	case task.Kaniko != nil:
+		return "Kaniko"
+	case task.Spectrum != nil:
+		return "Spectrum"
+	case task.S2i != nil:
+		return "S2i"
+	case task.Jib != nil:
+		return "Jib"
+	}
+
+	return ""
+}
+
+// failedBuildGoal returns the failing Maven step reported in the archived Build log.
+func failedBuildGoal(ctx context.Context, c client.Client, build *v1.Build) string {
+	data, err := k8slog.ReadBuildLog(ctx, c, build)
+	if err != nil {
+		return ""
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if goal := maven.FailedGoal(scanner.Text()); goal != "" {
+			return goal
+		}
+	}
+
+	return ""
+}
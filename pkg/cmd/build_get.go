@@ -0,0 +1,88 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+func newBuildGetCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *buildGetCommandOptions) {
+	options := buildGetCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "get [build1] [build2] ...",
+		Short:   "Get the Builds",
+		Long:    `Get the Builds of the namespace, or the given ones.`,
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.run(cmd, args)
+		},
+	}
+
+	return &cmd, &options
+}
+
+type buildGetCommandOptions struct {
+	*RootCmdOptions
+}
+
+func (command *buildGetCommandOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := command.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	var builds []v1.Build
+	if len(args) == 0 {
+		list := v1.NewBuildList()
+		if err := c.List(command.Context, &list, ctrl.InNamespace(command.Namespace)); err != nil {
+			return err
+		}
+		builds = list.Items
+	}
+	for _, name := range args {
+		build, err := getBuild(command.Context, c, command.Namespace, name)
+		if err != nil {
+			return err
+		}
+		builds = append(builds, *build)
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "NAME\tPHASE\tSTRATEGY\tDURATION\tATTEMPTS\tIMAGE")
+	for _, build := range builds {
+		build := build
+		attempts := 0
+		if build.Status.Failure != nil {
+			attempts = build.Status.Failure.Recovery.Attempt
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\n", build.Name, build.Status.Phase,
+			build.BuilderConfiguration().Strategy, build.Status.Duration, attempts, build.Status.Image)
+	}
+
+	return w.Flush()
+}
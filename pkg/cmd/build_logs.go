@@ -0,0 +1,251 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	k8slog "github.com/apache/camel-k/v2/pkg/util/kubernetes/log"
+	"github.com/apache/camel-k/v2/pkg/util/maven"
+)
+
+const buildLogPollInterval = 2 * time.Second
+
+func newBuildLogsCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *buildLogsCommandOptions) {
+	options := buildLogsCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:   "logs <build>",
+		Short: "Print the log of a Build",
+		Long: `Print the log of a Build. The log of a running Build executed in a Pod is streamed, ` +
+			`the log of a running Build executed by an external builder is followed from its archive, ` +
+			`and the log of a completed Build is read from its archive.`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.run(cmd, args[0])
+		},
+	}
+
+	return &cmd, &options
+}
+
+type buildLogsCommandOptions struct {
+	*RootCmdOptions
+}
+
+func (command *buildLogsCommandOptions) run(cmd *cobra.Command, name string) error {
+	c, err := command.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	build, err := getBuild(command.Context, c, command.Namespace, name)
+	if err != nil {
+		return err
+	}
+
+	return printBuildLog(command.Context, cmd.OutOrStdout(), c, build)
+}
+
+// printBuildLog streams the log of a running Build Pod, or prints the archived log of a completed Build.
+func printBuildLog(ctx context.Context, out io.Writer, c client.Client, build *v1.Build) error {
+	printer := newBuildLogPrinter(out)
+	defer printer.summary()
+
+	if !build.Status.IsFinished() {
+		if build.BuilderConfiguration().Strategy == v1.BuildStrategyPod {
+			return streamBuildPodLog(ctx, c, build, printer)
+		}
+		if build.BuilderConfiguration().Strategy == v1.BuildStrategyExternal {
+			return followArchivedBuildLog(ctx, c, build, printer)
+		}
+		switch build.BuilderConfiguration().Strategy {
+		case v1.BuildStrategyTekton:
+			fmt.Fprintf(out, "Build %s is running in a Tekton PipelineRun, waiting for it to complete ...\n", build.Name)
+		default:
+			fmt.Fprintf(out, "Build %s is running in the operator, waiting for it to complete ...\n", build.Name)
+		}
+		if err := waitForBuild(ctx, c, build); err != nil {
+			return err
+		}
+	}
+
+	data, err := k8slog.ReadBuildLog(ctx, c, build)
+	if errors.Is(err, k8slog.ErrNoBuildLog) {
+		return fmt.Errorf("no log archived for build \"%s\"", build.Name)
+	} else if err != nil {
+		return err
+	}
+
+	return printer.print(bytes.NewReader(data))
+}
+
+// followArchivedBuildLog prints the log of a running Build, which is periodically archived by the operator,
+// as it grows, until the Build completes.
+func followArchivedBuildLog(ctx context.Context, c ctrl.Reader, build *v1.Build, printer *buildLogPrinter) error {
+	printed := 0
+	archived := false
+	err := wait.PollUntilContextCancel(ctx, buildLogPollInterval, true, func(ctx context.Context) (bool, error) {
+		if err := c.Get(ctx, ctrl.ObjectKeyFromObject(build), build); err != nil {
+			return false, err
+		}
+		finished := build.Status.IsFinished()
+		data, err := k8slog.ReadBuildLog(ctx, c, build)
+		if errors.Is(err, k8slog.ErrNoBuildLog) {
+			return finished, nil
+		} else if err != nil {
+			return false, err
+		}
+		archived = true
+		if len(data) <= printed {
+			return finished, nil
+		}
+		data = data[printed:]
+		if !finished {
+			// Only print the complete lines of the running Build
+			data = data[:bytes.LastIndexByte(data, '\n')+1]
+		}
+		printed += len(data)
+
+		return finished, printer.print(bytes.NewReader(data))
+	})
+	if err != nil {
+		return err
+	}
+	if !archived {
+		return fmt.Errorf("no log archived for build \"%s\"", build.Name)
+	}
+
+	return nil
+}
+
+func waitForBuild(ctx context.Context, c ctrl.Reader, build *v1.Build) error {
+	return wait.PollUntilContextCancel(ctx, buildLogPollInterval, true, func(ctx context.Context) (bool, error) {
+		if err := c.Get(ctx, ctrl.ObjectKeyFromObject(build), build); err != nil {
+			return false, err
+		}
+
+		return build.Status.IsFinished(), nil
+	})
+}
+
+// streamBuildPodLog follows the log of each task container of the builder Pod, in order.
+func streamBuildPodLog(ctx context.Context, c client.Client, build *v1.Build, printer *buildLogPrinter) error {
+	pod, err := getBuilderPod(ctx, c, build)
+	if err != nil {
+		return err
+	}
+	if pod == nil {
+		return fmt.Errorf("no builder pod found for build \"%s\"", build.Name)
+	}
+
+	var containers []corev1.Container
+	containers = append(containers, pod.Spec.InitContainers...)
+	containers = append(containers, pod.Spec.Containers...)
+	for _, container := range containers {
+		if err := waitForContainer(ctx, c, pod, container.Name); err != nil {
+			return err
+		}
+		fmt.Fprintf(printer.out, "=== %s ===\n", container.Name)
+		stream, err := c.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+			Container: container.Name,
+			Follow:    true,
+		}).Stream(ctx)
+		if err != nil {
+			return err
+		}
+		err = printer.print(stream)
+		stream.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// waitForContainer waits until the container has started, so that its log can be followed.
+func waitForContainer(ctx context.Context, c client.Client, pod *corev1.Pod, name string) error {
+	return wait.PollUntilContextCancel(ctx, buildLogPollInterval, true, func(ctx context.Context) (bool, error) {
+		p, err := c.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		var statuses []corev1.ContainerStatus
+		statuses = append(statuses, p.Status.InitContainerStatuses...)
+		statuses = append(statuses, p.Status.ContainerStatuses...)
+		for _, s := range statuses {
+			if s.Name == name {
+				return s.State.Running != nil || s.State.Terminated != nil, nil
+			}
+		}
+
+		return false, nil
+	})
+}
+
+// buildLogPrinter prints the Build log lines, highlighting the failing Maven step.
+type buildLogPrinter struct {
+	out        io.Writer
+	failedGoal string
+}
+
+func newBuildLogPrinter(out io.Writer) *buildLogPrinter {
+	return &buildLogPrinter{out: out}
+}
+
+func (p *buildLogPrinter) print(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if goal := maven.FailedGoal(line); goal != "" && p.failedGoal == "" {
+			p.failedGoal = goal
+			fmt.Fprintln(p.out, ">> "+line)
+			continue
+		}
+		fmt.Fprintln(p.out, line)
+	}
+
+	return scanner.Err()
+}
+
+func (p *buildLogPrinter) summary() {
+	if p.failedGoal != "" {
+		fmt.Fprintf(p.out, "\nFailed Maven step: %s\n", p.failedGoal)
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/platform"
+	k8slog "github.com/apache/camel-k/v2/pkg/util/kubernetes/log"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+)
+
+const failedBuildLog = `=== builder ===
+[INFO] Building camel-k-integration
+[ERROR] Failed to execute goal org.apache.maven.plugins:maven-compiler-plugin:3.8.1:compile (default-compile) on project camel-k-integration: Compilation failure
+[ERROR] Failed to execute goal org.apache.maven.plugins:maven-compiler-plugin:3.8.1:compile (default-compile) on project camel-k-integration: Compilation failure -> [Help 1]
+`
+
+func initializeBuildCmdOptions(t *testing.T, initObjs ...runtime.Object) (*cobra.Command, client.Client) {
+	t.Helper()
+	defaultPlatform := v1.NewIntegrationPlatform("default", platform.DefaultPlatformName)
+	fakeClient, err := test.NewFakeClient(append(initObjs, &defaultPlatform)...)
+	require.NoError(t, err)
+
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	rootCmd.AddCommand(newCmdBuild(options))
+	rootCmd.AddCommand(newCmdKit(options))
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return rootCmd, fakeClient
+}
+
+func archivedBuild(t *testing.T, c client.Client, build *v1.Build, log string) {
+	t.Helper()
+	archive, err := k8slog.ArchiveBuildLog(context.TODO(), c, build, []byte(log))
+	require.NoError(t, err)
+	build.Status.Log = archive
+	require.NoError(t, c.Status().Update(context.TODO(), build))
+}
+
+func TestBuildLogsArchived(t *testing.T) {
+	build := v1.NewBuild("default", "kit-123")
+	build.Status.Phase = v1.BuildPhaseFailed
+	cmd, c := initializeBuildCmdOptions(t, build)
+	archivedBuild(t, c, build, failedBuildLog)
+
+	output, err := test.ExecuteCommand(cmd, "build", "logs", "kit-123")
+	require.NoError(t, err)
+	assert.Contains(t, output, "[INFO] Building camel-k-integration\n"+
+		">> [ERROR] Failed to execute goal org.apache.maven.plugins:maven-compiler-plugin:3.8.1:compile (default-compile)")
+	assert.Contains(t, output, "\n[ERROR] Failed to execute goal org.apache.maven.plugins:maven-compiler-plugin:3.8.1:compile (default-compile) on project camel-k-integration: Compilation failure -> [Help 1]\n")
+	assert.Contains(t, output, "\nFailed Maven step: org.apache.maven.plugins:maven-compiler-plugin:3.8.1:compile (default-compile)\n")
+}
+
+func TestBuildLogsNotArchived(t *testing.T) {
+	build := v1.NewBuild("default", "kit-123")
+	build.Status.Phase = v1.BuildPhaseSucceeded
+	cmd, _ := initializeBuildCmdOptions(t, build)
+
+	_, err := test.ExecuteCommand(cmd, "build", "logs", "kit-123")
+	require.EqualError(t, err, "no log archived for build \"kit-123\"")
+
+	_, err = test.ExecuteCommand(cmd, "build", "logs", "missing")
+	require.EqualError(t, err, "no build found with name \"missing\"")
+}
+
+func TestBuildLogsRunningPod(t *testing.T) {
+	build := v1.NewBuild("default", "kit-123")
+	build.Spec.Tasks = []v1.Task{{
+		Builder: &v1.BuilderTask{
+			BaseTask: v1.BaseTask{
+				Name: "builder",
+				Configuration: v1.BuildConfiguration{
+					Strategy:            v1.BuildStrategyPod,
+					BuilderPodNamespace: "default",
+				},
+			},
+		},
+	}}
+	build.Status.Phase = v1.BuildPhaseRunning
+	terminated := corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{}}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "camel-k-kit-123-builder",
+			Labels: map[string]string{
+				v1.BuildLabel: "kit-123",
+			},
+		},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "builder"}},
+			Containers:     []corev1.Container{{Name: "jib"}},
+		},
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{{Name: "builder", State: terminated}},
+			ContainerStatuses:     []corev1.ContainerStatus{{Name: "jib", State: terminated}},
+		},
+	}
+	cmd, _ := initializeBuildCmdOptions(t, build, pod)
+
+	output, err := test.ExecuteCommand(cmd, "build", "logs", "kit-123")
+	require.NoError(t, err)
+	assert.Equal(t, "=== builder ===\nfake logs\n=== jib ===\nfake logs\n", output)
+}
+
+func TestBuildLogsRunningExternal(t *testing.T) {
+	build := v1.NewBuild("default", "kit-123")
+	build.Spec.Tasks = []v1.Task{{
+		Builder: &v1.BuilderTask{
+			BaseTask: v1.BaseTask{
+				Name: "builder",
+				Configuration: v1.BuildConfiguration{
+					Strategy: v1.BuildStrategyExternal,
+				},
+			},
+		},
+	}}
+	build.Status.Phase = v1.BuildPhaseRunning
+	cmd, c := initializeBuildCmdOptions(t, build)
+	archivedBuild(t, c, build, "Downloading dependencies\nCompil")
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		completed := build.DeepCopy()
+		completed.Status.Phase = v1.BuildPhaseSucceeded
+		archivedBuild(t, c, completed, "Downloading dependencies\nCompiling\nBUILD SUCCESS\n")
+	}()
+
+	output, err := test.ExecuteCommand(cmd, "build", "logs", "kit-123")
+	require.NoError(t, err)
+	assert.Equal(t, "Downloading dependencies\nCompiling\nBUILD SUCCESS\n", output)
+}
+
+func TestKitLogs(t *testing.T) {
+	kit := v1.NewIntegrationKit("default", "kit-123")
+	build := v1.NewBuild("default", "kit-123")
+	build.Status.Phase = v1.BuildPhaseFailed
+	cmd, c := initializeBuildCmdOptions(t, kit, build)
+	archivedBuild(t, c, build, failedBuildLog)
+
+	output, err := test.ExecuteCommand(cmd, "kit", "logs", "kit-123")
+	require.NoError(t, err)
+	assert.Contains(t, output, "Failed Maven step: org.apache.maven.plugins:maven-compiler-plugin:3.8.1:compile (default-compile)")
+
+	_, err = test.ExecuteCommand(cmd, "kit", "logs", "missing")
+	require.EqualError(t, err, "no integration kit found with name \"missing\"")
+}
@@ -0,0 +1,120 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+)
+
+func newBuildRetryCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *buildRetryCommandOptions) {
+	options := buildRetryCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:   "retry <build>",
+		Short: "Retry a failed Build",
+		Long: `Retry a failed, errored or interrupted Build. The retry counts as a recovery attempt of the Build failure, ` +
+			`unless the recovery attempts are reset.`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.run(cmd, args[0])
+		},
+	}
+
+	cmd.Flags().Bool("reset", false, "Reset the recovery attempts of the Build failure")
+
+	return &cmd, &options
+}
+
+type buildRetryCommandOptions struct {
+	*RootCmdOptions
+	Reset bool `mapstructure:"reset"`
+}
+
+func (command *buildRetryCommandOptions) run(cmd *cobra.Command, name string) error {
+	c, err := command.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	build, err := getBuild(command.Context, c, command.Namespace, name)
+	if err != nil {
+		return err
+	}
+	if !build.Status.IsFinished() {
+		return fmt.Errorf("build \"%s\" has not completed (phase %s)", name, build.Status.Phase)
+	}
+	if build.Status.Phase == v1.BuildPhaseSucceeded {
+		return fmt.Errorf("build \"%s\" has succeeded", name)
+	}
+
+	// Account for the retry the same way the operator recovers failed Builds
+	if command.Reset {
+		build.Status.Failure = nil
+	} else if f := build.Status.Failure; f != nil {
+		if f.Recovery.Attempt >= f.Recovery.AttemptMax {
+			return fmt.Errorf("build \"%s\" has exhausted its %d recovery attempts, use --reset to retry it", name, f.Recovery.AttemptMax)
+		}
+		f.Recovery.Attempt++
+		f.Recovery.AttemptTime = metav1.Now()
+	}
+	build.Status.Phase = v1.BuildPhaseInitialization
+	build.Status.Error = ""
+	if err := c.Status().Update(command.Context, build); err != nil {
+		return err
+	}
+
+	if err := command.resumeKit(c, build); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Build %s retried\n", name)
+
+	return nil
+}
+
+// resumeKit makes the Integration Kit of the Build, put in error by the Build failure, wait for the retried Build.
+func (command *buildRetryCommandOptions) resumeKit(c client.Client, build *v1.Build) error {
+	owner := metav1.GetControllerOf(build)
+	if owner == nil || owner.Kind != v1.IntegrationKitKind {
+		return nil
+	}
+
+	kit := v1.NewIntegrationKit(build.Namespace, owner.Name)
+	if err := c.Get(command.Context, ctrl.ObjectKeyFromObject(kit), kit); err != nil {
+		return ctrl.IgnoreNotFound(err)
+	}
+	if kit.Status.Phase != v1.IntegrationKitPhaseError {
+		return nil
+	}
+	kit.Status.Phase = v1.IntegrationKitPhaseBuildRunning
+	kit.Status.Failure = nil
+
+	return c.Status().Update(command.Context, kit)
+}
@@ -0,0 +1,244 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+)
+
+func podBuild(name string, phase v1.BuildPhase) *v1.Build {
+	build := v1.NewBuild("default", name)
+	build.Spec.Tasks = []v1.Task{
+		{
+			Builder: &v1.BuilderTask{
+				BaseTask: v1.BaseTask{
+					Name: "builder",
+					Configuration: v1.BuildConfiguration{
+						Strategy:            v1.BuildStrategyPod,
+						BuilderPodNamespace: "default",
+					},
+				},
+			},
+		},
+		{
+			Jib: &v1.JibTask{
+				BaseTask: v1.BaseTask{
+					Name: "jib",
+				},
+			},
+		},
+	}
+	build.Status.Phase = phase
+
+	return build
+}
+
+func builderPod(build *v1.Build) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "camel-k-" + build.Name + "-builder",
+			Labels: map[string]string{
+				v1.BuildLabel: build.Name,
+			},
+		},
+	}
+}
+
+func TestBuildGet(t *testing.T) {
+	failed := podBuild("kit-1", v1.BuildPhaseError)
+	failed.Status.Duration = "1m2s"
+	failed.Status.Failure = &v1.Failure{Recovery: v1.FailureRecovery{Attempt: 5, AttemptMax: 5}}
+	succeeded := v1.NewBuild("default", "kit-2")
+	succeeded.Status.Phase = v1.BuildPhaseSucceeded
+	succeeded.Status.Image = "registry/kit-2:1"
+	cmd, _ := initializeBuildCmdOptions(t, failed, succeeded)
+
+	output, err := test.ExecuteCommand(cmd, "build", "get")
+	require.NoError(t, err)
+	assert.Equal(t, "NAME\tPHASE\t\tSTRATEGY\tDURATION\tATTEMPTS\tIMAGE\n"+
+		"kit-1\tError\t\tpod\t\t1m2s\t\t5\t\t\n"+
+		"kit-2\tSucceeded\t\t\t\t\t0\t\tregistry/kit-2:1\n", output)
+
+	output, err = test.ExecuteCommand(cmd, "build", "get", "kit-2")
+	require.NoError(t, err)
+	assert.NotContains(t, output, "kit-1")
+}
+
+func TestBuildDescribe(t *testing.T) {
+	build := podBuild("kit-1", v1.BuildPhaseFailed)
+	build.Status.Error = "Builder Pod camel-k-kit-1-builder failed (see conditions for more details)"
+	build.Status.Failure = &v1.Failure{
+		Reason:   "Builder Pod camel-k-kit-1-builder failed",
+		Recovery: v1.FailureRecovery{Attempt: 1, AttemptMax: 5},
+	}
+	build.Status.Conditions = []v1.BuildCondition{
+		{
+			Type:    v1.BuildConditionScheduled,
+			Status:  corev1.ConditionTrue,
+			Reason:  v1.BuildConditionReadyReason,
+			Message: "the build (kit-1) is scheduled",
+		},
+	}
+	started := metav1.NewTime(time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC))
+	finished := metav1.NewTime(started.Add(90 * time.Second))
+	pod := builderPod(build)
+	pod.Status.InitContainerStatuses = []corev1.ContainerStatus{{
+		Name: "builder",
+		State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+			ExitCode: 1, Reason: "Error", StartedAt: started, FinishedAt: finished,
+		}},
+	}}
+	pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+		Name:  "jib",
+		State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{}},
+	}}
+	cmd, c := initializeBuildCmdOptions(t, build, pod)
+	archivedBuild(t, c, build, failedBuildLog)
+
+	output, err := test.ExecuteCommand(cmd, "build", "describe", "kit-1")
+	require.NoError(t, err)
+	assert.Contains(t, output, "Phase:               Failed\n")
+	assert.Contains(t, output, "Strategy:            pod\n")
+	assert.Contains(t, output, "Scheduling:          Ready\n  the build (kit-1) is scheduled\n")
+	assert.Contains(t, output, "Tasks:\n  builder:        Builder\n")
+	assert.Contains(t, output, "    Duration:     1m30s\n    Status:       Failed (Error, exit code 1)\n")
+	assert.Contains(t, output, "  jib:            Jib\n    Status:       Waiting\n")
+	assert.Contains(t, output, "Recovery Attempts:  1/5\n")
+	assert.Contains(t, output, "Failed Maven Step:    org.apache.maven.plugins:maven-compiler-plugin:3.8.1:compile (default-compile)\n")
+}
+
+func TestBuildCancel(t *testing.T) {
+	kit := v1.NewIntegrationKit("default", "kit-1")
+	kit.Status.Phase = v1.IntegrationKitPhaseBuildRunning
+	controller := true
+	running := podBuild("kit-1", v1.BuildPhaseRunning)
+	running.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: v1.SchemeGroupVersion.String(),
+		Kind:       v1.IntegrationKitKind,
+		Name:       kit.Name,
+		Controller: &controller,
+	}}
+	routine := v1.NewBuild("default", "kit-2")
+	routine.Spec.Tasks = []v1.Task{{
+		Builder: &v1.BuilderTask{
+			BaseTask: v1.BaseTask{
+				Name:          "builder",
+				Configuration: v1.BuildConfiguration{Strategy: v1.BuildStrategyRoutine},
+			},
+		},
+	}}
+	routine.Status.Phase = v1.BuildPhaseRunning
+	succeeded := podBuild("kit-3", v1.BuildPhaseSucceeded)
+	cmd, c := initializeBuildCmdOptions(t, kit, running, routine, succeeded)
+
+	output, err := test.ExecuteCommand(cmd, "build", "cancel", "kit-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Build kit-1 cancellation requested\n", output)
+	build, err := getBuild(context.TODO(), c, "default", "kit-1")
+	require.NoError(t, err)
+	assert.Equal(t, v1.BuildPhaseCancelling, build.Status.Phase)
+	// The kit must not resubmit the cancelled Build
+	require.NoError(t, c.Get(context.TODO(), ctrl.ObjectKeyFromObject(kit), kit))
+	assert.Equal(t, v1.IntegrationKitPhaseError, kit.Status.Phase)
+	assert.Equal(t, "Build cancelled by the user", kit.Status.Failure.Reason)
+
+	_, err = test.ExecuteCommand(cmd, "build", "cancel", "kit-1")
+	require.EqualError(t, err, "build \"kit-1\" is already being cancelled")
+
+	// The running tasks of a routine Build are interrupted by the operator
+	output, err = test.ExecuteCommand(cmd, "build", "cancel", "kit-2")
+	require.NoError(t, err)
+	assert.Equal(t, "Build kit-2 cancellation requested\n", output)
+	build, err = getBuild(context.TODO(), c, "default", "kit-2")
+	require.NoError(t, err)
+	assert.Equal(t, v1.BuildPhaseCancelling, build.Status.Phase)
+
+	_, err = test.ExecuteCommand(cmd, "build", "cancel", "kit-3")
+	require.EqualError(t, err, "build \"kit-3\" has already completed (phase Succeeded)")
+}
+
+func TestBuildRetry(t *testing.T) {
+	kit := v1.NewIntegrationKit("default", "kit-1")
+	kit.Status.Phase = v1.IntegrationKitPhaseError
+	controller := true
+	failed := podBuild("kit-1", v1.BuildPhaseFailed)
+	failed.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: v1.SchemeGroupVersion.String(),
+		Kind:       v1.IntegrationKitKind,
+		Name:       kit.Name,
+		Controller: &controller,
+	}}
+	failed.Status.Failure = &v1.Failure{Recovery: v1.FailureRecovery{Attempt: 1, AttemptMax: 5}}
+	exhausted := podBuild("kit-2", v1.BuildPhaseError)
+	exhausted.Status.Failure = &v1.Failure{Recovery: v1.FailureRecovery{Attempt: 5, AttemptMax: 5}}
+	running := podBuild("kit-3", v1.BuildPhaseRunning)
+	cmd, c := initializeBuildCmdOptions(t, kit, failed, exhausted, running)
+
+	output, err := test.ExecuteCommand(cmd, "build", "retry", "kit-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Build kit-1 retried\n", output)
+	build, err := getBuild(context.TODO(), c, "default", "kit-1")
+	require.NoError(t, err)
+	assert.Equal(t, v1.BuildPhaseInitialization, build.Status.Phase)
+	assert.Equal(t, 2, build.Status.Failure.Recovery.Attempt)
+	require.NoError(t, c.Get(context.TODO(), ctrl.ObjectKeyFromObject(kit), kit))
+	assert.Equal(t, v1.IntegrationKitPhaseBuildRunning, kit.Status.Phase)
+
+	_, err = test.ExecuteCommand(cmd, "build", "retry", "kit-2")
+	require.EqualError(t, err, "build \"kit-2\" has exhausted its 5 recovery attempts, use --reset to retry it")
+	_, err = test.ExecuteCommand(cmd, "build", "retry", "kit-3")
+	require.EqualError(t, err, "build \"kit-3\" has not completed (phase Running)")
+
+	_, err = test.ExecuteCommand(cmd, "build", "retry", "kit-2", "--reset")
+	require.NoError(t, err)
+	build, err = getBuild(context.TODO(), c, "default", "kit-2")
+	require.NoError(t, err)
+	assert.Equal(t, v1.BuildPhaseInitialization, build.Status.Phase)
+	assert.Nil(t, build.Status.Failure)
+}
+
+func TestBuildDelete(t *testing.T) {
+	completed := podBuild("kit-1", v1.BuildPhaseSucceeded)
+	running := podBuild("kit-2", v1.BuildPhaseRunning)
+	cmd, c := initializeBuildCmdOptions(t, completed, running)
+
+	_, err := test.ExecuteCommand(cmd, "build", "delete")
+	require.Error(t, err)
+
+	output, err := test.ExecuteCommand(cmd, "build", "delete", "--all")
+	require.NoError(t, err)
+	assert.Equal(t, "Build kit-1 deleted\n", output)
+	_, err = getBuild(context.TODO(), c, "default", "kit-1")
+	require.EqualError(t, err, "no build found with name \"kit-1\"")
+	_, err = getBuild(context.TODO(), c, "default", "kit-2")
+	require.NoError(t, err)
+}
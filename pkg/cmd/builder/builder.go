@@ -71,6 +71,10 @@ func Run(namespace string, buildName string, taskName string) {
 	status := builder.New(c).Build(build).TaskByName(taskName).Do(cancelOnSignals)
 	target := build.DeepCopy()
 	target.Status = status
+	if status.MavenCache != nil && build.Status.MavenCache != nil {
+		// Account for the Maven cache usage of the previous tasks
+		target.Status.MavenCache.Downloaded += build.Status.MavenCache.Downloaded
+	}
 	// Let the owning controller decide the resulting phase based on the Pod state.
 	// The Pod status acts as the interface with the controller, so that no assumptions
 	// is made on the build containers.
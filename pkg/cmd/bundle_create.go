@@ -0,0 +1,177 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/builder"
+	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/defaults"
+	"github.com/apache/camel-k/v2/pkg/util/maven"
+	"github.com/apache/camel-k/v2/pkg/util/tar"
+)
+
+func newBundleCreateCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *bundleCreateCmdOptions) {
+	options := bundleCreateCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:   "create",
+		Short: "Create an offline bundle of Maven artifacts",
+		Long: `Create an offline bundle containing the Maven artifacts required to build the Integrations
+of a Camel K runtime version, along with the ones of the given extra dependencies.
+The bundle can then be installed with "kamel install --offline-bundle".`,
+		Args:    cobra.NoArgs,
+		PreRunE: decode(&options, options.Flags),
+		RunE:    options.run,
+	}
+
+	cmd.Flags().String("runtime-version", defaults.DefaultRuntimeVersion, "The Camel K runtime version of the bundle")
+	cmd.Flags().StringArrayP("dependency", "d", nil, "Add an extra dependency to the bundle, e.g. \"camel:kafka\" or \"mvn:org.my:app:1.0\"")
+	cmd.Flags().StringArray("maven-repository", nil, "Add a Maven repository the artifacts are resolved from")
+	cmd.Flags().StringArray("maven-cli-option", nil, "Add a Maven CLI option to the list of arguments of the Maven commands")
+	cmd.Flags().String("output-file", "", "The path of the bundle archive (default camel-k-maven-bundle-<runtime version>.tar.gz)")
+
+	return &cmd, &options
+}
+
+type bundleCreateCmdOptions struct {
+	*RootCmdOptions
+	RuntimeVersion    string   `mapstructure:"runtime-version"`
+	Dependencies      []string `mapstructure:"dependencies"`
+	MavenRepositories []string `mapstructure:"maven-repositories"`
+	MavenCLIOptions   []string `mapstructure:"maven-cli-options"`
+	OutputFile        string   `mapstructure:"output-file"`
+}
+
+func (o *bundleCreateCmdOptions) run(cmd *cobra.Command, _ []string) error {
+	if err := setupMavenCommand(); err != nil {
+		return err
+	}
+	output := o.OutputFile
+	if output == "" {
+		output = fmt.Sprintf("camel-k-maven-bundle-%s.tar.gz", o.RuntimeVersion)
+	}
+
+	return util.WithTempDir("camel-k-bundle", func(tmpDir string) error {
+		// The local repository the artifacts are resolved into, and eventually archived
+		repository := filepath.Join(tmpDir, "repository")
+		if err := os.MkdirAll(repository, os.ModePerm); err != nil {
+			return err
+		}
+		settings, err := maven.NewSettings(maven.DefaultRepositories, maven.Repositories(o.MavenRepositories...),
+			maven.ProxyFromEnvironment)
+		if err != nil {
+			return err
+		}
+		globalSettings, err := settings.MarshalBytes()
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Generating the catalog of runtime version %s\n", o.RuntimeVersion)
+		mvn := v1.MavenSpec{
+			LocalRepository: repository,
+			CLIOptions:      o.MavenCLIOptions,
+		}
+		runtime := v1.RuntimeSpec{
+			Version:  o.RuntimeVersion,
+			Provider: v1.RuntimeProviderQuarkus,
+		}
+		catalog, err := camel.GenerateCatalogCommon(o.Context, globalSettings, nil, nil, mvn, runtime, []maven.Dependency{})
+		if err != nil {
+			return err
+		}
+		if err := camel.ValidateDependenciesE(catalog, o.Dependencies); err != nil {
+			return err
+		}
+
+		fmt.Fprintln(cmd.OutOrStdout(), "Resolving the Maven artifacts")
+		project := builder.GenerateQuarkusProjectCommon(catalog.Runtime.Version, catalog.Runtime.Metadata["quarkus.version"])
+		if err := camel.ManageIntegrationDependencies(&project, bundleDependencies(catalog, o.Dependencies), catalog); err != nil {
+			return err
+		}
+		mc := maven.NewContext(filepath.Join(tmpDir, "project"))
+		mc.GlobalSettings = globalSettings
+		mc.LocalRepository = repository
+		mc.AdditionalArguments = o.MavenCLIOptions
+		// Building a project resolves the Maven plugins and the build time dependencies as well
+		if err := builder.BuildQuarkusRunnerCommon(o.Context, mc, project, nil); err != nil {
+			return err
+		}
+
+		if err := maven.ToOfflineRepository(repository); err != nil {
+			return err
+		}
+		f, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer util.CloseQuietly(f)
+		if err := tar.CreateTarGzFromDirectory(repository, f); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Maven offline bundle %s created\n", output)
+
+		return nil
+	})
+}
+
+// bundleDependencies returns the dependencies of the runtime and of its DSL loaders, along with the extra ones.
+func bundleDependencies(catalog *camel.RuntimeCatalog, extra []string) []string {
+	dependencies := make([]string, 0)
+	for _, d := range catalog.Runtime.Dependencies {
+		util.StringSliceUniqueAdd(&dependencies, d.GetDependencyID())
+	}
+	for _, loader := range catalog.Loaders {
+		util.StringSliceUniqueAdd(&dependencies, loader.GetDependencyID())
+		for _, d := range loader.Dependencies {
+			util.StringSliceUniqueAdd(&dependencies, d.GetDependencyID())
+		}
+	}
+	for _, d := range extra {
+		util.StringSliceUniqueAdd(&dependencies, camel.NormalizeDependency(d))
+	}
+
+	return dependencies
+}
+
+// setupMavenCommand makes the Maven tooling use the mvn command found in the PATH,
+// unless the MAVEN_CMD environment variable is already set.
+func setupMavenCommand() error {
+	if _, ok := os.LookupEnv("MAVEN_CMD"); ok {
+		return nil
+	}
+	mvn, err := exec.LookPath("mvn")
+	if err != nil {
+		return errors.New("cannot find the mvn command: install Maven, or set the MAVEN_CMD environment variable")
+	}
+
+	return os.Setenv("MAVEN_CMD", mvn)
+}
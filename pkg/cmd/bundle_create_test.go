@@ -0,0 +1,100 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/defaults"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+)
+
+const cmdBundleCreate = "create"
+
+func initializeBundleCreateCmdOptions(t *testing.T) (*bundleCreateCmdOptions, *cobra.Command) {
+	t.Helper()
+
+	options, rootCmd := kamelTestPreAddCommandInit()
+	bundleCreateCmd, bundleCreateOptions := newBundleCreateCmd(options)
+	bundleCreateCmd.RunE = func(c *cobra.Command, args []string) error {
+		return nil
+	}
+	rootCmd.AddCommand(bundleCreateCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return bundleCreateOptions, rootCmd
+}
+
+func TestBundleCreateDefaultFlags(t *testing.T) {
+	options, rootCmd := initializeBundleCreateCmdOptions(t)
+	_, err := test.ExecuteCommand(rootCmd, cmdBundleCreate)
+	require.NoError(t, err)
+	assert.Equal(t, defaults.DefaultRuntimeVersion, options.RuntimeVersion)
+	assert.Empty(t, options.Dependencies)
+	assert.Empty(t, options.OutputFile)
+}
+
+func TestBundleCreateFlags(t *testing.T) {
+	options, rootCmd := initializeBundleCreateCmdOptions(t)
+	_, err := test.ExecuteCommand(rootCmd, cmdBundleCreate,
+		"--runtime-version", "3.2.3",
+		"-d", "camel:kafka",
+		"--dependency", "mvn:org.my:app:1.0",
+		"--maven-repository", "https://repo.my.org/maven2@id=my",
+		"--maven-cli-option", "-V",
+		"--output-file", "bundle.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, "3.2.3", options.RuntimeVersion)
+	assert.Equal(t, []string{"camel:kafka", "mvn:org.my:app:1.0"}, options.Dependencies)
+	assert.Equal(t, []string{"https://repo.my.org/maven2@id=my"}, options.MavenRepositories)
+	assert.Equal(t, []string{"-V"}, options.MavenCLIOptions)
+	assert.Equal(t, "bundle.tar.gz", options.OutputFile)
+}
+
+func TestBundleCreateArgs(t *testing.T) {
+	_, rootCmd := initializeBundleCreateCmdOptions(t)
+	_, err := test.ExecuteCommand(rootCmd, cmdBundleCreate, "unexpected")
+	require.Error(t, err)
+}
+
+func TestBundleDependencies(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+
+	dependencies := bundleDependencies(catalog, []string{"camel:kafka", "camel-quarkus-timer", "camel:kafka"})
+
+	for _, d := range catalog.Runtime.Dependencies {
+		assert.Contains(t, dependencies, d.GetDependencyID())
+	}
+	yaml := catalog.Loaders["yaml"]
+	assert.Contains(t, dependencies, yaml.GetDependencyID())
+	assert.Contains(t, dependencies, "camel:kafka")
+	assert.Contains(t, dependencies, "camel:timer")
+	count := 0
+	for _, d := range dependencies {
+		if d == "camel:kafka" {
+			count++
+		}
+	}
+	assert.Equal(t, 1, count)
+}
@@ -0,0 +1,496 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/trait"
+	"github.com/apache/camel-k/v2/pkg/util/digest"
+)
+
+const (
+	compareCategorySpec       = "spec"
+	compareCategorySource     = "source"
+	compareCategoryDependency = "dependency"
+	compareCategoryTrait      = "trait"
+	compareCategoryRuntime    = "runtime"
+	compareCategoryImage      = "image"
+	compareCategoryConfigMap  = "configmap"
+	compareCategorySecret     = "secret"
+
+	compareMissing = "<missing>"
+	comparePresent = "<present>"
+)
+
+func newCmdCompare(rootCmdOptions *RootCmdOptions) (*cobra.Command, *compareCmdOptions) {
+	options := compareCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+	cmd := cobra.Command{
+		Use:     "compare my-it --to <namespace> [--from <namespace>] [--to-context <context>]",
+		Short:   "Compare an Integration between two environments",
+		Long:    "Compare an Integration between two environments, reporting the differences in specification, traits, resolved dependencies, runtime, container image and configuration keys.",
+		PreRunE: decode(&options, options.Flags),
+		RunE:    options.run,
+	}
+
+	cmd.Flags().String("from", "", "The namespace of the reference Integration, defaults to the current namespace")
+	cmd.Flags().String("to", "", "The namespace of the Integration to compare")
+	cmd.Flags().String("to-context", "", "The kube config context of the cluster of the Integration to compare")
+	cmd.Flags().String("to-kubeconfig", "", "The kube config file of the cluster of the Integration to compare")
+	cmd.Flags().StringP("output", "o", "", "Output format. One of: json")
+
+	return &cmd, &options
+}
+
+type compareCmdOptions struct {
+	*RootCmdOptions
+	From         string `mapstructure:"from" yaml:",omitempty"`
+	To           string `mapstructure:"to" yaml:",omitempty"`
+	ToContext    string `mapstructure:"to-context" yaml:",omitempty"`
+	ToKubeConfig string `mapstructure:"to-kubeconfig" yaml:",omitempty"`
+	OutputFormat string `mapstructure:"output" yaml:",omitempty"`
+}
+
+// compareReport is the result of the comparison of an Integration between two environments.
+type compareReport struct {
+	Integration string              `json:"integration"`
+	From        compareEnvironment  `json:"from"`
+	To          compareEnvironment  `json:"to"`
+	Differences []compareDifference `json:"differences"`
+}
+
+type compareEnvironment struct {
+	Namespace string `json:"namespace"`
+	Context   string `json:"context,omitempty"`
+}
+
+// compareDifference is a single difference found between two environments. The values of Secrets and
+// Configmaps are never reported, only the presence of their keys.
+type compareDifference struct {
+	Category string `json:"category"`
+	Key      string `json:"key"`
+	From     string `json:"from,omitempty"`
+	To       string `json:"to,omitempty"`
+}
+
+func (o *compareCmdOptions) validate(_ *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return errors.New("compare requires an Integration name argument")
+	}
+	if o.To == "" {
+		return errors.New("compare requires a namespace to compare with as --to argument")
+	}
+	if o.OutputFormat != "" && o.OutputFormat != "json" {
+		return fmt.Errorf("invalid output format %s, only json is supported", o.OutputFormat)
+	}
+	if o.from() == o.To && o.ToContext == "" && o.ToKubeConfig == "" {
+		return errors.New("source and destination namespaces must be different when comparing within the same cluster")
+	}
+
+	return nil
+}
+
+func (o *compareCmdOptions) from() string {
+	if o.From != "" {
+		return o.From
+	}
+	return o.Namespace
+}
+
+func (o *compareCmdOptions) run(cmd *cobra.Command, args []string) error {
+	if err := o.validate(cmd, args); err != nil {
+		return err
+	}
+
+	name := args[0]
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return fmt.Errorf("could not retrieve cluster client: %w", err)
+	}
+	dc, err := destinationClient(c, o.KubeConfig, o.ToKubeConfig, o.ToContext)
+	if err != nil {
+		return fmt.Errorf("could not retrieve destination cluster client: %w", err)
+	}
+
+	fromIt, err := getIntegration(o.Context, c, name, o.from())
+	if err != nil {
+		return fmt.Errorf("could not get Integration %s in namespace %s: %w", name, o.from(), err)
+	}
+	toIt, err := getIntegration(o.Context, dc, name, o.To)
+	if err != nil {
+		return fmt.Errorf("could not get Integration %s in namespace %s: %w", name, o.To, err)
+	}
+
+	report, err := compareIntegrations(o.Context, c, dc, fromIt, toIt)
+	if err != nil {
+		return err
+	}
+	report.To.Context = o.ToContext
+
+	if o.OutputFormat == "json" {
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	}
+
+	return printCompareReport(cmd, report)
+}
+
+// compareIntegrations returns the differences between the Integration in the source environment and the one in the
+// destination environment. Each Integration resources are looked up with the client of its own environment.
+func compareIntegrations(ctx context.Context, c client.Client, dc client.Client, from *v1.Integration, to *v1.Integration) (*compareReport, error) {
+	report := compareReport{
+		Integration: from.Name,
+		From:        compareEnvironment{Namespace: from.Namespace},
+		To:          compareEnvironment{Namespace: to.Namespace},
+		Differences: make([]compareDifference, 0),
+	}
+
+	// Specification
+	specDiffs, err := compareSpecs(from, to)
+	if err != nil {
+		return nil, err
+	}
+	report.Differences = append(report.Differences, specDiffs...)
+
+	// Traits
+	traitDiffs, err := compareTraits(from.Spec.Traits, to.Spec.Traits)
+	if err != nil {
+		return nil, err
+	}
+	report.Differences = append(report.Differences, traitDiffs...)
+
+	// Resolved dependencies
+	report.Differences = append(report.Differences,
+		compareSets(compareCategoryDependency, "", from.Status.Dependencies, to.Status.Dependencies)...)
+
+	// Runtime
+	report.Differences = append(report.Differences,
+		compareValues(compareCategoryRuntime, "provider", string(from.Status.RuntimeProvider), string(to.Status.RuntimeProvider))...)
+	report.Differences = append(report.Differences,
+		compareValues(compareCategoryRuntime, "version", from.Status.RuntimeVersion, to.Status.RuntimeVersion)...)
+
+	// Kits and images
+	report.Differences = append(report.Differences,
+		compareValues(compareCategoryImage, "kit", kitName(from), kitName(to))...)
+	report.Differences = append(report.Differences,
+		compareValues(compareCategoryImage, "image", from.Status.Image, to.Status.Image)...)
+	report.Differences = append(report.Differences,
+		compareValues(compareCategoryImage, "digest", from.Status.Digest, to.Status.Digest)...)
+
+	// Configuration keys
+	configDiffs, err := compareConfigurations(ctx, c, dc, from, to)
+	if err != nil {
+		return nil, err
+	}
+	report.Differences = append(report.Differences, configDiffs...)
+
+	return &report, nil
+}
+
+func compareSpecs(from *v1.Integration, to *v1.Integration) ([]compareDifference, error) {
+	diffs := make([]compareDifference, 0)
+
+	fromSources, err := sourceDigests(from.Spec.Sources)
+	if err != nil {
+		return nil, err
+	}
+	toSources, err := sourceDigests(to.Spec.Sources)
+	if err != nil {
+		return nil, err
+	}
+	diffs = append(diffs, compareMaps(compareCategorySource, fromSources, toSources)...)
+
+	fromFlows, err := json.Marshal(from.Spec.Flows)
+	if err != nil {
+		return nil, err
+	}
+	toFlows, err := json.Marshal(to.Spec.Flows)
+	if err != nil {
+		return nil, err
+	}
+	diffs = append(diffs, compareValues(compareCategorySpec, "flows", digestOf(fromFlows), digestOf(toFlows))...)
+	diffs = append(diffs, compareSets(compareCategorySpec, "dependencies/", from.Spec.Dependencies, to.Spec.Dependencies)...)
+	diffs = append(diffs, compareValues(compareCategorySpec, "replicas", replicas(from.Spec.Replicas), replicas(to.Spec.Replicas))...)
+	diffs = append(diffs, compareValues(compareCategorySpec, "profile", string(from.Spec.Profile), string(to.Spec.Profile))...)
+	diffs = append(diffs, compareValues(compareCategorySpec, "serviceAccountName", from.Spec.ServiceAccountName, to.Spec.ServiceAccountName)...)
+
+	return diffs, nil
+}
+
+// sourceDigests returns the digest of the content of each source, by source name.
+func sourceDigests(sources []v1.SourceSpec) (map[string]string, error) {
+	digests := make(map[string]string, len(sources))
+	for _, s := range sources {
+		d, err := digest.ComputeForSource(s)
+		if err != nil {
+			return nil, err
+		}
+		digests[s.Name] = d
+	}
+
+	return digests, nil
+}
+
+func digestOf(data []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+func replicas(r *int32) string {
+	if r == nil {
+		return ""
+	}
+	return strconv.Itoa(int(*r))
+}
+
+func kitName(it *v1.Integration) string {
+	if it.Status.IntegrationKit == nil {
+		return ""
+	}
+	return it.Status.IntegrationKit.Name
+}
+
+// compareTraits compares each trait property, using the same legacy configuration migration applied by
+// the promotion.
+func compareTraits(from v1.Traits, to v1.Traits) ([]compareDifference, error) {
+	fromProps, err := flattenTraits(from)
+	if err != nil {
+		return nil, err
+	}
+	toProps, err := flattenTraits(to)
+	if err != nil {
+		return nil, err
+	}
+
+	return compareMaps(compareCategoryTrait, fromProps, toProps), nil
+}
+
+func flattenTraits(traits v1.Traits) (map[string]string, error) {
+	traitMap, err := trait.ToTraitMap(traits)
+	if err != nil {
+		return nil, err
+	}
+	props := make(map[string]string)
+	for name, config := range traitMap {
+		if err := trait.MigrateLegacyConfiguration(config); err != nil {
+			return nil, err
+		}
+		for k, v := range config {
+			if value, ok := v.(string); ok {
+				props[name+"."+k] = value
+				continue
+			}
+			value, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			props[name+"."+k] = string(value)
+		}
+	}
+
+	return props, nil
+}
+
+// compareConfigurations compares the keys of the Configmaps and Secrets referenced by any of the Integrations.
+func compareConfigurations(ctx context.Context, c client.Client, dc client.Client, from *v1.Integration, to *v1.Integration) ([]compareDifference, error) {
+	fromResources, err := listConfigurationResources(from)
+	if err != nil {
+		return nil, err
+	}
+	toResources, err := listConfigurationResources(to)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs := make([]compareDifference, 0)
+	for _, name := range unionOf(fromResources.ConfigMaps, toResources.ConfigMaps) {
+		fromKeys, err := configMapKeys(ctx, c, from.Namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		toKeys, err := configMapKeys(ctx, dc, to.Namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, compareKeys(compareCategoryConfigMap, name, fromKeys, toKeys)...)
+	}
+	for _, name := range unionOf(fromResources.Secrets, toResources.Secrets) {
+		fromKeys, err := secretKeys(ctx, c, from.Namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		toKeys, err := secretKeys(ctx, dc, to.Namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, compareKeys(compareCategorySecret, name, fromKeys, toKeys)...)
+	}
+
+	return diffs, nil
+}
+
+// configMapKeys returns the keys of the Configmap, or nil if it does not exist.
+func configMapKeys(ctx context.Context, c client.Client, namespace string, name string) ([]string, error) {
+	var cm corev1.ConfigMap
+	if err := c.Get(ctx, k8sclient.ObjectKey{Namespace: namespace, Name: name}, &cm); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	keys := make([]string, 0, len(cm.Data)+len(cm.BinaryData))
+	for k := range cm.Data {
+		keys = append(keys, k)
+	}
+	for k := range cm.BinaryData {
+		keys = append(keys, k)
+	}
+
+	return keys, nil
+}
+
+// secretKeys returns the keys of the Secret, or nil if it does not exist.
+func secretKeys(ctx context.Context, c client.Client, namespace string, name string) ([]string, error) {
+	var secret corev1.Secret
+	if err := c.Get(ctx, k8sclient.ObjectKey{Namespace: namespace, Name: name}, &secret); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	keys := make([]string, 0, len(secret.Data)+len(secret.StringData))
+	for k := range secret.Data {
+		keys = append(keys, k)
+	}
+	for k := range secret.StringData {
+		keys = append(keys, k)
+	}
+
+	return keys, nil
+}
+
+func compareKeys(category string, name string, from []string, to []string) []compareDifference {
+	switch {
+	case from == nil && to == nil:
+		// Missing on both sides is not a difference
+		return nil
+	case from == nil:
+		return []compareDifference{{Category: category, Key: name, From: compareMissing, To: comparePresent}}
+	case to == nil:
+		return []compareDifference{{Category: category, Key: name, From: comparePresent, To: compareMissing}}
+	}
+
+	return compareSets(category, name+"/", from, to)
+}
+
+func compareValues(category string, key string, from string, to string) []compareDifference {
+	if from == to {
+		return nil
+	}
+	return []compareDifference{{Category: category, Key: key, From: valueOrMissing(from), To: valueOrMissing(to)}}
+}
+
+// compareSets reports the items which are present in only one of the lists, using the prefixed item as key.
+func compareSets(category string, prefix string, from []string, to []string) []compareDifference {
+	fromSet := make(map[string]string, len(from))
+	for _, item := range from {
+		fromSet[prefix+item] = comparePresent
+	}
+	toSet := make(map[string]string, len(to))
+	for _, item := range to {
+		toSet[prefix+item] = comparePresent
+	}
+
+	return compareMaps(category, fromSet, toSet)
+}
+
+// compareMaps reports the keys having different values, sorted by key.
+func compareMaps(category string, from map[string]string, to map[string]string) []compareDifference {
+	keys := make([]string, 0, len(from)+len(to))
+	for k := range from {
+		keys = append(keys, k)
+	}
+	for k := range to {
+		if _, ok := from[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	diffs := make([]compareDifference, 0)
+	for _, k := range keys {
+		diffs = append(diffs, compareValues(category, k, from[k], to[k])...)
+	}
+
+	return diffs
+}
+
+func unionOf(a []string, b []string) []string {
+	set := make(map[string]bool, len(a)+len(b))
+	for _, item := range append(append([]string{}, a...), b...) {
+		set[item] = true
+	}
+	union := make([]string, 0, len(set))
+	for item := range set {
+		union = append(union, item)
+	}
+	sort.Strings(union)
+
+	return union
+}
+
+func valueOrMissing(value string) string {
+	if value == "" {
+		return compareMissing
+	}
+	return value
+}
+
+func printCompareReport(cmd *cobra.Command, report *compareReport) error {
+	fmt.Fprintf(cmd.OutOrStdout(), "Comparing Integration %s from namespace %s to namespace %s\n",
+		report.Integration, report.From.Namespace, report.To.Namespace)
+	if len(report.Differences) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "No differences found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "CATEGORY\tKEY\tFROM\tTO")
+	for _, d := range report.Differences {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", d.Category, d.Key, d.From, d.To)
+	}
+
+	return w.Flush()
+}
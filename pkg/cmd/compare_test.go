@@ -0,0 +1,182 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/util/defaults"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const cmdCompare = "compare"
+
+// nolint: unparam
+func initializeCompareCmdOptions(t *testing.T, initObjs ...runtime.Object) (*compareCmdOptions, *cobra.Command, RootCmdOptions) {
+	t.Helper()
+	fakeClient, err := test.NewFakeClient(initObjs...)
+	require.NoError(t, err)
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	compareCmdOptions := addTestCompareCmd(*options, rootCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return compareCmdOptions, rootCmd, *options
+}
+
+func addTestCompareCmd(options RootCmdOptions, rootCmd *cobra.Command) *compareCmdOptions {
+	compareCmd, compareOptions := newCmdCompare(&options)
+	compareCmd.Args = test.ArbitraryArgs
+	rootCmd.AddCommand(compareCmd)
+	return compareOptions
+}
+
+func compareTestObjects() []runtime.Object {
+	srcPlatform := v1.NewIntegrationPlatform("default", platform.DefaultPlatformName)
+	srcPlatform.Status.Build.RuntimeVersion = defaults.DefaultRuntimeVersion
+	dstPlatform := v1.NewIntegrationPlatform("prod-namespace", platform.DefaultPlatformName)
+	dstPlatform.Status.Build.RuntimeVersion = defaults.DefaultRuntimeVersion
+	srcCatalog := createTestCamelCatalog(srcPlatform)
+	dstCatalog := createTestCamelCatalog(dstPlatform)
+
+	devIt, _ := nominalIntegration("my-it-test")
+	devIt.Spec.Traits.Mount = &traitv1.MountTrait{
+		Configs: []string{"configmap:my-cm", "secret:my-secret"},
+	}
+	devIt.Status.Dependencies = []string{"camel:log", "camel:timer"}
+	devIt.Status.RuntimeVersion = defaults.DefaultRuntimeVersion
+
+	prodIt := devIt.DeepCopy()
+	prodIt.Namespace = "prod-namespace"
+	prodIt.Spec.Traits.Container = &traitv1.ContainerTrait{
+		LimitMemory: "1Gi",
+	}
+	prodIt.Status.Dependencies = []string{"camel:log"}
+
+	devCm := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "my-cm"},
+		Data:       map[string]string{"greeting": "hello, I am development!", "dev-only": "true"},
+	}
+	prodCm := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "prod-namespace", Name: "my-cm"},
+		Data:       map[string]string{"greeting": "hello, I am production!"},
+	}
+	devSecret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "my-secret"},
+		Data:       map[string][]byte{"password": []byte("dev")},
+	}
+
+	return []runtime.Object{&srcPlatform, &dstPlatform, &srcCatalog, &dstCatalog, &devIt, prodIt, &devCm, &prodCm, &devSecret}
+}
+
+func TestCompareMissingTo(t *testing.T) {
+	_, compareCmd, _ := initializeCompareCmdOptions(t)
+	_, err := test.ExecuteCommand(compareCmd, cmdCompare, "my-it-test", "-n", "default")
+	require.Error(t, err)
+	assert.Equal(t, "compare requires a namespace to compare with as --to argument", err.Error())
+}
+
+func TestCompareIntegration(t *testing.T) {
+	_, compareCmd, _ := initializeCompareCmdOptions(t, compareTestObjects()...)
+	output, err := test.ExecuteCommand(compareCmd, cmdCompare, "my-it-test", "--to", "prod-namespace", "-n", "default")
+	require.NoError(t, err)
+	assert.Equal(t, `Comparing Integration my-it-test from namespace default to namespace prod-namespace
+CATEGORY	KEY			FROM		TO
+trait		container.limitMemory	<missing>	1Gi
+dependency	camel:timer		<present>	<missing>
+configmap	my-cm/dev-only		<present>	<missing>
+secret		my-secret		<present>	<missing>
+`, output)
+}
+
+func TestCompareIntegrationJSON(t *testing.T) {
+	_, compareCmd, _ := initializeCompareCmdOptions(t, compareTestObjects()...)
+	output, err := test.ExecuteCommand(compareCmd, cmdCompare, "my-it-test", "--to", "prod-namespace", "-o", "json", "-n", "default")
+	require.NoError(t, err)
+	assert.Equal(t, `{
+  "integration": "my-it-test",
+  "from": {
+    "namespace": "default"
+  },
+  "to": {
+    "namespace": "prod-namespace"
+  },
+  "differences": [
+    {
+      "category": "trait",
+      "key": "container.limitMemory",
+      "from": "<missing>",
+      "to": "1Gi"
+    },
+    {
+      "category": "dependency",
+      "key": "camel:timer",
+      "from": "<present>",
+      "to": "<missing>"
+    },
+    {
+      "category": "configmap",
+      "key": "my-cm/dev-only",
+      "from": "<present>",
+      "to": "<missing>"
+    },
+    {
+      "category": "secret",
+      "key": "my-secret",
+      "from": "<present>",
+      "to": "<missing>"
+    }
+  ]
+}
+`, output)
+}
+
+func TestCompareKeys(t *testing.T) {
+	assert.Empty(t, compareKeys(compareCategoryConfigMap, "my-cm", nil, nil))
+	assert.Empty(t, compareKeys(compareCategoryConfigMap, "my-cm", []string{"a"}, []string{"a"}))
+	assert.Equal(t, []compareDifference{{Category: compareCategoryConfigMap, Key: "my-cm", From: compareMissing, To: comparePresent}},
+		compareKeys(compareCategoryConfigMap, "my-cm", nil, []string{"a"}))
+	assert.Equal(t, []compareDifference{{Category: compareCategoryConfigMap, Key: "my-cm/b", From: comparePresent, To: compareMissing}},
+		compareKeys(compareCategoryConfigMap, "my-cm", []string{"a", "b"}, []string{"a"}))
+}
+
+func TestCompareConfigurationsWithoutCatalog(t *testing.T) {
+	objs := compareTestObjects()
+	devIt := objs[4].(*v1.Integration)
+	prodIt := objs[5].(*v1.Integration)
+	// No platform nor catalog is required to compare the Configmaps and Secrets
+	c, err := test.NewFakeClient(objs[6:]...)
+	require.NoError(t, err)
+
+	diffs, err := compareConfigurations(context.TODO(), c, c, devIt, prodIt)
+	require.NoError(t, err)
+	assert.Equal(t, []compareDifference{
+		{Category: compareCategoryConfigMap, Key: "my-cm/dev-only", From: comparePresent, To: compareMissing},
+		{Category: compareCategorySecret, Key: "my-secret", From: comparePresent, To: compareMissing},
+	}, diffs)
+}
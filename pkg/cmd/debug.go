@@ -65,6 +65,8 @@ type debugCmdOptions struct {
 	Suspend         bool `mapstructure:"suspend" yaml:",omitempty"`
 	Port            uint `mapstructure:"port" yaml:",omitempty"`
 	RemotePort      uint `mapstructure:"remote-port" yaml:",omitempty"`
+	// changedBy is the user toggling the debug mode, recorded for the operator audit trail
+	changedBy string
 }
 
 func (o *debugCmdOptions) validateArgs(_ *cobra.Command, args []string) error {
@@ -89,6 +91,12 @@ func (o *debugCmdOptions) run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	cmdClient, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+	o.changedBy = currentUser(o.Context, cmdClient)
+
 	fmt.Fprintf(cmd.OutOrStdout(), "Enabling debug mode on integration %q...\n", name)
 	if _, err := o.toggleDebug(c, it, true); err != nil {
 		return err
@@ -116,11 +124,6 @@ func (o *debugCmdOptions) run(cmd *cobra.Command, args []string) error {
 		os.Exit(0)
 	}()
 
-	cmdClient, err := o.GetCmdClient()
-	if err != nil {
-		return err
-	}
-
 	selector := fmt.Sprintf("camel.apache.org/debug=true,camel.apache.org/integration=%s", name)
 
 	go func() {
@@ -135,6 +138,7 @@ func (o *debugCmdOptions) run(cmd *cobra.Command, args []string) error {
 
 func (o *debugCmdOptions) toggleDebug(c camelv1.IntegrationsGetter, it *v1.Integration, active bool) (*v1.Integration, error) {
 	it = o.toggle(it, active)
+	setChangedBy(it, o.changedBy)
 	return c.Integrations(it.Namespace).Update(o.Context, it, metav1.UpdateOptions{})
 }
 
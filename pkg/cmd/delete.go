@@ -52,16 +52,24 @@ func newCmdDelete(rootCmdOptions *RootCmdOptions) (*cobra.Command, *deleteCmdOpt
 	}
 
 	cmd.Flags().Bool("all", false, "Delete all integrations")
+	cmd.Flags().StringP("file", "f", "", "Delete all the resources of the given project manifest")
 
 	return &cmd, &options
 }
 
 type deleteCmdOptions struct {
 	*RootCmdOptions
-	DeleteAll bool `mapstructure:"all"`
+	DeleteAll bool   `mapstructure:"all"`
+	File      string `mapstructure:"file"`
 }
 
 func (command *deleteCmdOptions) validate(args []string) error {
+	if command.File != "" {
+		if command.DeleteAll || len(args) > 0 {
+			return errors.New("invalid combination: --file flag cannot be used with --all flag or integration names")
+		}
+		return nil
+	}
 	if command.DeleteAll && len(args) > 0 {
 		return errors.New("invalid combination: --all flag is set and at least one integration name is provided")
 	}
@@ -77,6 +85,9 @@ func (command *deleteCmdOptions) run(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	if command.File != "" {
+		return command.deleteProject(cmd, c)
+	}
 	if len(args) != 0 && !command.DeleteAll {
 		for _, arg := range args {
 			name := kubernetes.SanitizeName(arg)
@@ -124,6 +135,24 @@ func (command *deleteCmdOptions) run(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// deleteProject deletes all the resources labelled as part of the project.
+func (command *deleteCmdOptions) deleteProject(cmd *cobra.Command, c client.Client) error {
+	p, err := loadProject(command.File)
+	if err != nil {
+		return err
+	}
+	plan, err := planProject(command.Context, c, p, command.Namespace, nil, true)
+	if err != nil {
+		return err
+	}
+	if len(plan) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "Nothing to delete")
+		return nil
+	}
+
+	return executeProjectPlan(command.Context, cmd, c, plan)
+}
+
 func getIntegration(ctx context.Context, c client.Client, name string, namespace string) (*v1.Integration, error) {
 	key := k8sclient.ObjectKey{
 		Name:      name,
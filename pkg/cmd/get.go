@@ -19,6 +19,7 @@ package cmd
 
 import (
 	"fmt"
+	"strconv"
 	"text/tabwriter"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -79,14 +80,28 @@ func (o *getCmdOptions) run(cmd *cobra.Command, args []string) error {
 	}
 
 	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
-	fmt.Fprintln(w, "NAME\tPHASE\tKIT")
+	fmt.Fprintln(w, "NAME\tPHASE\tKIT\tREADY\tEXCHANGES\tFAILED\tINFLIGHT\tMEAN TIME")
 	for _, integration := range integrationList.Items {
 		kit := ""
 		if integration.Status.IntegrationKit != nil {
 			ns := integration.GetIntegrationKitNamespace(nil)
 			kit = fmt.Sprintf("%s/%s", ns, integration.Status.IntegrationKit.Name)
 		}
-		fmt.Fprintf(w, "%s\t%s\t%s\n", integration.Name, string(integration.Status.Phase), kit)
+		ready := ""
+		if condition := integration.Status.GetCondition(v1.IntegrationConditionReady); condition != nil {
+			ready = string(condition.Status)
+		}
+		exchanges, failed, inflight, mean := "", "", "", ""
+		if m := integration.Status.Metrics; m != nil {
+			exchanges = strconv.FormatInt(m.ExchangesTotal, 10)
+			failed = strconv.FormatInt(m.ExchangesFailed, 10)
+			inflight = strconv.FormatInt(m.ExchangesInflight, 10)
+			if m.MeanProcessingTime != nil {
+				mean = m.MeanProcessingTime.Duration.String()
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", integration.Name, string(integration.Status.Phase), kit,
+			ready, exchanges, failed, inflight, mean)
 	}
 
 	return w.Flush()
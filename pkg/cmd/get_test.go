@@ -0,0 +1,65 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+)
+
+func TestGetIntegrationsWithRuntimeMetrics(t *testing.T) {
+	withMetrics := v1.NewIntegration("default", "with-metrics")
+	withMetrics.Status.Phase = v1.IntegrationPhaseRunning
+	withMetrics.Status.SetCondition(v1.IntegrationConditionReady, corev1.ConditionTrue, v1.IntegrationConditionDeploymentReadyReason, "")
+	withMetrics.Status.Metrics = &v1.IntegrationRuntimeMetrics{
+		Pods:               2,
+		ExchangesTotal:     120,
+		ExchangesFailed:    3,
+		ExchangesInflight:  1,
+		MeanProcessingTime: &metav1.Duration{Duration: 15 * time.Millisecond},
+	}
+	withoutMetrics := v1.NewIntegration("default", "without-metrics")
+	withoutMetrics.Status.Phase = v1.IntegrationPhaseBuildingKit
+
+	defaultPlatform := v1.NewIntegrationPlatform("default", platform.DefaultPlatformName)
+	fakeClient, err := test.NewFakeClient(&withMetrics, &withoutMetrics, &defaultPlatform)
+	require.NoError(t, err)
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	cmd, _ := newCmdGet(options)
+	rootCmd.AddCommand(cmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	output, err := test.ExecuteCommand(rootCmd, "get")
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, []string{"NAME", "PHASE", "KIT", "READY", "EXCHANGES", "FAILED", "INFLIGHT", "MEAN", "TIME"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"with-metrics", "Running", "True", "120", "3", "1", "15ms"}, strings.Fields(lines[1]))
+	assert.Equal(t, []string{"without-metrics", "Building", "Kit"}, strings.Fields(lines[2]))
+}
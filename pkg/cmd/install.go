@@ -130,6 +130,10 @@ func newCmdInstall(rootCmdOptions *RootCmdOptions) (*cobra.Command, *installCmdO
 	cmd.Flags().StringArray("maven-repository", nil, "Add a Maven repository")
 	cmd.Flags().String("maven-ca-secret", "", "Configure the secret key containing the Maven CA certificates (secret/key)")
 	cmd.Flags().StringArray("maven-cli-option", nil, "Add a default Maven CLI option to the list of arguments for Maven commands")
+	cmd.Flags().String("offline-bundle", "", "Install the Maven offline bundle archive, created with \"kamel bundle create\", as the only Maven repository")
+	cmd.Flags().String("offline-bundle-storage-class", "", "The storage class of the PersistentVolumeClaim holding the Maven offline bundle")
+	cmd.Flags().String("offline-bundle-access-mode", string(corev1.ReadWriteMany), "The access mode of the PersistentVolumeClaim holding the Maven offline bundle")
+	cmd.Flags().String("offline-bundle-loader-image", "", "The image, providing the tar command, of the Pod loading the Maven offline bundle (default: the operator image)")
 
 	// health
 	cmd.Flags().Int("health-port", 8081, "The port of the health endpoint")
@@ -189,6 +193,10 @@ type installCmdOptions struct {
 	MavenSettings            string   `mapstructure:"maven-settings"`
 	MavenCASecret            string   `mapstructure:"maven-ca-secret"`
 	MavenCLIOptions          []string `mapstructure:"maven-cli-options"`
+	OfflineBundle            string   `mapstructure:"offline-bundle"`
+	OfflineBundleStorage     string   `mapstructure:"offline-bundle-storage-class"`
+	OfflineBundleAccessMode  string   `mapstructure:"offline-bundle-access-mode"`
+	OfflineBundleLoaderImage string   `mapstructure:"offline-bundle-loader-image"`
 	HealthPort               int32    `mapstructure:"health-port"`
 	MaxRunningBuilds         int32    `mapstructure:"max-running-pipelines"`
 	Monitoring               bool     `mapstructure:"monitoring"`
@@ -337,6 +345,16 @@ func (o *installCmdOptions) installOperator(cmd *cobra.Command, output *kubernet
 		platformName = platformutil.DefaultPlatformName
 	}
 
+	// Set up the Maven offline bundle, before the operator that mounts it
+	if o.OfflineBundle != "" {
+		if olm {
+			return errors.New("the Maven offline bundle cannot be installed via OLM: use the --olm=false option")
+		}
+		if err := o.setupMavenOfflineBundle(cmd, c, namespace); err != nil {
+			return err
+		}
+	}
+
 	// Set up operator
 	if !olm {
 		if !o.SkipOperatorSetup {
@@ -442,10 +460,31 @@ func (o *installCmdOptions) setupOperator(
 		ResourcesRequirements: o.ResourcesRequirements,
 		EnvVars:               o.EnvVars,
 	}
+	if o.OfflineBundle != "" {
+		cfg.MavenOfflineBundle = install.MavenOfflineBundleName
+	}
 
 	return install.OperatorOrCollect(o.Context, cmd, c, cfg, output, o.Force)
 }
 
+func (o *installCmdOptions) setupMavenOfflineBundle(cmd *cobra.Command, c client.Client, namespace string) error {
+	fmt.Fprintln(cmd.OutOrStdout(), "Loading the Maven offline bundle", o.OfflineBundle)
+
+	loaderImage := o.OfflineBundleLoaderImage
+	if loaderImage == "" {
+		// The operator image is available in the disconnected environment, e.g. from a mirror registry
+		loaderImage = o.OperatorImage
+	}
+
+	return install.MavenOfflineBundle(o.Context, c, install.MavenOfflineBundleConfiguration{
+		Namespace:        namespace,
+		Bundle:           o.OfflineBundle,
+		LoaderImage:      loaderImage,
+		StorageClassName: o.OfflineBundleStorage,
+		AccessMode:       corev1.PersistentVolumeAccessMode(o.OfflineBundleAccessMode),
+	})
+}
+
 func isInstallAllowed(ctx context.Context, c client.Client, operatorID string, force bool, out io.Writer) (bool, error) {
 	// find existing platform with given name in any namespace
 	pl, err := platformutil.LookupForPlatformName(ctx, c, operatorID)
@@ -579,6 +618,12 @@ func (o *installCmdOptions) setupIntegrationPlatform(c client.Client, namespace
 		platform.Spec.Build.Maven.Settings = mavenSettings
 	}
 
+	if o.OfflineBundle != "" {
+		platform.Spec.Build.Maven.OfflineBundle = &v1.MavenOfflineBundleSpec{
+			PersistentVolumeClaim: install.MavenOfflineBundleName,
+		}
+	}
+
 	if o.MavenCASecret != "" {
 		secret, err := decodeSecretKeySelector(o.MavenCASecret)
 		if err != nil {
@@ -710,6 +755,21 @@ func (o *installCmdOptions) validate(_ *cobra.Command, _ []string) error {
 		result = multierr.Append(result, err)
 	}
 
+	if o.OfflineBundle != "" {
+		if o.OutputFormat != "" {
+			result = multierr.Append(result, errors.New("incompatible options combinations: you cannot set both offline-bundle and output"))
+		}
+		if len(o.MavenRepositories) > 0 {
+			result = multierr.Append(result, errors.New("incompatible options combinations: you cannot set both offline-bundle and maven-repository"))
+		}
+		if o.MavenLocalRepository != "" {
+			result = multierr.Append(result, errors.New("incompatible options combinations: you cannot set both offline-bundle and maven-local-repository"))
+		}
+		if _, err := os.Stat(o.OfflineBundle); err != nil {
+			result = multierr.Append(result, err)
+		}
+	}
+
 	if o.TraitProfile != "" {
 		tp := v1.TraitProfileByName(o.TraitProfile)
 		if tp == v1.TraitProfile("") {
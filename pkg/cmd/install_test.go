@@ -18,6 +18,8 @@ limitations under the License.
 package cmd
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/spf13/cobra"
@@ -188,6 +190,40 @@ func TestInstallMavenSettingsFlag(t *testing.T) {
 	assert.Equal(t, "someString", installCmdOptions.MavenSettings)
 }
 
+func TestInstallOfflineBundleFlag(t *testing.T) {
+	installCmdOptions, rootCmd, _ := initializeInstallCmdOptions(t)
+	_, err := test.ExecuteCommand(rootCmd, cmdInstall,
+		"--offline-bundle", "bundle.tar.gz",
+		"--offline-bundle-storage-class", "nfs",
+		"--offline-bundle-access-mode", "ReadOnlyMany",
+		"--offline-bundle-loader-image", "registry.my.org/busybox:latest")
+	require.NoError(t, err)
+	assert.Equal(t, "bundle.tar.gz", installCmdOptions.OfflineBundle)
+	assert.Equal(t, "nfs", installCmdOptions.OfflineBundleStorage)
+	assert.Equal(t, "ReadOnlyMany", installCmdOptions.OfflineBundleAccessMode)
+	assert.Equal(t, "registry.my.org/busybox:latest", installCmdOptions.OfflineBundleLoaderImage)
+}
+
+func TestInstallOfflineBundleValidation(t *testing.T) {
+	bundle := filepath.Join(t.TempDir(), "bundle.tar.gz")
+	require.NoError(t, os.WriteFile(bundle, []byte{}, 0o600))
+
+	options := installCmdOptions{OperatorID: "camel-k", OfflineBundle: bundle}
+	require.NoError(t, options.validate(nil, nil))
+
+	options.MavenRepositories = []string{"https://repo.my.org/maven2"}
+	options.MavenLocalRepository = "/tmp/m2"
+	options.OutputFormat = "yaml"
+	err := options.validate(nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "you cannot set both offline-bundle and output")
+	assert.Contains(t, err.Error(), "you cannot set both offline-bundle and maven-repository")
+	assert.Contains(t, err.Error(), "you cannot set both offline-bundle and maven-local-repository")
+
+	options = installCmdOptions{OperatorID: "camel-k", OfflineBundle: filepath.Join(t.TempDir(), "missing.tar.gz")}
+	require.Error(t, options.validate(nil, nil))
+}
+
 func TestInstallMonitoringFlag(t *testing.T) {
 	installCmdOptions, rootCmd, _ := initializeInstallCmdOptions(t)
 	_, err := test.ExecuteCommand(rootCmd, cmdInstall,
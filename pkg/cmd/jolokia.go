@@ -0,0 +1,127 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util/jolokia"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+const (
+	jolokiaPortName = "jolokia"
+	// jolokiaInsecureFlagUsage is the usage of the flag skipping the verification of the agent certificate
+	jolokiaInsecureFlagUsage = "Skip the verification of the certificate of the Jolokia agent, when it is served over HTTPS. " +
+		"The certificate is otherwise verified against the port-forwarded localhost address"
+)
+
+var errJolokiaNotEnabled = errors.New("jolokia trait not enabled")
+
+// jolokiaEndpoint is the Jolokia agent configured by the jolokia trait in the integration container of a Pod.
+type jolokiaEndpoint struct {
+	port     uint
+	protocol string
+	user     string
+	password string
+}
+
+// jolokiaEndpointOf returns the Jolokia agent of the Pod, as configured by the jolokia trait with the agent options.
+func jolokiaEndpointOf(pod *corev1.Pod) (*jolokiaEndpoint, error) {
+	for _, container := range pod.Spec.Containers {
+		for _, port := range container.Ports {
+			if port.Name != jolokiaPortName {
+				continue
+			}
+			endpoint := jolokiaEndpoint{
+				port:     uint(port.ContainerPort),
+				protocol: "http",
+			}
+			for _, arg := range container.Args {
+				if !strings.HasPrefix(arg, "-javaagent:") || !strings.Contains(arg, "jolokia") {
+					continue
+				}
+				_, options, _ := strings.Cut(arg, "=")
+				for _, option := range strings.Split(options, ",") {
+					key, value, _ := strings.Cut(option, "=")
+					switch key {
+					case "protocol":
+						endpoint.protocol = value
+					case "user":
+						endpoint.user = value
+					case "password":
+						endpoint.password = value
+					}
+				}
+			}
+			return &endpoint, nil
+		}
+	}
+
+	return nil, errJolokiaNotEnabled
+}
+
+// jolokiaClients port-forwards the Jolokia agent of the Pods, and caches the clients by Pod name.
+type jolokiaClients struct {
+	ctx      context.Context
+	client   client.Client
+	stdErr   io.Writer
+	insecure bool
+	clients  map[string]*jolokia.Client
+}
+
+// newJolokiaClients returns the clients of the Jolokia agents, that skip the verification of the agent
+// certificate when insecure is true.
+func newJolokiaClients(ctx context.Context, c client.Client, stdErr io.Writer, insecure bool) *jolokiaClients {
+	return &jolokiaClients{
+		ctx:      ctx,
+		client:   c,
+		stdErr:   stdErr,
+		insecure: insecure,
+		clients:  make(map[string]*jolokia.Client),
+	}
+}
+
+// forPod returns a client of the Jolokia agent of the Pod, port-forwarding it on first use.
+func (j *jolokiaClients) forPod(pod *corev1.Pod) (*jolokia.Client, error) {
+	if c, ok := j.clients[pod.Name]; ok {
+		return c, nil
+	}
+
+	endpoint, err := jolokiaEndpointOf(pod)
+	if err != nil {
+		return nil, err
+	}
+	address, err := kubernetes.PortForwardPod(j.ctx, j.client, pod.Namespace, pod.Name, endpoint.port, io.Discard, j.stdErr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to port-forward the Jolokia agent of Pod %s: %w", pod.Name, err)
+	}
+
+	c := jolokia.NewClient(endpoint.protocol+"://"+address+"/jolokia", j.insecure).
+		WithBasicAuth(endpoint.user, endpoint.password)
+	j.clients[pod.Name] = c
+
+	return c, nil
+}
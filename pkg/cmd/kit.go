@@ -31,6 +31,7 @@ func newCmdKit(rootCmdOptions *RootCmdOptions) *cobra.Command {
 	cmd.AddCommand(cmdOnly(newKitCreateCmd(rootCmdOptions)))
 	cmd.AddCommand(cmdOnly(newKitDeleteCmd(rootCmdOptions)))
 	cmd.AddCommand(cmdOnly(newKitGetCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newKitLogsCmd(rootCmdOptions)))
 
 	return &cmd
 }
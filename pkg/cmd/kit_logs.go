@@ -0,0 +1,79 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+func newKitLogsCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *kitLogsCommandOptions) {
+	options := kitLogsCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "logs <integration kit>",
+		Short:   "Print the log of the Build of an Integration Kit",
+		Long:    `Print the log of the Build of an Integration Kit, streamed while the Build is running or read from its archive.`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.run(cmd, args[0])
+		},
+	}
+
+	return &cmd, &options
+}
+
+type kitLogsCommandOptions struct {
+	*RootCmdOptions
+}
+
+func (command *kitLogsCommandOptions) run(cmd *cobra.Command, name string) error {
+	c, err := command.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	kit := v1.NewIntegrationKit(command.Namespace, name)
+	if err := c.Get(command.Context, ctrl.ObjectKeyFromObject(kit), kit); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return fmt.Errorf("no integration kit found with name \"%s\"", name)
+		}
+		return err
+	}
+
+	// The Build of a kit is named after it
+	build := v1.NewBuild(kit.Namespace, kit.Name)
+	if err := c.Get(command.Context, ctrl.ObjectKeyFromObject(build), build); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return fmt.Errorf("no build found for integration kit \"%s\"", name)
+		}
+		return err
+	}
+
+	return printBuildLog(command.Context, cmd.OutOrStdout(), c, build)
+}
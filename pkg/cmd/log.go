@@ -20,6 +20,7 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
@@ -47,6 +48,15 @@ func newCmdLog(rootCmdOptions *RootCmdOptions) (*cobra.Command, *logCmdOptions)
 	}
 
 	cmd.Flags().Int64("tail", -1, "The number of lines from the end of the logs to show. Defaults to -1 to show all the lines.")
+	cmd.Flags().String("level", "", "Only show the records with the given level or above, e.g. WARN. Requires the JSON output of the logging trait")
+	cmd.Flags().String("logger", "", "Only show the records of the loggers with the given name prefix. Requires the JSON output of the logging trait")
+	cmd.Flags().String("route-id", "", "Only show the records of the given Camel route. Requires the JSON output of the logging trait")
+	cmd.Flags().String("exchange-id", "", "Only show the records of the given Camel exchange. Requires the JSON output of the logging trait")
+	cmd.Flags().String("breadcrumb-id", "", "Only show the records with the given Camel breadcrumb. Requires the JSON output of the logging trait")
+	cmd.Flags().StringArray("mdc", nil, "Only show the records with the given MDC value, in the form key=value. Requires the JSON output of the logging trait")
+	cmd.Flags().String("since", "", "Only show the records produced after a relative duration like 5m, or an RFC3339 timestamp. Requires the JSON output of the logging trait")
+	cmd.Flags().String("until", "", "Only show the records produced before a relative duration like 5m, or an RFC3339 timestamp. Requires the JSON output of the logging trait")
+	cmd.Flags().StringP("output", "o", "", "Output format of the JSON log records, which are printed along with the name of their Pod. One of: text|json|table. Defaults to text when the JSON output of the logging trait is enabled")
 
 	// completion support
 	configureKnownCompletions(&cmd)
@@ -56,17 +66,101 @@ func newCmdLog(rootCmdOptions *RootCmdOptions) (*cobra.Command, *logCmdOptions)
 
 type logCmdOptions struct {
 	*RootCmdOptions
-	Tail int64 `mapstructure:"tail"`
+	Tail         int64    `mapstructure:"tail"`
+	Level        string   `mapstructure:"level"`
+	Logger       string   `mapstructure:"logger"`
+	RouteID      string   `mapstructure:"route-id"`
+	ExchangeID   string   `mapstructure:"exchange-id"`
+	BreadcrumbID string   `mapstructure:"breadcrumb-id"`
+	MDC          []string `mapstructure:"mdc"`
+	Since        string   `mapstructure:"since"`
+	Until        string   `mapstructure:"until"`
+	OutputFormat string   `mapstructure:"output"`
 }
 
-func (o *logCmdOptions) validate(_ *cobra.Command, args []string) error {
+func (o *logCmdOptions) validate(cmd *cobra.Command, args []string) error {
 	if len(args) != 1 {
 		return errors.New("log expects an integration name argument")
 	}
+	// The options are not decoded yet, as validation happens before the pre run
+	output, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return err
+	}
+	switch output {
+	case "", logOutputText, logOutputJSON, logOutputTable:
+	default:
+		return fmt.Errorf("invalid output format %q, one of text|json|table is expected", output)
+	}
 
 	return nil
 }
 
+// filter returns the filter of the structured log records.
+func (o *logCmdOptions) filter(now time.Time) (*k8slog.Filter, error) {
+	filter := k8slog.Filter{
+		Level:   o.Level,
+		Logger:  o.Logger,
+		RouteID: o.RouteID,
+		MDC:     make(map[string]string),
+	}
+	if o.ExchangeID != "" {
+		filter.MDC[k8slog.MDCExchangeID] = o.ExchangeID
+	}
+	if o.BreadcrumbID != "" {
+		filter.MDC[k8slog.MDCBreadcrumbID] = o.BreadcrumbID
+	}
+	for _, item := range o.MDC {
+		k, v, ok := strings.Cut(item, "=")
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid MDC filter %q, the format key=value is expected", item)
+		}
+		filter.MDC[k] = v
+	}
+	var err error
+	if filter.Since, err = parseLogTime(o.Since, now); err != nil {
+		return nil, err
+	}
+	if filter.Until, err = parseLogTime(o.Until, now); err != nil {
+		return nil, err
+	}
+
+	return &filter, filter.Validate()
+}
+
+// structured returns whether the log records must be parsed.
+func (o *logCmdOptions) structured(filter *k8slog.Filter, it *v1.Integration) (bool, error) {
+	jsonEnabled := it.Spec.Traits.Logging != nil && it.Spec.Traits.Logging.JSON != nil && *it.Spec.Traits.Logging.JSON
+	if !jsonEnabled {
+		if o.OutputFormat != "" || !filter.IsEmpty() {
+			return false, fmt.Errorf("filtering and formatting the logs of integration %s requires the JSON output of the logging trait, "+
+				"use -t logging.json=true", it.Name)
+		}
+		return false, nil
+	}
+	if it.Spec.Traits.Logging.JSONPrettyPrint != nil && *it.Spec.Traits.Logging.JSONPrettyPrint {
+		return false, fmt.Errorf("the logs of integration %s cannot be parsed when the JSON pretty print of the logging trait is enabled", it.Name)
+	}
+
+	return true, nil
+}
+
+// parseLogTime parses either a duration relative to now, or an RFC3339 timestamp.
+func parseLogTime(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil {
+		return now.Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, a duration like 5m or an RFC3339 timestamp is expected", value)
+	}
+
+	return t, nil
+}
+
 func (o *logCmdOptions) run(cmd *cobra.Command, args []string) error {
 	c, err := o.GetCmdClient()
 	if err != nil {
@@ -75,6 +169,11 @@ func (o *logCmdOptions) run(cmd *cobra.Command, args []string) error {
 
 	integrationID := args[0]
 
+	filter, err := o.filter(time.Now())
+	if err != nil {
+		return err
+	}
+
 	integration := v1.Integration{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       v1.IntegrationKind,
@@ -133,11 +232,22 @@ func (o *logCmdOptions) run(cmd *cobra.Command, args []string) error {
 			//
 			// Found the running integration so step over to scraping its pod log
 			//
+			structured, err := o.structured(filter, &integration)
+			if err != nil {
+				return false, err
+			}
 			fmt.Fprintln(cmd.OutOrStdout(), "Integration '"+integrationID+"' is now running. Showing log ...")
 			var tailLines *int64
 			if o.Tail > 0 {
 				tailLines = &o.Tail
 			}
+			if structured {
+				printer := newLogPrinter(cmd.OutOrStdout(), o.OutputFormat, filter)
+				scraper := k8slog.NewSelectorScraper(c, integration.Namespace, integration.Name, v1.IntegrationLabel+"="+integration.Name, tailLines)
+				go printer.printLines(o.Context, scraper.StartLines(o.Context))
+
+				return true, nil
+			}
 			if err := k8slog.Print(o.Context, cmd, c, &integration, tailLines, cmd.OutOrStdout()); err != nil {
 				return false, err
 			}
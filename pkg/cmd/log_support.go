@@ -0,0 +1,159 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	k8slog "github.com/apache/camel-k/v2/pkg/util/kubernetes/log"
+)
+
+const (
+	logOutputText  = "text"
+	logOutputJSON  = "json"
+	logOutputTable = "table"
+
+	logTimestampFormat = "2006-01-02 15:04:05.000"
+	logTableLoggerSize = 30
+)
+
+// logPrinter prints the JSON log records of an Integration, along with the name of their Pod, as the Pods
+// of the Integration may change while their logs are followed.
+type logPrinter struct {
+	out    io.Writer
+	format string
+	filter *k8slog.Filter
+	// whether the table header is printed
+	headerPrinted bool
+}
+
+func newLogPrinter(out io.Writer, format string, filter *k8slog.Filter) *logPrinter {
+	if format == "" {
+		format = logOutputText
+	}
+	return &logPrinter{
+		out:    out,
+		format: format,
+		filter: filter,
+	}
+}
+
+func (p *logPrinter) printLines(ctx context.Context, lines <-chan k8slog.Line) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case line := <-lines:
+			if err := p.print(line); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (p *logPrinter) print(line k8slog.Line) error {
+	record, ok := k8slog.ParseRecord(line.Text)
+	if !ok {
+		// Lines which are not log records, like the startup banner, are only kept when nothing is filtered
+		if p.format != logOutputText || !p.filter.IsEmpty() {
+			return nil
+		}
+		_, err := fmt.Fprintln(p.out, p.prefix(line.Pod)+line.Text)
+		return err
+	}
+	if !p.filter.Matches(record) {
+		return nil
+	}
+
+	switch p.format {
+	case logOutputJSON:
+		return p.printJSON(line.Pod, record)
+	case logOutputTable:
+		return p.printRow(line.Pod, record)
+	default:
+		return p.printText(line.Pod, record)
+	}
+}
+
+func (p *logPrinter) prefix(pod string) string {
+	return "[" + pod + "] "
+}
+
+func (p *logPrinter) printText(pod string, r *k8slog.Record) error {
+	var sb strings.Builder
+	sb.WriteString(p.prefix(pod))
+	sb.WriteString(formatLogTimestamp(r.Timestamp))
+	sb.WriteString(fmt.Sprintf(" %-5s [%s]", r.Level, r.LoggerName))
+	if routeID := r.RouteID(); routeID != "" {
+		sb.WriteString(fmt.Sprintf(" (%s)", routeID))
+	}
+	sb.WriteString(" ")
+	sb.WriteString(r.Message)
+	if r.StackTrace != "" {
+		sb.WriteString("\n")
+		sb.WriteString(strings.TrimRight(r.StackTrace, "\n"))
+	}
+	_, err := fmt.Fprintln(p.out, sb.String())
+	return err
+}
+
+func (p *logPrinter) printJSON(pod string, r *k8slog.Record) error {
+	record := r.Raw
+	record["pod"] = pod
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(p.out, string(data))
+	return err
+}
+
+// printRow prints the record as a table row. As the records are streamed, the columns have a fixed width.
+func (p *logPrinter) printRow(pod string, r *k8slog.Record) error {
+	if !p.headerPrinted {
+		header := fmt.Sprintf("%-20s  %-23s  %-5s  %-*s  %-15s  %s", "POD", "TIMESTAMP", "LEVEL", logTableLoggerSize, "LOGGER", "ROUTE", "MESSAGE")
+		if _, err := fmt.Fprintln(p.out, header); err != nil {
+			return err
+		}
+		p.headerPrinted = true
+	}
+	row := fmt.Sprintf("%-20s  %-23s  %-5s  %-*s  %-15s  %s", pod, formatLogTimestamp(r.Timestamp), r.Level, logTableLoggerSize,
+		abbreviateLogger(r.LoggerName, logTableLoggerSize), r.RouteID(), r.Message)
+	_, err := fmt.Fprintln(p.out, row)
+	return err
+}
+
+func formatLogTimestamp(t time.Time) string {
+	if t.IsZero() {
+		return strings.Repeat("-", len(logTimestampFormat))
+	}
+	return t.Format(logTimestampFormat)
+}
+
+// abbreviateLogger shortens the logger name to the given size, by keeping its last characters.
+func abbreviateLogger(name string, size int) string {
+	if len(name) <= size {
+		return name
+	}
+	return "..." + name[len(name)-size+3:]
+}
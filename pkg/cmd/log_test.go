@@ -18,9 +18,17 @@ limitations under the License.
 package cmd
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	k8slog "github.com/apache/camel-k/v2/pkg/util/kubernetes/log"
 	"github.com/apache/camel-k/v2/pkg/util/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/pointer"
 )
 
 func TestLogsAlias(t *testing.T) {
@@ -37,3 +45,102 @@ func TestLogsAlias(t *testing.T) {
 		t.Fatalf("Expected error result for invalid alias `logs`")
 	}
 }
+
+func TestLogInvalidOutput(t *testing.T) {
+	options, rootCommand := kamelTestPreAddCommandInit()
+	logCommand, _ := newCmdLog(options)
+	rootCommand.AddCommand(logCommand)
+	kamelTestPostAddCommandInit(t, rootCommand, options)
+
+	_, err := test.ExecuteCommand(rootCommand, "log", "my-it", "-o", "yaml")
+	require.Error(t, err)
+	assert.Equal(t, `invalid output format "yaml", one of text|json|table is expected`, err.Error())
+}
+
+func TestLogFilter(t *testing.T) {
+	now := time.Date(2023, 10, 18, 10, 0, 0, 0, time.UTC)
+	options := logCmdOptions{
+		Level:      "warn",
+		ExchangeID: "ABC-1",
+		MDC:        []string{"tenant=acme"},
+		Since:      "5m",
+		Until:      "2023-10-18T10:00:00Z",
+	}
+	filter, err := options.filter(now)
+	require.NoError(t, err)
+	assert.Equal(t, "warn", filter.Level)
+	assert.Equal(t, map[string]string{k8slog.MDCExchangeID: "ABC-1", "tenant": "acme"}, filter.MDC)
+	assert.Equal(t, now.Add(-5*time.Minute), filter.Since)
+	assert.Equal(t, now, filter.Until)
+
+	options = logCmdOptions{MDC: []string{"tenant"}}
+	_, err = options.filter(now)
+	require.Error(t, err)
+
+	options = logCmdOptions{Since: "yesterday"}
+	_, err = options.filter(now)
+	require.Error(t, err)
+}
+
+func TestLogStructuredRequiresJSON(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	options := logCmdOptions{OutputFormat: logOutputTable}
+
+	_, err := options.structured(&k8slog.Filter{}, &it)
+	require.Error(t, err)
+
+	it.Spec.Traits.Logging = &traitv1.LoggingTrait{JSON: pointer.Bool(true)}
+	structured, err := options.structured(&k8slog.Filter{}, &it)
+	require.NoError(t, err)
+	assert.True(t, structured)
+
+	options = logCmdOptions{}
+	it.Spec.Traits.Logging = nil
+	structured, err = options.structured(&k8slog.Filter{}, &it)
+	require.NoError(t, err)
+	assert.False(t, structured)
+}
+
+const (
+	testLogRecord1 = `{"timestamp":"2023-10-18T10:15:30.123Z","loggerName":"route1","level":"INFO","message":"Hello",` +
+		`"mdc":{"camel.exchangeId":"ABC-1","camel.routeId":"route1"}}`
+	testLogRecord2 = `{"timestamp":"2023-10-18T10:15:31.456Z","loggerName":"org.apache.camel.component.log","level":"ERROR",` +
+		`"message":"Failed","stackTrace":"java.lang.IllegalStateException: boom","mdc":{"camel.exchangeId":"ABC-2","camel.routeId":"route2"}}`
+)
+
+func TestLogPrinterText(t *testing.T) {
+	var out bytes.Buffer
+	printer := newLogPrinter(&out, "", &k8slog.Filter{})
+	require.NoError(t, printer.print(k8slog.Line{Pod: "pod-1", Text: "Starting the application"}))
+	require.NoError(t, printer.print(k8slog.Line{Pod: "pod-1", Text: testLogRecord1}))
+	require.NoError(t, printer.print(k8slog.Line{Pod: "pod-2", Text: testLogRecord2}))
+
+	assert.Equal(t, `[pod-1] Starting the application
+[pod-1] 2023-10-18 10:15:30.123 INFO  [route1] (route1) Hello
+[pod-2] 2023-10-18 10:15:31.456 ERROR [org.apache.camel.component.log] (route2) Failed
+java.lang.IllegalStateException: boom
+`, out.String())
+}
+
+func TestLogPrinterFilteredJSON(t *testing.T) {
+	var out bytes.Buffer
+	printer := newLogPrinter(&out, logOutputJSON, &k8slog.Filter{Level: "WARN"})
+	require.NoError(t, printer.print(k8slog.Line{Pod: "pod-1", Text: "Starting the application"}))
+	require.NoError(t, printer.print(k8slog.Line{Pod: "pod-1", Text: testLogRecord1}))
+	require.NoError(t, printer.print(k8slog.Line{Pod: "pod-1", Text: testLogRecord2}))
+
+	assert.Equal(t, `{"level":"ERROR","loggerName":"org.apache.camel.component.log","mdc":{"camel.exchangeId":"ABC-2","camel.routeId":"route2"},`+
+		`"message":"Failed","pod":"pod-1","stackTrace":"java.lang.IllegalStateException: boom","timestamp":"2023-10-18T10:15:31.456Z"}
+`, out.String())
+}
+
+func TestLogPrinterTable(t *testing.T) {
+	var out bytes.Buffer
+	printer := newLogPrinter(&out, logOutputTable, &k8slog.Filter{RouteID: "route2"})
+	require.NoError(t, printer.print(k8slog.Line{Pod: "pod-1", Text: testLogRecord1}))
+	require.NoError(t, printer.print(k8slog.Line{Pod: "pod-1", Text: testLogRecord2}))
+
+	assert.Equal(t, `POD                   TIMESTAMP                LEVEL  LOGGER                          ROUTE            MESSAGE
+pod-1                 2023-10-18 10:15:31.456  ERROR  org.apache.camel.component.log  route2           Failed
+`, out.String())
+}
@@ -61,6 +61,7 @@ import (
 	"github.com/apache/camel-k/v2/pkg/controller/synthetic"
 	"github.com/apache/camel-k/v2/pkg/install"
 	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/util/audit"
 	"github.com/apache/camel-k/v2/pkg/util/defaults"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
 	logutil "github.com/apache/camel-k/v2/pkg/util/log"
@@ -68,6 +69,9 @@ import (
 
 var log = logutil.Log.WithName("cmd")
 
+// auditFlushTimeout is how long the operator waits for the queued audit entries to be delivered when it stops.
+const auditFlushTimeout = 30 * time.Second
+
 func printVersion() {
 	log.Info(fmt.Sprintf("Go Version: %s", runtime.Version()))
 	log.Info(fmt.Sprintf("Go OS/Arch: %s/%s", runtime.GOOS, runtime.GOARCH))
@@ -224,6 +228,13 @@ func Run(healthPort, monitoringPort int32, leaderElection bool, leaderElectionID
 	install.OperatorStartupOptionalTools(installCtx, bootstrapClient, watchNamespace, operatorNamespace, log)
 	exitOnError(findOrCreateIntegrationPlatform(installCtx, bootstrapClient, operatorNamespace), "failed to create integration platform")
 
+	if auditSink, ok := os.LookupEnv(audit.SinkEnvVariable); ok && auditSink != "" {
+		sink, err := audit.NewSink(auditSink)
+		exitOnError(err, "cannot configure the audit sink")
+		audit.SetSink(sink)
+		log.Info("Recording the audit trail of the Integration changes")
+	}
+
 	synthEnvVal, synth := os.LookupEnv("CAMEL_K_SYNTHETIC_INTEGRATIONS")
 	if synth && synthEnvVal == "true" {
 		log.Info("Starting the synthetic Integration manager")
@@ -232,7 +243,14 @@ func Run(healthPort, monitoringPort int32, leaderElection bool, leaderElectionID
 		log.Info("Synthetic Integration manager not configured, skipping")
 	}
 	log.Info("Starting the manager")
-	exitOnError(mgr.Start(ctx), "manager exited non-zero")
+	err = mgr.Start(ctx)
+	// Deliver the audit entries that are still queued before exiting
+	flushCtx, flushCancel := context.WithTimeout(context.Background(), auditFlushTimeout)
+	defer flushCancel()
+	if flushErr := audit.Flush(flushCtx); flushErr != nil {
+		log.Error(flushErr, "cannot deliver the audit entries")
+	}
+	exitOnError(err, "manager exited non-zero")
 }
 
 func getNamespacesSelector(operatorNamespace string, watchNamespace string) map[string]cache.Config {
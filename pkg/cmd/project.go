@@ -0,0 +1,489 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/cmd/source"
+	"github.com/apache/camel-k/v2/pkg/trait"
+	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/dsl"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/resource"
+)
+
+// project is a manifest declaring a set of related Integrations, Pipes and Kamelets, which are managed as a whole.
+// The shared traits, configurations, properties and dependencies are applied to all the Integrations and Pipes
+// of the project. Relative paths are resolved against the directory of the manifest.
+type project struct {
+	Name         string               `yaml:"name"`
+	Traits       []string             `yaml:"traits,omitempty"`
+	Configs      []string             `yaml:"configs,omitempty"`
+	Resources    []string             `yaml:"resources,omitempty"`
+	Properties   []string             `yaml:"properties,omitempty"`
+	Dependencies []string             `yaml:"dependencies,omitempty"`
+	Kamelets     []string             `yaml:"kamelets,omitempty"`
+	Integrations []projectIntegration `yaml:"integrations,omitempty"`
+	Pipes        []string             `yaml:"pipes,omitempty"`
+
+	dir string
+}
+
+// projectIntegration is an Integration of a project. The options have the same format as the
+// corresponding `kamel run` flags.
+type projectIntegration struct {
+	Name         string   `yaml:"name"`
+	Sources      []string `yaml:"sources"`
+	Traits       []string `yaml:"traits,omitempty"`
+	Configs      []string `yaml:"configs,omitempty"`
+	Resources    []string `yaml:"resources,omitempty"`
+	Properties   []string `yaml:"properties,omitempty"`
+	Dependencies []string `yaml:"dependencies,omitempty"`
+}
+
+type projectAction string
+
+const (
+	projectActionCreate    projectAction = "create"
+	projectActionUpdate    projectAction = "update"
+	projectActionUnchanged projectAction = "unchanged"
+	projectActionPrune     projectAction = "prune"
+)
+
+// projectPlanItem is the action required to reconcile a single resource of a project.
+type projectPlanItem struct {
+	Action projectAction
+	Kind   string
+	Name   string
+	object ctrl.Object
+	patch  ctrl.Patch
+}
+
+func (i projectPlanItem) symbol() string {
+	switch i.Action {
+	case projectActionCreate:
+		return "+"
+	case projectActionUpdate:
+		return "~"
+	case projectActionPrune:
+		return "-"
+	default:
+		return "="
+	}
+}
+
+// loadProject reads and validates the project manifest.
+func loadProject(file string) (*project, error) {
+	data, err := util.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	p := project{}
+	if err := yaml.UnmarshalStrict(data, &p); err != nil {
+		return nil, fmt.Errorf("invalid project manifest %s: %w", file, err)
+	}
+	p.dir = filepath.Dir(file)
+
+	if errs := validation.IsDNS1123Label(p.Name); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid project name %q: %v", p.Name, errs)
+	}
+	names := make(map[string]bool)
+	for _, it := range p.Integrations {
+		if errs := validation.IsDNS1123Subdomain(it.Name); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid integration name %q: %v", it.Name, errs)
+		}
+		if names[it.Name] {
+			return nil, fmt.Errorf("integration %s is declared more than once", it.Name)
+		}
+		if len(it.Sources) == 0 {
+			return nil, fmt.Errorf("integration %s has no sources", it.Name)
+		}
+		names[it.Name] = true
+	}
+
+	return &p, nil
+}
+
+// path returns the location relative to the project manifest directory, unless it is absolute or remote.
+func (p *project) path(location string) string {
+	if filepath.IsAbs(location) {
+		return location
+	}
+	if u, err := url.Parse(location); err == nil && u.Scheme != "" {
+		return location
+	}
+	return filepath.Join(p.dir, location)
+}
+
+func (p *project) labels() map[string]string {
+	return map[string]string{
+		kubernetes.CamelProjectLabel: p.Name,
+	}
+}
+
+// resources returns the resources declared by the project, in the order they have to be created.
+func (p *project) resources(ctx context.Context, cmd *cobra.Command, c client.Client, namespace string) ([]ctrl.Object, error) {
+	objects := make([]ctrl.Object, 0, len(p.Kamelets)+len(p.Integrations)+len(p.Pipes))
+
+	for _, file := range p.Kamelets {
+		obj, err := p.load(c, file)
+		if err != nil {
+			return nil, err
+		}
+		kamelet, ok := obj.(*v1.Kamelet)
+		if !ok {
+			return nil, fmt.Errorf("%s does not contain a Kamelet", file)
+		}
+		p.own(kamelet, namespace)
+		objects = append(objects, kamelet)
+	}
+
+	catalog, err := createCamelCatalog()
+	if err != nil {
+		return nil, err
+	}
+	for _, pit := range p.Integrations {
+		it := v1.NewIntegration(namespace, pit.Name)
+		p.own(&it, namespace)
+
+		locations := make([]string, 0, len(pit.Sources))
+		for _, location := range pit.Sources {
+			locations = append(locations, p.path(location))
+		}
+		sources, err := source.Resolve(ctx, locations, false, cmd)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range sources {
+			if s.IsYaml() {
+				flows, err := dsl.FromYamlDSLString(s.Content)
+				if err != nil {
+					return nil, err
+				}
+				it.Spec.AddFlows(flows...)
+			} else {
+				it.Spec.AddSources(v1.SourceSpec{
+					DataSpec: v1.DataSpec{
+						Name:    s.Name,
+						Content: s.Content,
+					},
+				})
+			}
+		}
+
+		traits, err := p.traits(pit)
+		if err != nil {
+			return nil, err
+		}
+		if len(traits) > 0 {
+			if err := configureTraits(traits, &it.Spec.Traits, trait.NewCatalog(c)); err != nil {
+				return nil, fmt.Errorf("invalid traits for integration %s: %w", pit.Name, err)
+			}
+		}
+		for _, item := range append(append([]string{}, p.Dependencies...), pit.Dependencies...) {
+			addDependency(cmd, &it, item, catalog)
+		}
+
+		objects = append(objects, &it)
+	}
+
+	for _, file := range p.Pipes {
+		obj, err := p.load(c, file)
+		if err != nil {
+			return nil, err
+		}
+		pipe, ok := obj.(*v1.Pipe)
+		if !ok {
+			return nil, fmt.Errorf("%s does not contain a Pipe", file)
+		}
+		p.own(pipe, namespace)
+		traits, err := p.traits(projectIntegration{})
+		if err != nil {
+			return nil, err
+		}
+		if len(traits) > 0 || len(p.Dependencies) > 0 {
+			if pipe.Spec.Integration == nil {
+				pipe.Spec.Integration = &v1.IntegrationSpec{}
+			}
+		}
+		if len(traits) > 0 {
+			if err := configureTraits(traits, &pipe.Spec.Integration.Traits, trait.NewCatalog(c)); err != nil {
+				return nil, fmt.Errorf("invalid traits for pipe %s: %w", pipe.Name, err)
+			}
+		}
+		for _, item := range p.Dependencies {
+			normalized := camel.NormalizeDependency(item)
+			camel.ValidateDependency(catalog, normalized, cmd.ErrOrStderr())
+			pipe.Spec.Integration.AddDependency(normalized)
+		}
+		objects = append(objects, pipe)
+	}
+
+	return objects, nil
+}
+
+// traits returns the trait options of the Integration, including the project shared ones, in the
+// format of the `kamel run --trait` flag.
+func (p *project) traits(pit projectIntegration) ([]string, error) {
+	traits := make([]string, 0)
+	traits = append(traits, p.Traits...)
+	traits = append(traits, pit.Traits...)
+
+	for _, item := range append(append([]string{}, p.Configs...), pit.Configs...) {
+		config, err := resource.ParseConfig(item)
+		if err != nil {
+			return nil, err
+		}
+		traits = append(traits, fmt.Sprintf("mount.configs=%s", config.String()))
+	}
+	for _, item := range append(append([]string{}, p.Resources...), pit.Resources...) {
+		config, err := resource.ParseResource(item)
+		if err != nil {
+			return nil, err
+		}
+		traits = append(traits, fmt.Sprintf("mount.resources=%s", config.String()))
+	}
+	for _, item := range append(append([]string{}, p.Properties...), pit.Properties...) {
+		traits = append(traits, fmt.Sprintf("camel.properties=%s", item))
+	}
+
+	return traits, nil
+}
+
+func (p *project) load(c client.Client, file string) (ctrl.Object, error) {
+	data, err := util.ReadFile(p.path(file))
+	if err != nil {
+		return nil, err
+	}
+
+	return kubernetes.LoadResourceFromYaml(c.GetScheme(), string(data))
+}
+
+func (p *project) own(obj ctrl.Object, namespace string) {
+	obj.SetNamespace(namespace)
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	for k, v := range p.labels() {
+		labels[k] = v
+	}
+	obj.SetLabels(labels)
+}
+
+// planProject computes the actions required to reconcile the desired resources of the project. The resources
+// previously applied as part of the project but no longer declared are pruned, if requested.
+func planProject(ctx context.Context, c client.Client, p *project, namespace string, desired []ctrl.Object, prune bool) ([]projectPlanItem, error) {
+	plan := make([]projectPlanItem, 0, len(desired))
+	declared := make(map[string]bool, len(desired))
+
+	for _, obj := range desired {
+		kind := objectKind(obj)
+		declared[kind+"/"+obj.GetName()] = true
+
+		existing, ok := obj.DeepCopyObject().(ctrl.Object)
+		if !ok {
+			return nil, fmt.Errorf("type assertion failed: %v", obj)
+		}
+		err := c.Get(ctx, ctrl.ObjectKeyFromObject(obj), existing)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return nil, err
+		}
+		if k8serrors.IsNotFound(err) {
+			plan = append(plan, projectPlanItem{Action: projectActionCreate, Kind: kind, Name: obj.GetName(), object: obj})
+			continue
+		}
+		// Only the resources already owned by the project are adopted
+		if owner := existing.GetLabels()[kubernetes.CamelProjectLabel]; owner != p.Name {
+			return nil, fmt.Errorf("%s %s already exists and is not part of project %s", kind, obj.GetName(), p.Name)
+		}
+
+		merged, err := mergeProjectResource(existing, obj)
+		if err != nil {
+			return nil, err
+		}
+		patch := ctrl.MergeFrom(existing)
+		data, err := patch.Data(merged)
+		if err != nil {
+			return nil, err
+		}
+		action := projectActionUpdate
+		if string(data) == "{}" {
+			action = projectActionUnchanged
+		}
+		plan = append(plan, projectPlanItem{Action: action, Kind: kind, Name: obj.GetName(), object: merged, patch: patch})
+	}
+
+	if !prune {
+		return plan, nil
+	}
+
+	// Prune in the reverse order of creation
+	selector := ctrl.MatchingLabels(p.labels())
+	pipes := v1.NewPipeList()
+	if err := c.List(ctx, &pipes, ctrl.InNamespace(namespace), selector); err != nil {
+		return nil, err
+	}
+	for i := range pipes.Items {
+		plan = appendPrune(plan, declared, v1.PipeKind, &pipes.Items[i])
+	}
+	integrations := v1.NewIntegrationList()
+	if err := c.List(ctx, &integrations, ctrl.InNamespace(namespace), selector); err != nil {
+		return nil, err
+	}
+	for i := range integrations.Items {
+		// The Integrations created by a Pipe are pruned along with their Pipe
+		if kind, _ := findCreator(&integrations.Items[i]); kind != "" {
+			continue
+		}
+		plan = appendPrune(plan, declared, v1.IntegrationKind, &integrations.Items[i])
+	}
+	kamelets := v1.NewKameletList()
+	if err := c.List(ctx, &kamelets, ctrl.InNamespace(namespace), selector); err != nil {
+		return nil, err
+	}
+	for i := range kamelets.Items {
+		plan = appendPrune(plan, declared, v1.KameletKind, &kamelets.Items[i])
+	}
+
+	return plan, nil
+}
+
+func appendPrune(plan []projectPlanItem, declared map[string]bool, kind string, obj ctrl.Object) []projectPlanItem {
+	if declared[kind+"/"+obj.GetName()] {
+		return plan
+	}
+	return append(plan, projectPlanItem{Action: projectActionPrune, Kind: kind, Name: obj.GetName(), object: obj})
+}
+
+// mergeProjectResource returns a copy of the existing resource, with the specification, labels and
+// annotations of the desired one.
+func mergeProjectResource(existing ctrl.Object, desired ctrl.Object) (ctrl.Object, error) {
+	merged, ok := existing.DeepCopyObject().(ctrl.Object)
+	if !ok {
+		return nil, fmt.Errorf("type assertion failed: %v", existing)
+	}
+	labels := merged.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	for k, v := range desired.GetLabels() {
+		labels[k] = v
+	}
+	merged.SetLabels(labels)
+	annotations := merged.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	for k, v := range desired.GetAnnotations() {
+		annotations[k] = v
+	}
+	merged.SetAnnotations(annotations)
+
+	switch d := desired.(type) {
+	case *v1.Integration:
+		merged.(*v1.Integration).Spec = d.Spec
+	case *v1.Pipe:
+		merged.(*v1.Pipe).Spec = d.Spec
+	case *v1.Kamelet:
+		merged.(*v1.Kamelet).Spec = d.Spec
+	default:
+		return nil, fmt.Errorf("unsupported project resource %s", objectKind(desired))
+	}
+
+	return merged, nil
+}
+
+func objectKind(obj ctrl.Object) string {
+	switch obj.(type) {
+	case *v1.Integration:
+		return v1.IntegrationKind
+	case *v1.Pipe:
+		return v1.PipeKind
+	case *v1.Kamelet:
+		return v1.KameletKind
+	default:
+		return obj.GetObjectKind().GroupVersionKind().Kind
+	}
+}
+
+func printProjectPlan(cmd *cobra.Command, p *project, plan []projectPlanItem) {
+	fmt.Fprintf(cmd.OutOrStdout(), "Plan for project %s:\n", p.Name)
+	if len(plan) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "  nothing to do")
+		return
+	}
+	for _, item := range plan {
+		fmt.Fprintf(cmd.OutOrStdout(), "  %s %s %s (%s)\n", item.symbol(), item.Kind, item.Name, item.Action)
+	}
+}
+
+// executeProjectPlan applies the actions of the plan to the cluster.
+func executeProjectPlan(ctx context.Context, cmd *cobra.Command, c client.Client, plan []projectPlanItem) error {
+	for _, item := range plan {
+		var err error
+		switch item.Action {
+		case projectActionCreate:
+			err = c.Create(ctx, item.object)
+		case projectActionUpdate:
+			err = c.Patch(ctx, item.object, item.patch)
+		case projectActionPrune:
+			err = c.Delete(ctx, item.object)
+			if k8serrors.IsNotFound(err) {
+				err = nil
+			}
+		case projectActionUnchanged:
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("could not %s %s %s: %w", item.Action, item.Kind, item.Name, err)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "%s %q %s\n", item.Kind, item.Name, pastAction(item.Action))
+	}
+
+	return nil
+}
+
+func pastAction(action projectAction) string {
+	switch action {
+	case projectActionCreate:
+		return "created"
+	case projectActionUpdate:
+		return "updated"
+	case projectActionPrune:
+		return "deleted"
+	default:
+		return "unchanged"
+	}
+}
+
+var errMissingProjectFile = errors.New("a project manifest must be provided with the --file flag")
@@ -32,6 +32,7 @@ import (
 	"github.com/apache/camel-k/v2/pkg/util/camel"
 	"github.com/apache/camel-k/v2/pkg/util/kamelets"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/registry"
 	"github.com/apache/camel-k/v2/pkg/util/resource"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
@@ -49,7 +50,7 @@ func newCmdPromote(rootCmdOptions *RootCmdOptions) (*cobra.Command, *promoteCmdO
 		RootCmdOptions: rootCmdOptions,
 	}
 	cmd := cobra.Command{
-		Use:     "promote my-it [--to <namespace>] [-x <promoted-operator-id>]",
+		Use:     "promote my-it [--to <namespace>] [--to-context <context>] [-x <promoted-operator-id>]",
 		Short:   "Promote an Integration/Pipe from an environment to another",
 		Long:    "Promote an Integration/Pipe from an environment to another, for example from a Development environment to a Production environment",
 		PreRunE: decode(&options, options.Flags),
@@ -57,19 +58,27 @@ func newCmdPromote(rootCmdOptions *RootCmdOptions) (*cobra.Command, *promoteCmdO
 	}
 
 	cmd.Flags().String("to", "", "The namespace where to promote the Integration/Pipe")
+	cmd.Flags().String("to-context", "", "The kube config context of the cluster where to promote the Integration/Pipe")
+	cmd.Flags().String("to-kubeconfig", "", "The kube config file of the cluster where to promote the Integration/Pipe")
+	cmd.Flags().Bool("to-registry-insecure", false, "Configure to use an insecure connection when copying the container image to the destination registry")
 	cmd.Flags().StringP("to-operator", "x", "", "The operator id which will reconcile the promoted Integration/Pipe")
 	cmd.Flags().StringP("output", "o", "", "Output format. One of: json|yaml")
 	cmd.Flags().BoolP("image", "i", false, "Output the container image only")
+	cmd.Flags().String("export-gitops-dir", "", "Export to a Kustomize GitOps directory (a base and an overlay for the destination namespace) instead of promoting to the cluster")
 
 	return &cmd, &options
 }
 
 type promoteCmdOptions struct {
 	*RootCmdOptions
-	To           string `mapstructure:"to" yaml:",omitempty"`
-	ToOperator   string `mapstructure:"to-operator" yaml:",omitempty"`
-	OutputFormat string `mapstructure:"output" yaml:",omitempty"`
-	Image        bool   `mapstructure:"image" yaml:",omitempty"`
+	To                 string `mapstructure:"to" yaml:",omitempty"`
+	ToContext          string `mapstructure:"to-context" yaml:",omitempty"`
+	ToKubeConfig       string `mapstructure:"to-kubeconfig" yaml:",omitempty"`
+	ToRegistryInsecure bool   `mapstructure:"to-registry-insecure" yaml:",omitempty"`
+	ToOperator         string `mapstructure:"to-operator" yaml:",omitempty"`
+	OutputFormat       string `mapstructure:"output" yaml:",omitempty"`
+	Image              bool   `mapstructure:"image" yaml:",omitempty"`
+	ExportGitOpsDir    string `mapstructure:"export-gitops-dir" yaml:",omitempty"`
 }
 
 func (o *promoteCmdOptions) validate(_ *cobra.Command, args []string) error {
@@ -79,10 +88,13 @@ func (o *promoteCmdOptions) validate(_ *cobra.Command, args []string) error {
 	if o.To == "" {
 		return errors.New("promote requires a destination namespace as --to argument")
 	}
-	if o.To == o.Namespace {
+	if o.To == o.Namespace && !o.isMultiCluster() {
 		return errors.New("source and destination namespaces must be different in order to avoid promoted Integration/Pipe clashes with the source Integration/Pipe")
 	}
-	return nil
+	if o.ExportGitOpsDir != "" && (o.OutputFormat != "" || o.Image) {
+		return errors.New("cannot use --export-gitops-dir together with --output or --image")
+	}
+	return validateGitOpsDir(o.ExportGitOpsDir)
 }
 
 func (o *promoteCmdOptions) run(cmd *cobra.Command, args []string) error {
@@ -95,18 +107,24 @@ func (o *promoteCmdOptions) run(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("could not retrieve cluster client: %w", err)
 	}
+	dc := c
+	var opDest map[string]string
 	if !o.isDryRun() {
 		// Skip these checks if in dry mode
+		dc, err = o.getDestinationClient(c)
+		if err != nil {
+			return fmt.Errorf("could not retrieve destination cluster client: %w", err)
+		}
 		opSource, err := operatorInfo(o.Context, c, o.Namespace)
 		if err != nil {
 			return fmt.Errorf("could not retrieve info for Camel K operator source: %w", err)
 		}
-		opDest, err := operatorInfo(o.Context, c, o.To)
+		opDest, err = operatorInfo(o.Context, dc, o.To)
 		if err != nil {
 			return fmt.Errorf("could not retrieve info for Camel K operator destination: %w", err)
 		}
 
-		err = checkOpsCompatibility(cmd, opSource, opDest)
+		err = checkOpsCompatibility(cmd, opSource, opDest, !o.isMultiCluster())
 		if err != nil {
 			return fmt.Errorf("could not verify operators compatibility: %w", err)
 		}
@@ -141,7 +159,7 @@ func (o *promoteCmdOptions) run(cmd *cobra.Command, args []string) error {
 
 	if !o.isDryRun() {
 		// Skip these checks if in dry mode
-		err = o.validateDestResources(c, sourceIntegration)
+		err = o.validateDestResources(c, dc, sourceIntegration)
 		if err != nil {
 			return fmt.Errorf("could not validate destination resources: %w", err)
 		}
@@ -150,6 +168,13 @@ func (o *promoteCmdOptions) run(cmd *cobra.Command, args []string) error {
 	// Pipe promotion
 	if promotePipe {
 		destPipe, destKit := o.editPipe(sourcePipe, sourceIntegration, sourceKit)
+		if o.ExportGitOpsDir != "" {
+			if err := o.exportGitOpsPipe(destPipe, destKit.Spec.Image); err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), `Exported Pipe "`+name+`" to "`+o.ExportGitOpsDir+`"`)
+			return nil
+		}
 		if o.OutputFormat != "" {
 			if err := showIntegrationKitOutput(cmd, destKit, o.OutputFormat); err != nil {
 				return err
@@ -157,16 +182,14 @@ func (o *promoteCmdOptions) run(cmd *cobra.Command, args []string) error {
 			fmt.Fprintln(cmd.OutOrStdout(), `---`)
 			return showPipeOutput(cmd, destPipe, o.OutputFormat, c.GetScheme())
 		}
-		// Ensure the destination namespace has access to the source namespace images
-		err = addSystemPullerRoleBinding(o.Context, c, sourceIntegration.Namespace, destPipe.Namespace)
-		if err != nil {
+		if err := o.provideImage(cmd, c, sourceIntegration, destKit, opDest); err != nil {
 			return err
 		}
-		_, err = o.replaceResource(destKit)
+		_, err = o.replaceResource(dc, destKit)
 		if err != nil {
 			return err
 		}
-		replaced, err := o.replaceResource(destPipe)
+		replaced, err := o.replaceResource(dc, destPipe)
 		if err != nil {
 			return err
 		}
@@ -180,6 +203,13 @@ func (o *promoteCmdOptions) run(cmd *cobra.Command, args []string) error {
 
 	// Plain Integration promotion
 	destIntegration, destKit := o.editIntegration(sourceIntegration, sourceKit)
+	if o.ExportGitOpsDir != "" {
+		if err := o.exportGitOpsIntegration(destIntegration, destKit.Spec.Image); err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), `Exported Integration "`+name+`" to "`+o.ExportGitOpsDir+`"`)
+		return nil
+	}
 	if o.OutputFormat != "" {
 		if err := showIntegrationKitOutput(cmd, destKit, o.OutputFormat); err != nil {
 			return err
@@ -187,16 +217,14 @@ func (o *promoteCmdOptions) run(cmd *cobra.Command, args []string) error {
 		fmt.Fprintln(cmd.OutOrStdout(), `---`)
 		return showIntegrationOutput(cmd, destIntegration, o.OutputFormat)
 	}
-	// Ensure the destination namespace has access to the source namespace images
-	err = addSystemPullerRoleBinding(o.Context, c, sourceIntegration.Namespace, destIntegration.Namespace)
-	if err != nil {
+	if err := o.provideImage(cmd, c, sourceIntegration, destKit, opDest); err != nil {
 		return err
 	}
-	_, err = o.replaceResource(destKit)
+	_, err = o.replaceResource(dc, destKit)
 	if err != nil {
 		return err
 	}
-	replaced, err := o.replaceResource(destIntegration)
+	replaced, err := o.replaceResource(dc, destIntegration)
 	if err != nil {
 		return err
 	}
@@ -208,15 +236,18 @@ func (o *promoteCmdOptions) run(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func checkOpsCompatibility(cmd *cobra.Command, source, dest map[string]string) error {
+// checkOpsCompatibility verifies the source and destination operators can share the same Integration.
+// The container registry check is only relevant when the operators are expected to share the registry, that is
+// when promoting within the same cluster, as the image is otherwise copied to the destination registry.
+func checkOpsCompatibility(cmd *cobra.Command, source, dest map[string]string, sameRegistry bool) error {
 	if !compatibleVersions(source["Version"], dest["Version"], cmd) {
 		return fmt.Errorf("source (%s) and destination (%s) Camel K operator versions are not compatible", source["Version"], dest["Version"])
 	}
-	if !compatibleVersions(source["Runtime Version"], dest["Runtime Version"], cmd) {
-		return fmt.Errorf("source (%s) and destination (%s) Camel K runtime versions are not compatible", source["Runtime Version"], dest["Runtime Version"])
+	if !compatibleVersions(source[infoRuntimeVersion], dest[infoRuntimeVersion], cmd) {
+		return fmt.Errorf("source (%s) and destination (%s) Camel K runtime versions are not compatible", source[infoRuntimeVersion], dest[infoRuntimeVersion])
 	}
-	if source["Registry Address"] != dest["Registry Address"] {
-		return fmt.Errorf("source (%s) and destination (%s) Camel K container images registries are not the same", source["Registry Address"], dest["Registry Address"])
+	if sameRegistry && source[infoRegistryAddress] != dest[infoRegistryAddress] {
+		return fmt.Errorf("source (%s) and destination (%s) Camel K container images registries are not the same", source[infoRegistryAddress], dest[infoRegistryAddress])
 	}
 
 	return nil
@@ -261,28 +292,105 @@ func (o *promoteCmdOptions) getIntegrationKit(c client.Client, name string) (*v1
 	return ik, nil
 }
 
-func (o *promoteCmdOptions) validateDestResources(c client.Client, it *v1.Integration) error {
+// integrationResources are the resources referenced by an Integration, which must be available in the
+// namespace where the Integration runs.
+type integrationResources struct {
+	ConfigMaps []string
+	Secrets    []string
+	PVCs       []string
+	Kamelets   []string
+}
+
+// validateDestResources verifies the resources required by the Integration (looked up with the source client)
+// are available in the destination namespace (looked up with the destination client).
+func (o *promoteCmdOptions) validateDestResources(c client.Client, dc client.Client, it *v1.Integration) error {
+	resources, err := listIntegrationResources(o.Context, c, it)
+	if err != nil {
+		return err
+	}
+
+	anyError := false
+	var errorTrace string
+	for _, name := range resources.ConfigMaps {
+		if !existsCm(o.Context, dc, name, o.To) {
+			anyError = true
+			errorTrace += fmt.Sprintf("\n\tConfigmap %s is missing from %s namespace", name, o.To)
+		}
+	}
+	for _, name := range resources.Secrets {
+		if !existsSecret(o.Context, dc, name, o.To) {
+			anyError = true
+			errorTrace += fmt.Sprintf("\n\tSecret %s is missing from %s namespace", name, o.To)
+		}
+	}
+	for _, name := range resources.PVCs {
+		if !existsPvc(o.Context, dc, name, o.To) {
+			anyError = true
+			errorTrace += fmt.Sprintf("\n\tPersistentVolumeClaim %s is missing from %s namespace", name, o.To)
+		}
+	}
+	for _, name := range resources.Kamelets {
+		if !existsKamelet(o.Context, dc, name, o.To) {
+			anyError = true
+			errorTrace += fmt.Sprintf("\n\tKamelet %s is missing from %s namespace", name, o.To)
+		}
+	}
+
+	if anyError {
+		return fmt.Errorf(errorTrace)
+	}
+
+	return nil
+}
+
+// listIntegrationResources returns the Configmaps, Secrets, PersistentVolumeClaims and Kamelets referenced by
+// the Integration traits and sources.
+func listIntegrationResources(ctx context.Context, c client.Client, it *v1.Integration) (*integrationResources, error) {
+	resources, err := listConfigurationResources(it)
+	if err != nil {
+		return nil, err
+	}
+
+	// Kamelets trait
+	kamelet, err := toPropertyMap(it.Spec.Traits.Kamelets)
+	if err != nil {
+		return nil, err
+	}
+	if list, ok := kamelet["list"].(string); ok {
+		resources.Kamelets = strings.Split(list, ",")
+	}
+	sourceKamelets, err := listKamelets(ctx, c, it)
+	if err != nil {
+		return nil, err
+	}
+	resources.Kamelets = append(resources.Kamelets, sourceKamelets...)
+
+	return resources, nil
+}
+
+// listConfigurationResources returns the Configmaps, Secrets and PersistentVolumeClaims referenced by
+// the Integration traits.
+func listConfigurationResources(it *v1.Integration) (*integrationResources, error) {
 	var configmaps []string
 	var secrets []string
 	var pvcs []string
-	var kamelets []string
 
 	// Mount trait
 	mount, err := toPropertyMap(it.Spec.Traits.Mount)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	for t, v := range mount {
 		switch t {
 		case "configs":
 			list, ok := v.([]interface{})
 			if !ok {
-				return fmt.Errorf("invalid %s type: %s, value: %s", t, reflect.TypeOf(v), v)
+				return nil, fmt.Errorf("invalid %s type: %s, value: %s", t, reflect.TypeOf(v), v)
 			}
 			for _, cn := range list {
 				s, ok := cn.(string)
 				if !ok {
-					return fmt.Errorf("invalid %s type: %s, value: %s", t, reflect.TypeOf(cn), cn)
+					return nil, fmt.Errorf("invalid %s type: %s, value: %s", t, reflect.TypeOf(cn), cn)
 				}
 				if conf, parseErr := resource.ParseConfig(s); parseErr == nil {
 					if conf.StorageType() == resource.StorageTypeConfigmap {
@@ -291,18 +399,18 @@ func (o *promoteCmdOptions) validateDestResources(c client.Client, it *v1.Integr
 						secrets = append(secrets, conf.Name())
 					}
 				} else {
-					return parseErr
+					return nil, parseErr
 				}
 			}
 		case "resources":
 			list, ok := v.([]interface{})
 			if !ok {
-				return fmt.Errorf("invalid %s type: %s, value: %s", t, reflect.TypeOf(v), v)
+				return nil, fmt.Errorf("invalid %s type: %s, value: %s", t, reflect.TypeOf(v), v)
 			}
 			for _, cn := range list {
 				s, ok := cn.(string)
 				if !ok {
-					return fmt.Errorf("invalid %s type: %s, value: %s", t, reflect.TypeOf(cn), cn)
+					return nil, fmt.Errorf("invalid %s type: %s, value: %s", t, reflect.TypeOf(cn), cn)
 				}
 				if conf, parseErr := resource.ParseResource(s); parseErr == nil {
 					if conf.StorageType() == resource.StorageTypeConfigmap {
@@ -311,25 +419,25 @@ func (o *promoteCmdOptions) validateDestResources(c client.Client, it *v1.Integr
 						secrets = append(secrets, conf.Name())
 					}
 				} else {
-					return parseErr
+					return nil, parseErr
 				}
 			}
 		case "volumes":
 			list, ok := v.([]interface{})
 			if !ok {
-				return fmt.Errorf("invalid %s type: %s, value: %s", t, reflect.TypeOf(v), v)
+				return nil, fmt.Errorf("invalid %s type: %s, value: %s", t, reflect.TypeOf(v), v)
 			}
 			for _, cn := range list {
 				s, ok := cn.(string)
 				if !ok {
-					return fmt.Errorf("invalid %s type: %s, value: %s", t, reflect.TypeOf(cn), cn)
+					return nil, fmt.Errorf("invalid %s type: %s, value: %s", t, reflect.TypeOf(cn), cn)
 				}
 				if conf, parseErr := resource.ParseVolume(s); parseErr == nil {
 					if conf.StorageType() == resource.StorageTypePVC {
 						pvcs = append(pvcs, conf.Name())
 					}
 				} else {
-					return parseErr
+					return nil, parseErr
 				}
 			}
 		}
@@ -338,7 +446,7 @@ func (o *promoteCmdOptions) validateDestResources(c client.Client, it *v1.Integr
 	// OpenAPI trait
 	openapi, err := toPropertyMap(it.Spec.Traits.OpenAPI)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	for k, v := range openapi {
 		if k != "configmaps" {
@@ -350,52 +458,11 @@ func (o *promoteCmdOptions) validateDestResources(c client.Client, it *v1.Integr
 		}
 	}
 
-	// Kamelets trait
-	kamelet, err := toPropertyMap(it.Spec.Traits.Kamelets)
-	if err != nil {
-		return err
-	}
-	if list, ok := kamelet["list"].(string); ok {
-		kamelets = strings.Split(list, ",")
-	}
-	sourceKamelets, err := o.listKamelets(c, it)
-	if err != nil {
-		return err
-	}
-	kamelets = append(kamelets, sourceKamelets...)
-
-	anyError := false
-	var errorTrace string
-	for _, name := range configmaps {
-		if !existsCm(o.Context, c, name, o.To) {
-			anyError = true
-			errorTrace += fmt.Sprintf("\n\tConfigmap %s is missing from %s namespace", name, o.To)
-		}
-	}
-	for _, name := range secrets {
-		if !existsSecret(o.Context, c, name, o.To) {
-			anyError = true
-			errorTrace += fmt.Sprintf("\n\tSecret %s is missing from %s namespace", name, o.To)
-		}
-	}
-	for _, name := range pvcs {
-		if !existsPv(o.Context, c, name, o.To) {
-			anyError = true
-			errorTrace += fmt.Sprintf("\n\tPersistentVolume %s is missing from %s namespace", name, o.To)
-		}
-	}
-	for _, name := range kamelets {
-		if !existsKamelet(o.Context, c, name, o.To) {
-			anyError = true
-			errorTrace += fmt.Sprintf("\n\tKamelet %s is missing from %s namespace", name, o.To)
-		}
-	}
-
-	if anyError {
-		return fmt.Errorf(errorTrace)
-	}
-
-	return nil
+	return &integrationResources{
+		ConfigMaps: configmaps,
+		Secrets:    secrets,
+		PVCs:       pvcs,
+	}, nil
 }
 
 func toPropertyMap(src interface{}) (map[string]interface{}, error) {
@@ -411,16 +478,16 @@ func toPropertyMap(src interface{}) (map[string]interface{}, error) {
 	return propMap, nil
 }
 
-func (o *promoteCmdOptions) listKamelets(c client.Client, it *v1.Integration) ([]string, error) {
+func listKamelets(ctx context.Context, c client.Client, it *v1.Integration) ([]string, error) {
 	runtime := v1.RuntimeSpec{
 		Version:  it.Status.RuntimeVersion,
 		Provider: v1.RuntimeProviderQuarkus,
 	}
-	catalog, err := camel.LoadCatalog(o.Context, c, o.Namespace, runtime)
+	catalog, err := camel.LoadCatalog(ctx, c, it.Namespace, runtime)
 	if err != nil {
 		return nil, err
 	}
-	kamelets, err := kamelets.ExtractKameletFromSources(o.Context, c, catalog, &kubernetes.Collection{}, it)
+	kamelets, err := kamelets.ExtractKameletFromSources(ctx, c, catalog, &kubernetes.Collection{}, it)
 	if err != nil {
 		return nil, err
 	}
@@ -470,8 +537,8 @@ func existsSecret(ctx context.Context, c client.Client, name string, namespace s
 	return true
 }
 
-func existsPv(ctx context.Context, c client.Client, name string, namespace string) bool {
-	var obj corev1.PersistentVolume
+func existsPvc(ctx context.Context, c client.Client, name string, namespace string) bool {
+	var obj corev1.PersistentVolumeClaim
 	key := k8sclient.ObjectKey{
 		Name:      name,
 		Namespace: namespace,
@@ -642,12 +709,61 @@ func (o *promoteCmdOptions) editPipe(kb *v1.Pipe, it *v1.Integration, kit *v1.In
 	return &dst, dstKit
 }
 
-func (o *promoteCmdOptions) replaceResource(res k8sclient.Object) (bool, error) {
-	return kubernetes.ReplaceResource(o.Context, o._client, res)
+func (o *promoteCmdOptions) replaceResource(c client.Client, res k8sclient.Object) (bool, error) {
+	return kubernetes.ReplaceResource(o.Context, c, res)
 }
 
 func (o *promoteCmdOptions) isDryRun() bool {
-	return o.OutputFormat != "" || o.Image
+	return o.OutputFormat != "" || o.Image || o.ExportGitOpsDir != ""
+}
+
+// isMultiCluster returns true when the destination namespace belongs to a different cluster.
+func (o *promoteCmdOptions) isMultiCluster() bool {
+	return o.ToContext != "" || o.ToKubeConfig != ""
+}
+
+func (o *promoteCmdOptions) getDestinationClient(c client.Client) (client.Client, error) {
+	return destinationClient(c, o.KubeConfig, o.ToKubeConfig, o.ToContext)
+}
+
+// destinationClient returns the client to use for the destination cluster, which is the source client
+// unless a different kube config or context is provided.
+func destinationClient(c client.Client, kubeConfig string, toKubeConfig string, toContext string) (client.Client, error) {
+	if toKubeConfig == "" && toContext == "" {
+		return c, nil
+	}
+	if toKubeConfig == "" {
+		toKubeConfig = kubeConfig
+	}
+
+	return client.NewOutOfClusterClientForContext(toKubeConfig, toContext)
+}
+
+// provideImage makes sure the destination namespace can pull the container image of the promoted IntegrationKit.
+// Within the same cluster, the destination namespace is granted access to the source namespace images. Across
+// clusters, the image is copied (preserving its digest) into the destination operator registry.
+func (o *promoteCmdOptions) provideImage(cmd *cobra.Command, c client.Client, it *v1.Integration, kit *v1.IntegrationKit, opDest map[string]string) error {
+	if !o.isMultiCluster() {
+		// Ensure the destination namespace has access to the source namespace images
+		return addSystemPullerRoleBinding(o.Context, c, it.Namespace, o.To)
+	}
+	registryAddress := opDest[infoRegistryAddress]
+	if registryAddress == "" {
+		// The destination cluster is expected to reach the source registry
+		return nil
+	}
+	target, err := registry.TargetImage(it.Status.Image, registryAddress, o.To)
+	if err != nil {
+		return err
+	}
+	image, err := registry.CopyImage(o.Context, it.Status.Image, target, o.ToRegistryInsecure)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), `Container image "`+it.Status.Image+`" copied to "`+image+`"`)
+	kit.Spec.Image = image
+
+	return nil
 }
 
 // RoleBinding is required to allow access to images in one namespace
@@ -0,0 +1,271 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/imdario/mergo"
+	"gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+const (
+	kustomizationFile     = "kustomization.yaml"
+	gitOpsBaseDir         = "base"
+	gitOpsOverlaysDir     = "overlays"
+	gitOpsIntegrationFile = "integration.yaml"
+	gitOpsPipeFile        = "pipe.yaml"
+	gitOpsPatchPrefix     = "patch-"
+)
+
+// kustomization is the subset of the Kustomize configuration used by the GitOps export.
+type kustomization struct {
+	APIVersion string               `yaml:"apiVersion"`
+	Kind       string               `yaml:"kind"`
+	Namespace  string               `yaml:"namespace,omitempty"`
+	Resources  []string             `yaml:"resources,omitempty"`
+	Patches    []kustomizationPatch `yaml:"patches,omitempty"`
+}
+
+type kustomizationPatch struct {
+	Path string `yaml:"path"`
+}
+
+func newKustomization() kustomization {
+	return kustomization{
+		APIVersion: "kustomize.config.k8s.io/v1beta1",
+		Kind:       "Kustomization",
+	}
+}
+
+// exportGitOpsIntegration writes a Kustomize base holding the Integration with its pinned container image,
+// and an overlay for the destination namespace holding the environment specific traits.
+// The base is regenerated on each promotion, whereas the promoted traits are merged into the overlay, so that
+// the values added to the overlay for the environment are preserved.
+func (o *promoteCmdOptions) exportGitOpsIntegration(it *v1.Integration, image string) error {
+	base := v1.NewIntegration("", it.Name)
+	base.Labels = it.Labels
+	base.Annotations = it.Annotations
+	base.Spec = *it.Spec.DeepCopy()
+	base.Spec.IntegrationKit = nil
+	base.Spec.Traits = pinnedTraits(it.Spec.Traits, image)
+
+	patch := v1.NewIntegration("", it.Name)
+	patch.Spec.Traits = overlayTraits(it.Spec.Traits)
+	existing, err := o.loadGitOpsPatch(it.Name, gitOpsIntegrationFile)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		existingIt, ok := existing.(*v1.Integration)
+		if !ok {
+			return fmt.Errorf("unexpected resource %s in overlay patch", existing.GetObjectKind().GroupVersionKind().Kind)
+		}
+		if err := mergo.Merge(&existingIt.Spec.Traits, patch.Spec.Traits, mergo.WithOverride); err != nil {
+			return err
+		}
+		patch.Spec.Traits = existingIt.Spec.Traits
+	}
+
+	return o.writeGitOps(it.Name, gitOpsIntegrationFile, &base, &patch)
+}
+
+// exportGitOpsPipe writes a Kustomize base holding the Pipe with its pinned container image,
+// and an overlay for the destination namespace holding the environment specific traits.
+func (o *promoteCmdOptions) exportGitOpsPipe(pipe *v1.Pipe, image string) error {
+	base := v1.NewPipe("", pipe.Name)
+	base.Labels = pipe.Labels
+	base.Annotations = pipe.Annotations
+	base.Spec = *pipe.Spec.DeepCopy()
+	if base.Spec.Integration == nil {
+		base.Spec.Integration = &v1.IntegrationSpec{}
+	}
+	base.Spec.Integration.IntegrationKit = nil
+	traits := base.Spec.Integration.Traits
+	base.Spec.Integration.Traits = pinnedTraits(traits, image)
+	// The references are resolved in the namespace set by the overlay
+	for _, endpoint := range append([]v1.Endpoint{base.Spec.Source, base.Spec.Sink}, base.Spec.Steps...) {
+		if endpoint.Ref != nil {
+			endpoint.Ref.Namespace = ""
+		}
+	}
+
+	patch := v1.NewPipe("", pipe.Name)
+	patch.Spec.Integration = &v1.IntegrationSpec{
+		Traits: overlayTraits(traits),
+	}
+	existing, err := o.loadGitOpsPatch(pipe.Name, gitOpsPipeFile)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		existingPipe, ok := existing.(*v1.Pipe)
+		if !ok {
+			return fmt.Errorf("unexpected resource %s in overlay patch", existing.GetObjectKind().GroupVersionKind().Kind)
+		}
+		if existingPipe.Spec.Integration == nil {
+			existingPipe.Spec.Integration = &v1.IntegrationSpec{}
+		}
+		if err := mergo.Merge(&existingPipe.Spec.Integration.Traits, patch.Spec.Integration.Traits, mergo.WithOverride); err != nil {
+			return err
+		}
+		patch.Spec.Integration.Traits = existingPipe.Spec.Integration.Traits
+	}
+
+	return o.writeGitOps(pipe.Name, gitOpsPipeFile, &base, &patch)
+}
+
+// pinnedTraits returns the traits required to run the given container image: the container image itself
+// and the classpath of the dependencies it contains.
+func pinnedTraits(traits v1.Traits, image string) v1.Traits {
+	pinned := v1.Traits{
+		Container: &traitv1.ContainerTrait{
+			Image: image,
+		},
+	}
+	if traits.JVM != nil && traits.JVM.Classpath != "" {
+		pinned.JVM = &traitv1.JVMTrait{
+			Classpath: traits.JVM.Classpath,
+		}
+	}
+
+	return pinned
+}
+
+// overlayTraits returns the traits that can be changed by each environment, that is all the traits
+// but the ones pinning the container image.
+func overlayTraits(traits v1.Traits) v1.Traits {
+	overlay := *traits.DeepCopy()
+	if overlay.Container != nil {
+		overlay.Container.Image = ""
+		if reflect.DeepEqual(*overlay.Container, traitv1.ContainerTrait{}) {
+			overlay.Container = nil
+		}
+	}
+	if overlay.JVM != nil {
+		overlay.JVM.Classpath = ""
+		if reflect.DeepEqual(*overlay.JVM, traitv1.JVMTrait{}) {
+			overlay.JVM = nil
+		}
+	}
+
+	return overlay
+}
+
+func (o *promoteCmdOptions) gitOpsOverlayDir(name string) string {
+	return filepath.Join(o.ExportGitOpsDir, name, gitOpsOverlaysDir, o.To)
+}
+
+func (o *promoteCmdOptions) loadGitOpsPatch(name string, file string) (runtime.Object, error) {
+	patchFile := filepath.Join(o.gitOpsOverlayDir(name), gitOpsPatchPrefix+file)
+	exists, err := util.FileExists(patchFile)
+	if err != nil || !exists {
+		return nil, err
+	}
+	data, err := util.ReadFile(patchFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return kubernetes.LoadResourceFromYaml(o._client.GetScheme(), string(data))
+}
+
+func (o *promoteCmdOptions) writeGitOps(name string, file string, base runtime.Object, patch runtime.Object) error {
+	baseDir := filepath.Join(o.ExportGitOpsDir, name, gitOpsBaseDir)
+	if err := writeGitOpsResource(filepath.Join(baseDir, file), base); err != nil {
+		return err
+	}
+	baseKustomization := newKustomization()
+	baseKustomization.Resources = []string{file}
+	if err := writeKustomization(baseDir, baseKustomization); err != nil {
+		return err
+	}
+
+	overlayDir := o.gitOpsOverlayDir(name)
+	if err := writeGitOpsResource(filepath.Join(overlayDir, gitOpsPatchPrefix+file), patch); err != nil {
+		return err
+	}
+	overlayKustomization := newKustomization()
+	overlayKustomization.Namespace = o.To
+	overlayKustomization.Resources = []string{filepath.ToSlash(filepath.Join("..", "..", gitOpsBaseDir))}
+	overlayKustomization.Patches = []kustomizationPatch{{Path: gitOpsPatchPrefix + file}}
+
+	return writeKustomization(overlayDir, overlayKustomization)
+}
+
+// writeGitOpsResource writes the resource without any field set by the cluster, so that the same resource
+// always generates the same content.
+func writeGitOpsResource(file string, obj runtime.Object) error {
+	data, err := kubernetes.ToJSON(obj)
+	if err != nil {
+		return err
+	}
+	m, err := util.JSONToMap(data)
+	if err != nil {
+		return err
+	}
+	delete(m, "status")
+	if metadata, ok := m["metadata"].(map[string]interface{}); ok {
+		delete(metadata, "creationTimestamp")
+		delete(metadata, "managedFields")
+	}
+	content, err := util.MapToYAML(m)
+	if err != nil {
+		return err
+	}
+
+	return util.WriteFileWithContent(file, content)
+}
+
+func writeKustomization(dir string, k kustomization) error {
+	content, err := yaml.Marshal(k)
+	if err != nil {
+		return err
+	}
+
+	return util.WriteFileWithContent(filepath.Join(dir, kustomizationFile), content)
+}
+
+// validateGitOpsDir verifies the export directory, if existing, is a directory.
+func validateGitOpsDir(dir string) error {
+	if strings.TrimSpace(dir) == "" {
+		return nil
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", dir)
+	}
+
+	return nil
+}
@@ -19,9 +19,12 @@ package cmd
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
 	"github.com/apache/camel-k/v2/pkg/platform"
 	"github.com/apache/camel-k/v2/pkg/util/defaults"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
@@ -544,3 +547,262 @@ spec:
 status: {}
 `, output)
 }
+
+func TestIntegrationSameNamespace(t *testing.T) {
+	defaultIntegration, defaultKit := nominalIntegration("my-it-test")
+
+	_, promoteCmd, _ := initializePromoteCmdOptions(t, &defaultIntegration, &defaultKit)
+	_, err := test.ExecuteCommand(promoteCmd, cmdPromote, "my-it-test", "--to", "default", "-n", "default")
+	require.Error(t, err)
+	assert.Equal(t,
+		"source and destination namespaces must be different in order to avoid promoted Integration/Pipe clashes with the source Integration/Pipe",
+		err.Error(),
+	)
+}
+
+func TestIntegrationSameNamespaceToContextDryRun(t *testing.T) {
+	srcPlatform := v1.NewIntegrationPlatform("default", platform.DefaultPlatformName)
+	srcPlatform.Status.Version = defaults.Version
+	srcPlatform.Status.Build.RuntimeVersion = defaults.DefaultRuntimeVersion
+	srcPlatform.Status.Phase = v1.IntegrationPlatformPhaseReady
+	defaultIntegration, defaultKit := nominalIntegration("my-it-test")
+	srcCatalog := createTestCamelCatalog(srcPlatform)
+
+	promoteCmdOptions, promoteCmd, _ := initializePromoteCmdOptions(t, &srcPlatform, &defaultIntegration, &defaultKit, &srcCatalog)
+	output, err := test.ExecuteCommand(promoteCmd, cmdPromote, "my-it-test", "--to", "default", "--to-context", "production", "-i", "-n", "default")
+	require.NoError(t, err)
+	assert.Equal(t, "production", promoteCmdOptions.ToContext)
+	assert.True(t, promoteCmdOptions.isMultiCluster())
+	assert.Equal(t, "my-special-image\n", output)
+}
+
+func TestOpsCompatibilityRuntimeVersion(t *testing.T) {
+	source := map[string]string{infoVersion: defaults.Version, infoRuntimeVersion: "3.2.3"}
+	dest := map[string]string{infoVersion: defaults.Version, infoRuntimeVersion: "2.16.0"}
+
+	err := checkOpsCompatibility(&cobra.Command{}, source, dest, true)
+	require.Error(t, err)
+	assert.Equal(t, "source (3.2.3) and destination (2.16.0) Camel K runtime versions are not compatible", err.Error())
+	require.NoError(t, checkOpsCompatibility(&cobra.Command{}, source, source, true))
+}
+
+func TestOpsCompatibilityRegistry(t *testing.T) {
+	source := map[string]string{infoVersion: defaults.Version, infoRegistryAddress: "registry.dev:5000"}
+	dest := map[string]string{infoVersion: defaults.Version, infoRegistryAddress: "registry.prod:5000"}
+
+	err := checkOpsCompatibility(&cobra.Command{}, source, dest, true)
+	require.Error(t, err)
+	assert.Equal(t, "source (registry.dev:5000) and destination (registry.prod:5000) Camel K container images registries are not the same", err.Error())
+	require.NoError(t, checkOpsCompatibility(&cobra.Command{}, source, dest, false))
+}
+
+func TestIntegrationGitOpsExport(t *testing.T) {
+	srcPlatform := v1.NewIntegrationPlatform("default", platform.DefaultPlatformName)
+	srcPlatform.Status.Version = defaults.Version
+	srcPlatform.Status.Build.RuntimeVersion = defaults.DefaultRuntimeVersion
+	srcPlatform.Status.Phase = v1.IntegrationPlatformPhaseReady
+	defaultIntegration, defaultKit := nominalIntegration("my-it-test")
+	defaultIntegration.Spec.Traits.Mount = &traitv1.MountTrait{
+		Configs: []string{"configmap:my-cm"},
+	}
+	defaultIntegration.Spec.Traits.Container = &traitv1.ContainerTrait{
+		LimitMemory: "512Mi",
+	}
+	srcCatalog := createTestCamelCatalog(srcPlatform)
+	dir := t.TempDir()
+
+	_, promoteCmd, _ := initializePromoteCmdOptions(t, &srcPlatform, &defaultIntegration, &defaultKit, &srcCatalog)
+	output, err := test.ExecuteCommand(promoteCmd, cmdPromote, "my-it-test", "--to", "prod-namespace", "--export-gitops-dir", dir, "-n", "default")
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("Exported Integration \"my-it-test\" to %q\n", dir), output)
+
+	assertFileContent(t, filepath.Join(dir, "my-it-test", "base", "kustomization.yaml"), `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+- integration.yaml
+`)
+	assertFileContent(t, filepath.Join(dir, "my-it-test", "base", "integration.yaml"), `apiVersion: camel.apache.org/v1
+kind: Integration
+metadata:
+  name: my-it-test
+spec:
+  traits:
+    container:
+      image: my-special-image
+    jvm:
+      classpath: /path/to/artifact-1/*:/path/to/artifact-2/*
+`)
+	assertFileContent(t, filepath.Join(dir, "my-it-test", "overlays", "prod-namespace", "kustomization.yaml"), `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+namespace: prod-namespace
+resources:
+- ../../base
+patches:
+- path: patch-integration.yaml
+`)
+	patchFile := filepath.Join(dir, "my-it-test", "overlays", "prod-namespace", "patch-integration.yaml")
+	assertFileContent(t, patchFile, `apiVersion: camel.apache.org/v1
+kind: Integration
+metadata:
+  name: my-it-test
+spec:
+  traits:
+    container:
+      limitMemory: 512Mi
+    mount:
+      configs:
+      - configmap:my-cm
+`)
+
+	// The promoted values are updated, the environment specific ones are kept on further promotions
+	require.NoError(t, os.WriteFile(patchFile, []byte(`apiVersion: camel.apache.org/v1
+kind: Integration
+metadata:
+  name: my-it-test
+spec:
+  traits:
+    container:
+      limitMemory: 1Gi
+      requestMemory: 256Mi
+`), 0o600))
+	_, err = test.ExecuteCommand(promoteCmd, cmdPromote, "my-it-test", "--to", "prod-namespace", "--export-gitops-dir", dir, "-n", "default")
+	require.NoError(t, err)
+	expected := `apiVersion: camel.apache.org/v1
+kind: Integration
+metadata:
+  name: my-it-test
+spec:
+  traits:
+    container:
+      limitMemory: 512Mi
+      requestMemory: 256Mi
+    mount:
+      configs:
+      - configmap:my-cm
+`
+	assertFileContent(t, patchFile, expected)
+	_, err = test.ExecuteCommand(promoteCmd, cmdPromote, "my-it-test", "--to", "prod-namespace", "--export-gitops-dir", dir, "-n", "default")
+	require.NoError(t, err)
+	assertFileContent(t, patchFile, expected)
+}
+
+func TestGitOpsExportWithOutput(t *testing.T) {
+	defaultIntegration, defaultKit := nominalIntegration("my-it-test")
+
+	_, promoteCmd, _ := initializePromoteCmdOptions(t, &defaultIntegration, &defaultKit)
+	_, err := test.ExecuteCommand(promoteCmd, cmdPromote, "my-it-test", "--to", "prod-namespace", "--export-gitops-dir", t.TempDir(), "-o", "yaml", "-n", "default")
+	require.Error(t, err)
+	assert.Equal(t, "cannot use --export-gitops-dir together with --output or --image", err.Error())
+}
+
+func assertFileContent(t *testing.T, file string, expected string) {
+	t.Helper()
+	content, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(content))
+}
+
+func TestPipeGitOpsExport(t *testing.T) {
+	srcPlatform := v1.NewIntegrationPlatform("default", platform.DefaultPlatformName)
+	srcPlatform.Status.Version = defaults.Version
+	srcPlatform.Status.Build.RuntimeVersion = defaults.DefaultRuntimeVersion
+	srcPlatform.Status.Phase = v1.IntegrationPlatformPhaseReady
+	defaultKB := nominalPipe("my-kb-test")
+	defaultKB.Spec.Source.Ref = &corev1.ObjectReference{
+		Kind:       "Kamelet",
+		APIVersion: v1.SchemeGroupVersion.String(),
+		Name:       "timer-source",
+	}
+	defaultKB.Spec.Integration = &v1.IntegrationSpec{
+		Traits: v1.Traits{
+			Mount: &traitv1.MountTrait{
+				Configs: []string{"configmap:my-cm"},
+			},
+			Container: &traitv1.ContainerTrait{
+				LimitMemory: "512Mi",
+			},
+		},
+	}
+	defaultIntegration, defaultKit := nominalIntegration("my-kb-test")
+	srcCatalog := createTestCamelCatalog(srcPlatform)
+	dir := t.TempDir()
+
+	_, promoteCmd, _ := initializePromoteCmdOptions(t, &srcPlatform, &defaultKB, &defaultIntegration, &defaultKit, &srcCatalog)
+	output, err := test.ExecuteCommand(promoteCmd, cmdPromote, "my-kb-test", "--to", "prod-namespace", "--export-gitops-dir", dir, "-n", "default")
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("Exported Pipe \"my-kb-test\" to %q\n", dir), output)
+
+	assertFileContent(t, filepath.Join(dir, "my-kb-test", "base", "pipe.yaml"), `apiVersion: camel.apache.org/v1
+kind: Pipe
+metadata:
+  name: my-kb-test
+spec:
+  integration:
+    traits:
+      container:
+        image: my-special-image
+      jvm:
+        classpath: /path/to/artifact-1/*:/path/to/artifact-2/*
+  sink: {}
+  source:
+    ref:
+      apiVersion: camel.apache.org/v1
+      kind: Kamelet
+      name: timer-source
+`)
+	assertFileContent(t, filepath.Join(dir, "my-kb-test", "overlays", "prod-namespace", "kustomization.yaml"), `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+namespace: prod-namespace
+resources:
+- ../../base
+patches:
+- path: patch-pipe.yaml
+`)
+	patchFile := filepath.Join(dir, "my-kb-test", "overlays", "prod-namespace", "patch-pipe.yaml")
+	assertFileContent(t, patchFile, `apiVersion: camel.apache.org/v1
+kind: Pipe
+metadata:
+  name: my-kb-test
+spec:
+  integration:
+    traits:
+      container:
+        limitMemory: 512Mi
+      mount:
+        configs:
+        - configmap:my-cm
+  sink: {}
+  source: {}
+`)
+
+	// The promoted values are updated, the environment specific ones are kept on further promotions
+	require.NoError(t, os.WriteFile(patchFile, []byte(`apiVersion: camel.apache.org/v1
+kind: Pipe
+metadata:
+  name: my-kb-test
+spec:
+  integration:
+    traits:
+      container:
+        limitMemory: 1Gi
+        requestMemory: 256Mi
+`), 0o600))
+	_, err = test.ExecuteCommand(promoteCmd, cmdPromote, "my-kb-test", "--to", "prod-namespace", "--export-gitops-dir", dir, "-n", "default")
+	require.NoError(t, err)
+	assertFileContent(t, patchFile, `apiVersion: camel.apache.org/v1
+kind: Pipe
+metadata:
+  name: my-kb-test
+spec:
+  integration:
+    traits:
+      container:
+        limitMemory: 512Mi
+        requestMemory: 256Mi
+      mount:
+        configs:
+        - configmap:my-cm
+  sink: {}
+  source: {}
+`)
+}
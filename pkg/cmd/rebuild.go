@@ -115,10 +115,18 @@ func (o *rebuildCmdOptions) getIntegrations(c client.Client, names []string) ([]
 	return ints, nil
 }
 
-func (o *rebuildCmdOptions) rebuildIntegrations(c k8sclient.StatusClient, integrations []v1.Integration) error {
+func (o *rebuildCmdOptions) rebuildIntegrations(c client.Client, integrations []v1.Integration) error {
+	user := currentUser(o.Context, c)
 	for _, i := range integrations {
 		it := i
-		it.Status = v1.IntegrationStatus{}
+		// Record who requested the rebuild, for the operator audit trail
+		patch := k8sclient.MergeFrom(it.DeepCopy())
+		setChangedBy(&it, user)
+		if err := c.Patch(o.Context, &it, patch); err != nil {
+			return fmt.Errorf("could not rebuild integration %s in namespace %s: %w", it.Name, o.Namespace, err)
+		}
+		// The audited generation is kept, so that the operator audit trail records a rebuild, not a creation
+		it.Status = v1.IntegrationStatus{AuditedGeneration: it.Status.AuditedGeneration}
 		if err := c.Status().Update(o.Context, &it); err != nil {
 			return fmt.Errorf("could not rebuild integration %s in namespace %s: %w", it.Name, o.Namespace, err)
 		}
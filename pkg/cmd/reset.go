@@ -107,12 +107,19 @@ func (o *resetCmdOptions) deleteAllIntegrations(c client.Client) (int, error) {
 	if err := c.List(o.Context, &list, k8sclient.InNamespace(o.Namespace)); err != nil {
 		return 0, fmt.Errorf("could not retrieve integrations from namespace %s: %w", o.Namespace, err)
 	}
+	user := currentUser(o.Context, c)
 	for _, i := range list.Items {
 		it := i
 		if isIntegrationOwned(it) {
 			// Deleting it directly is ineffective, deleting the controller will delete it
 			continue
 		}
+		// Record who deleted the integration, for the operator audit trail
+		patch := k8sclient.MergeFrom(it.DeepCopy())
+		setChangedBy(&it, user)
+		if err := c.Patch(o.Context, &it, patch); err != nil {
+			return 0, fmt.Errorf("could not delete integration %s from namespace %s: %w", it.Name, it.Namespace, err)
+		}
 		if err := c.Delete(o.Context, &it); err != nil {
 			return 0, fmt.Errorf("could not delete integration %s from namespace %s: %w", it.Name, it.Namespace, err)
 		}
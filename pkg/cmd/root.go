@@ -139,19 +139,28 @@ func addKamelSubcommands(cmd *cobra.Command, options *RootCmdOptions) {
 	cmd.AddCommand(cmdOnly(newCmdRun(options)))
 	cmd.AddCommand(cmdOnly(newCmdGet(options)))
 	cmd.AddCommand(cmdOnly(newCmdDelete(options)))
+	cmd.AddCommand(cmdOnly(newCmdApply(options)))
 	cmd.AddCommand(cmdOnly(newCmdInstall(options)))
 	cmd.AddCommand(cmdOnly(newCmdUninstall(options)))
 	cmd.AddCommand(cmdOnly(newCmdLog(options)))
 	cmd.AddCommand(newCmdKit(options))
+	cmd.AddCommand(newCmdBuild(options))
+	cmd.AddCommand(newCmdBundle(options))
 	cmd.AddCommand(cmdOnly(newCmdReset(options)))
 	cmd.AddCommand(newCmdDescribe(options))
 	cmd.AddCommand(cmdOnly(newCmdRebuild(options)))
+	cmd.AddCommand(cmdOnly(newCmdSuspend(options)))
+	cmd.AddCommand(cmdOnly(newCmdResume(options)))
 	cmd.AddCommand(cmdOnly(newCmdOperator(options)))
 	cmd.AddCommand(cmdOnly(newCmdBuilder(options)))
 	cmd.AddCommand(cmdOnly(newCmdDebug(options)))
 	cmd.AddCommand(cmdOnly(newCmdDump(options)))
 	cmd.AddCommand(cmdOnly(newCmdBind(options)))
 	cmd.AddCommand(cmdOnly(newCmdPromote(options)))
+	cmd.AddCommand(cmdOnly(newCmdCompare(options)))
+	cmd.AddCommand(cmdOnly(newCmdTop(options)))
+	cmd.AddCommand(newCmdRoute(options))
+	cmd.AddCommand(cmdOnly(newCmdAudit(options)))
 	cmd.AddCommand(newCmdKamelet(options))
 	cmd.AddCommand(cmdOnly(newCmdConfig(options)))
 }
@@ -0,0 +1,127 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"sort"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util/jolokia"
+)
+
+// camelRoutesMBeans is the pattern of the MBeans of the Camel routes.
+const camelRoutesMBeans = "org.apache.camel:type=routes,*"
+
+var camelRouteAttributes = []string{
+	"CamelId",
+	"RouteId",
+	"State",
+	"ExchangesTotal",
+	"ExchangesFailed",
+	"ExchangesInflight",
+	"LastProcessingTime",
+	"MeanProcessingTime",
+}
+
+func newCmdRoute(rootCmdOptions *RootCmdOptions) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "route",
+		Short: "Manage the Camel routes of running integrations",
+		Long: `Manage the Camel routes of running integrations, through the Jolokia agent of their Pods.
+The jolokia trait must be enabled on the integrations.`,
+	}
+
+	cmd.AddCommand(cmdOnly(newRouteListCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newRouteControlCmd(rootCmdOptions, routeStop)))
+	cmd.AddCommand(cmdOnly(newRouteControlCmd(rootCmdOptions, routeStart)))
+	cmd.AddCommand(cmdOnly(newRouteControlCmd(rootCmdOptions, routeSuspend)))
+	cmd.AddCommand(cmdOnly(newRouteControlCmd(rootCmdOptions, routeResume)))
+
+	return &cmd
+}
+
+// camelRoute is a Camel route, as exposed by its MBean.
+type camelRoute struct {
+	MBean              string `json:"-"`
+	CamelID            string `json:"CamelId"`
+	RouteID            string `json:"RouteId"`
+	State              string `json:"State"`
+	ExchangesTotal     int64  `json:"ExchangesTotal"`
+	ExchangesFailed    int64  `json:"ExchangesFailed"`
+	ExchangesInflight  int64  `json:"ExchangesInflight"`
+	LastProcessingTime int64  `json:"LastProcessingTime"`
+	MeanProcessingTime int64  `json:"MeanProcessingTime"`
+}
+
+// readCamelRoutes reads the Camel routes exposed by the Jolokia agent, sorted by Camel context and route id.
+func readCamelRoutes(ctx context.Context, c *jolokia.Client) ([]camelRoute, error) {
+	var mbeans map[string]camelRoute
+	if err := c.Read(ctx, camelRoutesMBeans, camelRouteAttributes, &mbeans); err != nil {
+		return nil, err
+	}
+
+	routes := make([]camelRoute, 0, len(mbeans))
+	for name, route := range mbeans {
+		route.MBean = name
+		routes = append(routes, route)
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].CamelID != routes[j].CamelID {
+			return routes[i].CamelID < routes[j].CamelID
+		}
+		return routes[i].RouteID < routes[j].RouteID
+	})
+
+	return routes, nil
+}
+
+// readyIntegrationPods returns the ready Pods of the Integration, sorted by name.
+func readyIntegrationPods(ctx context.Context, c client.Client, it *v1.Integration) ([]corev1.Pod, error) {
+	pods := corev1.PodList{}
+	if err := c.List(ctx, &pods, k8sclient.InNamespace(it.Namespace), k8sclient.MatchingLabels{v1.IntegrationLabel: it.Name}); err != nil {
+		return nil, err
+	}
+
+	ready := make([]corev1.Pod, 0, len(pods.Items))
+	for _, pod := range pods.Items {
+		if pod.DeletionTimestamp != nil || !isPodReady(&pod) {
+			continue
+		}
+		ready = append(ready, pod)
+	}
+	sort.Slice(ready, func(i, j int) bool {
+		return ready[i].Name < ready[j].Name
+	})
+
+	return ready, nil
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,176 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/multierr"
+	corev1 "k8s.io/api/core/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/apache/camel-k/v2/pkg/util/jolokia"
+)
+
+// routeOperation is an operation of the MBean of a Camel route, and the state the route ends up in.
+type routeOperation struct {
+	name  string
+	title string
+	state string
+	done  string
+}
+
+var (
+	routeStop    = routeOperation{name: "stop", title: "Stop", state: "Stopped", done: "stopped"}
+	routeStart   = routeOperation{name: "start", title: "Start", state: "Started", done: "started"}
+	routeSuspend = routeOperation{name: "suspend", title: "Suspend", state: "Suspended", done: "suspended"}
+	routeResume  = routeOperation{name: "resume", title: "Resume", state: "Started", done: "resumed"}
+)
+
+func newRouteControlCmd(rootCmdOptions *RootCmdOptions, operation routeOperation) (*cobra.Command, *routeControlCmdOptions) {
+	options := routeControlCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+		operation:      operation,
+	}
+	cmd := cobra.Command{
+		Use:   operation.name + " <integration> [route-id]",
+		Short: fmt.Sprintf("%s a Camel route of a running integration", operation.title),
+		Long: fmt.Sprintf(`%s a Camel route of a running integration, or all its routes when no route id is given, in all
+the ready Pods. The route state is recorded on the integration, so that the operator does not consider the
+integration as failing, and so that the Pods started afterwards, e.g. when scaling the integration, do not start
+the stopped or suspended routes. The routes controlled in some Pods are recorded even when the operation fails in
+other Pods.`, operation.title),
+		Args:    options.validateArgs,
+		PreRunE: decode(&options, options.Flags),
+		RunE:    options.run,
+	}
+
+	cmd.Flags().Bool("insecure", false, jolokiaInsecureFlagUsage)
+
+	return &cmd, &options
+}
+
+type routeControlCmdOptions struct {
+	*RootCmdOptions `json:"-"`
+	Insecure        bool `mapstructure:"insecure" yaml:",omitempty"`
+	operation       routeOperation
+	// jolokia returns the client of the Jolokia agent of a Pod
+	jolokia func(pod *corev1.Pod) (*jolokia.Client, error)
+}
+
+func (o *routeControlCmdOptions) validateArgs(_ *cobra.Command, args []string) error {
+	if len(args) < 1 || len(args) > 2 {
+		return fmt.Errorf("route %s expects an integration name and an optional route id arguments", o.operation.name)
+	}
+	return nil
+}
+
+func (o *routeControlCmdOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+	it, err := getIntegration(o.Context, c, args[0], o.Namespace)
+	if err != nil {
+		return err
+	}
+	routeID := ""
+	if len(args) == 2 {
+		routeID = args[1]
+	}
+	pods, err := readyIntegrationPods(o.Context, c, it)
+	if err != nil {
+		return err
+	}
+	if len(pods) == 0 {
+		return fmt.Errorf("no ready Pod found for integration %s", it.Name)
+	}
+
+	ctx, cancel := context.WithCancel(o.Context)
+	defer cancel()
+	if o.jolokia == nil {
+		o.jolokia = newJolokiaClients(ctx, c, cmd.ErrOrStderr(), o.Insecure).forPod
+	}
+
+	// Apply the operation to the routes of all the replicas, and carry on with the other replicas on failures,
+	// so that the state of the routes controlled in any replica is recorded
+	var errs error
+	controlled := make(map[string]bool)
+	for i := range pods {
+		if err := o.control(ctx, cmd, &pods[i], routeID, controlled); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
+
+	// Record the state of the routes, so that the operator does not consider the failing health checks
+	// of the stopped or suspended routes, and that the Pods started afterwards do not start them
+	if len(controlled) > 0 {
+		target := it.DeepCopy()
+		routes := target.GetControlledRoutes()
+		for id := range controlled {
+			if o.operation.state == routeStart.state {
+				delete(routes, id)
+			} else {
+				routes[id] = o.operation.state
+			}
+		}
+		target.SetControlledRoutes(routes)
+		setChangedBy(target, currentUser(o.Context, c))
+		if err := c.Patch(o.Context, target, k8sclient.MergeFrom(it)); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	} else if errs == nil {
+		errs = errors.New("no route found")
+	}
+
+	return errs
+}
+
+// control applies the operation to the routes of the Pod, and collects the ids of the routes controlled.
+func (o *routeControlCmdOptions) control(ctx context.Context, cmd *cobra.Command, pod *corev1.Pod, routeID string, controlled map[string]bool) error {
+	jc, err := o.jolokia(pod)
+	if err != nil {
+		return fmt.Errorf("unable to connect to the Jolokia agent of Pod %s: %w", pod.Name, err)
+	}
+	routes, err := readCamelRoutes(ctx, jc)
+	if err != nil {
+		return fmt.Errorf("unable to list the routes of Pod %s: %w", pod.Name, err)
+	}
+	var errs error
+	found := false
+	for _, route := range routes {
+		if routeID != "" && route.RouteID != routeID {
+			continue
+		}
+		found = true
+		if err := jc.Exec(ctx, route.MBean, o.operation.name+"()", nil); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("unable to %s route %s in Pod %s: %w", o.operation.name, route.RouteID, pod.Name, err))
+			continue
+		}
+		controlled[route.RouteID] = true
+		fmt.Fprintf(cmd.OutOrStdout(), "Route %s %s in Pod %s\n", route.RouteID, o.operation.done, pod.Name)
+	}
+	if !found && routeID != "" {
+		errs = multierr.Append(errs, fmt.Errorf("route %s not found in Pod %s", routeID, pod.Name))
+	}
+
+	return errs
+}
@@ -0,0 +1,102 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/apache/camel-k/v2/pkg/util/jolokia"
+)
+
+func newRouteListCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *routeListCmdOptions) {
+	options := routeListCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+	cmd := cobra.Command{
+		Use:     "list <integration>",
+		Short:   "List the Camel routes of a running integration",
+		Long:    `List the Camel routes of a running integration, with their state in each ready Pod.`,
+		Args:    options.validateArgs,
+		PreRunE: decode(&options, options.Flags),
+		RunE:    options.run,
+	}
+
+	cmd.Flags().Bool("insecure", false, jolokiaInsecureFlagUsage)
+
+	return &cmd, &options
+}
+
+type routeListCmdOptions struct {
+	*RootCmdOptions `json:"-"`
+	Insecure        bool `mapstructure:"insecure" yaml:",omitempty"`
+	// jolokia returns the client of the Jolokia agent of a Pod
+	jolokia func(pod *corev1.Pod) (*jolokia.Client, error)
+}
+
+func (o *routeListCmdOptions) validateArgs(_ *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return errors.New("route list expects an integration name argument")
+	}
+	return nil
+}
+
+func (o *routeListCmdOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+	it, err := getIntegration(o.Context, c, args[0], o.Namespace)
+	if err != nil {
+		return err
+	}
+	pods, err := readyIntegrationPods(o.Context, c, it)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(o.Context)
+	defer cancel()
+	if o.jolokia == nil {
+		o.jolokia = newJolokiaClients(ctx, c, cmd.ErrOrStderr(), o.Insecure).forPod
+	}
+
+	controlled := it.GetControlledRoutes()
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "POD\tCONTEXT\tROUTE\tSTATE\tCONTROLLED")
+	for i := range pods {
+		jc, err := o.jolokia(&pods[i])
+		if err != nil {
+			return fmt.Errorf("unable to connect to the Jolokia agent of Pod %s: %w", pods[i].Name, err)
+		}
+		routes, err := readCamelRoutes(ctx, jc)
+		if err != nil {
+			return fmt.Errorf("unable to list the routes of Pod %s: %w", pods[i].Name, err)
+		}
+		for _, route := range routes {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", pods[i].Name, route.CamelID, route.RouteID, route.State, controlled[route.RouteID])
+		}
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,229 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/util/jolokia"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+)
+
+// fakeJolokia emulates the Jolokia agent of an integration Pod, exposing the MBeans of Camel routes.
+type fakeJolokia struct {
+	*httptest.Server
+	mu     sync.Mutex
+	states map[string]string
+}
+
+func newFakeJolokia(t *testing.T, routes ...string) *fakeJolokia {
+	t.Helper()
+	f := &fakeJolokia{states: make(map[string]string)}
+	for _, route := range routes {
+		f.states[route] = "Started"
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		var req map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		switch req["type"] {
+		case "read":
+			value := make(map[string]interface{})
+			for route, state := range f.states {
+				value[fmt.Sprintf("org.apache.camel:context=camel-1,name=%q,type=routes", route)] = map[string]interface{}{
+					"CamelId": "camel-1",
+					"RouteId": route,
+					"State":   state,
+				}
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": 200, "value": value})
+		case "exec":
+			mbean, _ := req["mbean"].(string)
+			route := strings.TrimSuffix(strings.SplitN(mbean, "name=\"", 2)[1], "\",type=routes")
+			switch req["operation"] {
+			case "stop()":
+				f.states[route] = "Stopped"
+			case "suspend()":
+				f.states[route] = "Suspended"
+			case "start()", "resume()":
+				f.states[route] = "Started"
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": 200})
+		}
+	}))
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeJolokia) state(route string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.states[route]
+}
+
+func initializeRouteCmdOptions(t *testing.T, agents map[string]*fakeJolokia, initObjs ...runtime.Object) (*cobra.Command, client.Client) {
+	t.Helper()
+	defaultPlatform := v1.NewIntegrationPlatform("default", platform.DefaultPlatformName)
+	fakeClient, err := test.NewFakeClient(append(initObjs, &defaultPlatform)...)
+	require.NoError(t, err)
+
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	clients := func(pod *corev1.Pod) (*jolokia.Client, error) {
+		agent, ok := agents[pod.Name]
+		if !ok {
+			return nil, errJolokiaNotEnabled
+		}
+		return jolokia.NewClient(agent.URL+"/jolokia", false), nil
+	}
+
+	routeCmd := cobra.Command{Use: "route"}
+	listCmd, listOptions := newRouteListCmd(options)
+	listOptions.jolokia = clients
+	routeCmd.AddCommand(listCmd)
+	for _, operation := range []routeOperation{routeStop, routeStart, routeSuspend, routeResume} {
+		controlCmd, controlOptions := newRouteControlCmd(options, operation)
+		controlOptions.jolokia = clients
+		routeCmd.AddCommand(controlCmd)
+	}
+	rootCmd.AddCommand(&routeCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return rootCmd, fakeClient
+}
+
+func TestRouteStopAllReplicas(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	agents := map[string]*fakeJolokia{
+		"my-it-1": newFakeJolokia(t, "route1", "route2"),
+		"my-it-2": newFakeJolokia(t, "route1", "route2"),
+	}
+	cmd, c := initializeRouteCmdOptions(t, agents, &it,
+		newIntegrationPod("my-it", "my-it-1", true),
+		newIntegrationPod("my-it", "my-it-2", true),
+	)
+
+	output, err := test.ExecuteCommand(cmd, "route", "stop", "my-it", "route1")
+	require.NoError(t, err)
+	assert.Equal(t, "Route route1 stopped in Pod my-it-1\nRoute route1 stopped in Pod my-it-2\n", output)
+	for _, agent := range agents {
+		assert.Equal(t, "Stopped", agent.state("route1"))
+		assert.Equal(t, "Started", agent.state("route2"))
+	}
+
+	updated, err := getIntegration(context.TODO(), c, "my-it", "default")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"route1": "Stopped"}, updated.GetControlledRoutes())
+
+	output, err = test.ExecuteCommand(cmd, "route", "list", "my-it")
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	require.Len(t, lines, 5)
+	assert.Equal(t, []string{"my-it-1", "camel-1", "route1", "Stopped", "Stopped"}, strings.Fields(lines[1]))
+	assert.Equal(t, []string{"my-it-1", "camel-1", "route2", "Started"}, strings.Fields(lines[2]))
+
+	_, err = test.ExecuteCommand(cmd, "route", "start", "my-it", "route1")
+	require.NoError(t, err)
+	for _, agent := range agents {
+		assert.Equal(t, "Started", agent.state("route1"))
+	}
+	updated, err = getIntegration(context.TODO(), c, "my-it", "default")
+	require.NoError(t, err)
+	assert.Empty(t, updated.GetControlledRoutes())
+	assert.NotContains(t, updated.Annotations, v1.IntegrationControlledRoutesAnnotation)
+}
+
+func TestRouteSuspendAllRoutes(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	agent := newFakeJolokia(t, "route1", "route2")
+	cmd, c := initializeRouteCmdOptions(t, map[string]*fakeJolokia{"my-it-1": agent}, &it,
+		newIntegrationPod("my-it", "my-it-1", true),
+		newIntegrationPod("my-it", "my-it-2", false),
+	)
+
+	_, err := test.ExecuteCommand(cmd, "route", "suspend", "my-it")
+	require.NoError(t, err)
+	assert.Equal(t, "Suspended", agent.state("route1"))
+	assert.Equal(t, "Suspended", agent.state("route2"))
+
+	updated, err := getIntegration(context.TODO(), c, "my-it", "default")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"route1": "Suspended", "route2": "Suspended"}, updated.GetControlledRoutes())
+
+	_, err = test.ExecuteCommand(cmd, "route", "resume", "my-it", "route2")
+	require.NoError(t, err)
+	assert.Equal(t, "Started", agent.state("route2"))
+	updated, err = getIntegration(context.TODO(), c, "my-it", "default")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"route1": "Suspended"}, updated.GetControlledRoutes())
+}
+
+func TestRouteErrors(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	other := v1.NewIntegration("default", "other")
+	agent := newFakeJolokia(t, "route1")
+	cmd, _ := initializeRouteCmdOptions(t, map[string]*fakeJolokia{"my-it-1": agent}, &it, &other,
+		newIntegrationPod("my-it", "my-it-1", true),
+	)
+
+	_, err := test.ExecuteCommand(cmd, "route", "stop", "my-it", "missing")
+	require.EqualError(t, err, "route missing not found in Pod my-it-1")
+
+	_, err = test.ExecuteCommand(cmd, "route", "stop", "other")
+	require.EqualError(t, err, "no ready Pod found for integration other")
+
+	_, err = test.ExecuteCommand(cmd, "route", "stop")
+	require.EqualError(t, err, "route stop expects an integration name and an optional route id arguments")
+}
+
+func TestRouteStopPartialFailure(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	agent := newFakeJolokia(t, "route1")
+	cmd, c := initializeRouteCmdOptions(t, map[string]*fakeJolokia{"my-it-2": agent}, &it,
+		newIntegrationPod("my-it", "my-it-1", true),
+		newIntegrationPod("my-it", "my-it-2", true),
+		newIntegrationPod("my-it", "my-it-3", true),
+	)
+
+	output, err := test.ExecuteCommand(cmd, "route", "stop", "my-it", "route1")
+	require.EqualError(t, err, "unable to connect to the Jolokia agent of Pod my-it-1: jolokia trait not enabled; "+
+		"unable to connect to the Jolokia agent of Pod my-it-3: jolokia trait not enabled")
+	assert.Contains(t, output, "Route route1 stopped in Pod my-it-2\n")
+	assert.Equal(t, "Stopped", agent.state("route1"))
+
+	updated, err := getIntegration(context.TODO(), c, "my-it", "default")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"route1": "Stopped"}, updated.GetControlledRoutes())
+}
@@ -423,6 +423,7 @@ func (o *runCmdOptions) postRun(cmd *cobra.Command, args []string) error {
 }
 
 func (o *runCmdOptions) waitForIntegrationReady(cmd *cobra.Command, c client.Client, integration *v1.Integration) (*v1.IntegrationPhase, error) {
+	policyMessage := ""
 	handler := func(i *v1.Integration) bool {
 		//
 		// TODO when we add health checks, we should Wait until they are passed
@@ -431,6 +432,14 @@ func (o *runCmdOptions) waitForIntegrationReady(cmd *cobra.Command, c client.Cli
 			// TODO remove this log when we make sure that events are always created
 			fmt.Fprintf(cmd.OutOrStdout(), "Progress: integration %q in phase %s\n", integration.Name, string(i.Status.Phase))
 		}
+		if cond := i.Status.GetCondition(v1.IntegrationConditionPolicyCompliant); cond != nil && cond.Message != "" && cond.Message != policyMessage {
+			policyMessage = cond.Message
+			if cond.Status == corev1.ConditionFalse {
+				fmt.Fprintf(cmd.OutOrStdout(), "Policy: integration %q violates the integration policies: %s\n", integration.Name, cond.Message)
+			} else {
+				fmt.Fprintf(cmd.OutOrStdout(), "Policy: integration %q mutated by the integration policies: %s\n", integration.Name, cond.Message)
+			}
+		}
 		if i.Status.Phase == v1.IntegrationPhaseRunning || i.Status.Phase == v1.IntegrationPhaseError {
 			return false
 		}
@@ -575,6 +584,7 @@ func (o *runCmdOptions) createOrUpdateIntegration(cmd *cobra.Command, c client.C
 	}
 
 	if existing == nil {
+		setChangedBy(integration, currentUser(o.Context, c))
 		err = c.Create(o.Context, integration)
 		if err != nil {
 			return nil, err
@@ -591,6 +601,8 @@ func (o *runCmdOptions) createOrUpdateIntegration(cmd *cobra.Command, c client.C
 			fmt.Fprintln(cmd.OutOrStdout(), `Integration "`+name+`" unchanged`)
 			return integration, nil
 		}
+		// Set after the comparison, so that a different user alone does not update the Integration
+		setChangedBy(integration, currentUser(o.Context, c))
 		err = c.Patch(o.Context, integration, patch)
 		if err != nil {
 			return nil, err
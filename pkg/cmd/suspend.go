@@ -0,0 +1,181 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+)
+
+func newCmdSuspend(rootCmdOptions *RootCmdOptions) (*cobra.Command, *suspendCmdOptions) {
+	options := suspendCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+		suspend:        true,
+	}
+	cmd := cobra.Command{
+		Use:   "suspend [integration1] [integration2] ...",
+		Short: "Suspend integrations",
+		Long: `Suspend one or more integrations, scaling their workloads down to zero while keeping all their resources.
+The integrations owned by a Pipe are suspended through the Pipe.`,
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(args); err != nil {
+				return err
+			}
+			return options.run(cmd, args)
+		},
+	}
+
+	cmd.Flags().Bool("all", false, "Suspend all integrations")
+
+	return &cmd, &options
+}
+
+func newCmdResume(rootCmdOptions *RootCmdOptions) (*cobra.Command, *suspendCmdOptions) {
+	options := suspendCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+		suspend:        false,
+	}
+	cmd := cobra.Command{
+		Use:     "resume [integration1] [integration2] ...",
+		Short:   "Resume suspended integrations",
+		Long:    `Resume one or more suspended integrations, scaling their workloads back up.`,
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(args); err != nil {
+				return err
+			}
+			return options.run(cmd, args)
+		},
+	}
+
+	cmd.Flags().Bool("all", false, "Resume all integrations")
+
+	return &cmd, &options
+}
+
+type suspendCmdOptions struct {
+	*RootCmdOptions
+	All     bool `mapstructure:"all"`
+	suspend bool
+}
+
+func (o *suspendCmdOptions) validate(args []string) error {
+	if o.All && len(args) > 0 {
+		return errors.New("invalid combination: --all flag is set and at least one integration name is provided")
+	}
+	if !o.All && len(args) == 0 {
+		return errors.New("invalid combination: provide one or several integration names or set --all flag for all integrations")
+	}
+
+	return nil
+}
+
+func (o *suspendCmdOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	names := args
+	if o.All {
+		list := v1.NewIntegrationList()
+		if err := c.List(o.Context, &list, k8sclient.InNamespace(o.Namespace)); err != nil {
+			return fmt.Errorf("could not retrieve integrations from namespace %s: %w", o.Namespace, err)
+		}
+		names = make([]string, 0, len(list.Items))
+		for _, it := range list.Items {
+			if it.IsSynthetic() {
+				continue
+			}
+			names = append(names, it.Name)
+		}
+	}
+
+	action := "resumed"
+	if o.suspend {
+		action = "suspended"
+	}
+	for _, name := range names {
+		kind, err := o.suspendOrResume(c, name)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "%s %s %s\n", kind, name, action)
+	}
+
+	return nil
+}
+
+// suspendOrResume annotates the Integration, or the Pipe owning it, and returns the kind of the annotated resource.
+func (o *suspendCmdOptions) suspendOrResume(c client.Client, name string) (string, error) {
+	key := k8sclient.ObjectKey{Namespace: o.Namespace, Name: name}
+	it := v1.NewIntegration(o.Namespace, name)
+	err := c.Get(o.Context, key, &it)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return "", err
+	}
+	if err == nil {
+		if it.IsSynthetic() {
+			return "", fmt.Errorf("integration %s is synthetic and cannot be suspended or resumed", name)
+		}
+		owner := metav1.GetControllerOf(&it)
+		if owner == nil || owner.Kind != v1.PipeKind {
+			return v1.IntegrationKind, o.annotate(c, &it)
+		}
+		key.Name = owner.Name
+	}
+
+	pipe := v1.NewPipe(o.Namespace, key.Name)
+	if err := c.Get(o.Context, key, &pipe); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return "", fmt.Errorf("could not find integration %s in namespace %s", name, o.Namespace)
+		}
+		return "", err
+	}
+
+	return v1.PipeKind, o.annotate(c, &pipe)
+}
+
+func (o *suspendCmdOptions) annotate(c client.Client, obj k8sclient.Object) error {
+	//nolint:forcetypeassert
+	target := obj.DeepCopyObject().(k8sclient.Object)
+	annotations := target.GetAnnotations()
+	if o.suspend {
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[v1.IntegrationSuspendedAnnotation] = "true"
+	} else {
+		delete(annotations, v1.IntegrationSuspendedAnnotation)
+	}
+	target.SetAnnotations(annotations)
+	if it, ok := target.(*v1.Integration); ok {
+		setChangedBy(it, currentUser(o.Context, c))
+	}
+
+	return c.Patch(o.Context, target, k8sclient.MergeFrom(obj))
+}
@@ -0,0 +1,104 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+)
+
+func initializeSuspendCmdOptions(t *testing.T, initObjs ...runtime.Object) (*cobra.Command, client.Client) {
+	t.Helper()
+	defaultPlatform := v1.NewIntegrationPlatform("default", platform.DefaultPlatformName)
+	fakeClient, err := test.NewFakeClient(append(initObjs, &defaultPlatform)...)
+	require.NoError(t, err)
+
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	suspendCmd, _ := newCmdSuspend(options)
+	resumeCmd, _ := newCmdResume(options)
+	rootCmd.AddCommand(suspendCmd, resumeCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return rootCmd, fakeClient
+}
+
+func TestSuspendResumeIntegration(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	rootCmd, c := initializeSuspendCmdOptions(t, &it)
+
+	output, err := test.ExecuteCommand(rootCmd, "suspend", "my-it")
+	require.NoError(t, err)
+	assert.Equal(t, "Integration my-it suspended\n", output)
+	require.NoError(t, c.Get(context.TODO(), k8sclient.ObjectKeyFromObject(&it), &it))
+	assert.True(t, it.IsSuspended())
+
+	output, err = test.ExecuteCommand(rootCmd, "resume", "my-it")
+	require.NoError(t, err)
+	assert.Equal(t, "Integration my-it resumed\n", output)
+	require.NoError(t, c.Get(context.TODO(), k8sclient.ObjectKeyFromObject(&it), &it))
+	assert.False(t, it.IsSuspended())
+}
+
+func TestSuspendPipe(t *testing.T) {
+	pipe := v1.NewPipe("default", "my-pipe")
+	it := v1.NewIntegration("default", "my-pipe")
+	it.OwnerReferences = []metav1.OwnerReference{
+		{APIVersion: v1.SchemeGroupVersion.String(), Kind: v1.PipeKind, Name: "my-pipe", Controller: pointer.Bool(true)},
+	}
+	rootCmd, c := initializeSuspendCmdOptions(t, &pipe, &it)
+
+	output, err := test.ExecuteCommand(rootCmd, "suspend", "--all")
+	require.NoError(t, err)
+	assert.Equal(t, "Pipe my-pipe suspended\n", output)
+	require.NoError(t, c.Get(context.TODO(), k8sclient.ObjectKeyFromObject(&pipe), &pipe))
+	assert.True(t, pipe.IsSuspended())
+	// The operator propagates the suspension to the Integration
+	require.NoError(t, c.Get(context.TODO(), k8sclient.ObjectKeyFromObject(&it), &it))
+	assert.False(t, it.IsSuspended())
+}
+
+func TestSuspendErrors(t *testing.T) {
+	it := v1.NewIntegration("default", "my-synthetic")
+	it.Annotations = map[string]string{
+		v1.IntegrationSyntheticLabel: "true",
+	}
+	rootCmd, _ := initializeSuspendCmdOptions(t, &it)
+
+	_, err := test.ExecuteCommand(rootCmd, "suspend")
+	require.EqualError(t, err, "invalid combination: provide one or several integration names or set --all flag for all integrations")
+	_, err = test.ExecuteCommand(rootCmd, "suspend", "my-it")
+	require.EqualError(t, err, "could not find integration my-it in namespace default")
+	_, err = test.ExecuteCommand(rootCmd, "resume", "my-synthetic")
+	require.EqualError(t, err, "integration my-synthetic is synthetic and cannot be suspended or resumed")
+	_, err = test.ExecuteCommand(rootCmd, "suspend", "--all", "my-it")
+	require.EqualError(t, err, "invalid combination: --all flag is set and at least one integration name is provided")
+}
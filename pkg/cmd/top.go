@@ -0,0 +1,237 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+)
+
+func newCmdTop(rootCmdOptions *RootCmdOptions) (*cobra.Command, *topCmdOptions) {
+	options := topCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+	cmd := cobra.Command{
+		Use:   "top [integration]",
+		Short: "Display the route statistics of running integrations",
+		Long: `Display the live throughput and latency of the routes of running integrations, polled from the Jolokia agent
+of their Pods. The jolokia trait must be enabled on the integrations.`,
+		PreRunE: decode(&options, options.Flags),
+		RunE:    options.run,
+	}
+
+	cmd.Flags().Duration("interval", 2*time.Second, "The interval between two refreshes of the statistics")
+	cmd.Flags().StringP("output", "o", "", "Output format, printing the statistics once. One of: json")
+	cmd.Flags().Bool("insecure", false, jolokiaInsecureFlagUsage)
+
+	// completion support
+	configureKnownCompletions(&cmd)
+
+	return &cmd, &options
+}
+
+type topCmdOptions struct {
+	*RootCmdOptions `json:"-"`
+	Interval        time.Duration `mapstructure:"interval" yaml:",omitempty"`
+	OutputFormat    string        `mapstructure:"output" yaml:",omitempty"`
+	Insecure        bool          `mapstructure:"insecure" yaml:",omitempty"`
+	// fetch collects the statistics of the routes of a Pod
+	fetch func(pod *corev1.Pod) ([]topRouteStats, error)
+}
+
+// topRouteStats are the statistics of a route running in an integration Pod. The processing times are in milliseconds.
+type topRouteStats struct {
+	Integration        string `json:"integration"`
+	Pod                string `json:"pod"`
+	Context            string `json:"context"`
+	RouteID            string `json:"routeId"`
+	ExchangesTotal     int64  `json:"exchangesTotal"`
+	ExchangesFailed    int64  `json:"exchangesFailed"`
+	ExchangesInflight  int64  `json:"exchangesInflight"`
+	LastProcessingTime int64  `json:"lastProcessingTime"`
+	MeanProcessingTime int64  `json:"meanProcessingTime"`
+}
+
+func (s topRouteStats) key() string {
+	return s.Pod + "/" + s.Context + "/" + s.RouteID
+}
+
+func (o *topCmdOptions) validate(_ *cobra.Command, args []string) error {
+	if len(args) > 1 {
+		return errors.New("top accepts at most one integration name argument")
+	}
+	if o.OutputFormat != "" && o.OutputFormat != "json" {
+		return fmt.Errorf("invalid output format %s, only json is supported", o.OutputFormat)
+	}
+	if o.Interval <= 0 {
+		return errors.New("the refresh interval must be positive")
+	}
+	return nil
+}
+
+func (o *topCmdOptions) run(cmd *cobra.Command, args []string) error {
+	if err := o.validate(cmd, args); err != nil {
+		return err
+	}
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(o.Context)
+	defer cancel()
+	if o.fetch == nil {
+		o.fetch = newJolokiaClients(ctx, c, cmd.ErrOrStderr(), o.Insecure).routeStats
+	}
+
+	if o.OutputFormat == "json" {
+		stats, err := o.collect(ctx, c, args)
+		if err != nil {
+			return err
+		}
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(stats)
+	}
+
+	var previous []topRouteStats
+	for {
+		stats, err := o.collect(ctx, c, args)
+		if err != nil {
+			return err
+		}
+		// Clear the terminal, like the watch command does
+		fmt.Fprint(cmd.OutOrStdout(), "\033[H\033[2J")
+		if err := printTopStats(cmd.OutOrStdout(), stats, previous, o.Interval); err != nil {
+			return err
+		}
+		previous = stats
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(o.Interval):
+		}
+	}
+}
+
+// collect returns the statistics of the routes of the ready Pods of the integration, or of all the integrations
+// of the namespace running with the jolokia trait when no integration is given.
+func (o *topCmdOptions) collect(ctx context.Context, c client.Client, args []string) ([]topRouteStats, error) {
+	var integrations []v1.Integration
+	if len(args) == 1 {
+		it, err := getIntegration(ctx, c, args[0], o.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		integrations = append(integrations, *it)
+	} else {
+		list := v1.NewIntegrationList()
+		if err := c.List(ctx, &list, k8sclient.InNamespace(o.Namespace)); err != nil {
+			return nil, err
+		}
+		integrations = list.Items
+	}
+
+	stats := make([]topRouteStats, 0)
+	for _, it := range integrations {
+		pods, err := readyIntegrationPods(ctx, c, &it)
+		if err != nil {
+			return nil, err
+		}
+		for i := range pods {
+			pod := &pods[i]
+			routes, err := o.fetch(pod)
+			if errors.Is(err, errJolokiaNotEnabled) && len(args) == 0 {
+				continue
+			} else if err != nil {
+				return nil, fmt.Errorf("unable to collect the route statistics of integration %s, Pod %s: %w", it.Name, pod.Name, err)
+			}
+			for _, route := range routes {
+				route.Integration = it.Name
+				route.Pod = pod.Name
+				stats = append(stats, route)
+			}
+		}
+	}
+
+	return stats, nil
+}
+
+// routeStats reads the statistics of the Camel routes from the Jolokia agent of the Pod.
+func (j *jolokiaClients) routeStats(pod *corev1.Pod) ([]topRouteStats, error) {
+	c, err := j.forPod(pod)
+	if err != nil {
+		return nil, err
+	}
+
+	routes, err := readCamelRoutes(j.ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]topRouteStats, 0, len(routes))
+	for _, route := range routes {
+		stats = append(stats, topRouteStats{
+			Context:            route.CamelID,
+			RouteID:            route.RouteID,
+			ExchangesTotal:     route.ExchangesTotal,
+			ExchangesFailed:    route.ExchangesFailed,
+			ExchangesInflight:  route.ExchangesInflight,
+			LastProcessingTime: route.LastProcessingTime,
+			MeanProcessingTime: route.MeanProcessingTime,
+		})
+	}
+
+	return stats, nil
+}
+
+// printTopStats prints the statistics of the routes, with their throughput computed from the previous statistics.
+func printTopStats(out io.Writer, stats []topRouteStats, previous []topRouteStats, interval time.Duration) error {
+	exchanges := make(map[string]int64, len(previous))
+	for _, s := range previous {
+		exchanges[s.key()] = s.ExchangesTotal
+	}
+
+	w := tabwriter.NewWriter(out, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "INTEGRATION\tPOD\tROUTE\tEXCHANGES\tFAILED\tINFLIGHT\tEXCHANGES/S\tLAST TIME\tMEAN TIME")
+	for _, s := range stats {
+		throughput := ""
+		if total, ok := exchanges[s.key()]; ok && s.ExchangesTotal >= total {
+			throughput = strconv.FormatFloat(float64(s.ExchangesTotal-total)/interval.Seconds(), 'f', 1, 64)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%d\t%s\t%s\t%s\n", s.Integration, s.Pod, s.RouteID,
+			s.ExchangesTotal, s.ExchangesFailed, s.ExchangesInflight, throughput,
+			time.Duration(s.LastProcessingTime)*time.Millisecond, time.Duration(s.MeanProcessingTime)*time.Millisecond)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,186 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/util/jolokia"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+)
+
+func initializeTopCmdOptions(t *testing.T, initObjs ...runtime.Object) (*cobra.Command, *topCmdOptions) {
+	t.Helper()
+	defaultPlatform := v1.NewIntegrationPlatform("default", platform.DefaultPlatformName)
+	fakeClient, err := test.NewFakeClient(append(initObjs, &defaultPlatform)...)
+	require.NoError(t, err)
+
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	topCmd, topOptions := newCmdTop(options)
+	rootCmd.AddCommand(topCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return rootCmd, topOptions
+}
+
+func newIntegrationPod(integration string, name string, ready bool) *corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+			Labels: map[string]string{
+				v1.IntegrationLabel: integration,
+			},
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+		},
+	}
+}
+
+func TestTopJSON(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	cmd, options := initializeTopCmdOptions(t, &it,
+		newIntegrationPod("my-it", "my-it-1", true),
+		newIntegrationPod("my-it", "my-it-2", false),
+	)
+	options.fetch = func(pod *corev1.Pod) ([]topRouteStats, error) {
+		return []topRouteStats{{Context: "camel-1", RouteID: "route1", ExchangesTotal: 12, ExchangesFailed: 1, MeanProcessingTime: 5}}, nil
+	}
+
+	output, err := test.ExecuteCommand(cmd, "top", "my-it", "-o", "json")
+	require.NoError(t, err)
+	var stats []topRouteStats
+	require.NoError(t, json.Unmarshal([]byte(output), &stats))
+	assert.Equal(t, []topRouteStats{{
+		Integration:        "my-it",
+		Pod:                "my-it-1",
+		Context:            "camel-1",
+		RouteID:            "route1",
+		ExchangesTotal:     12,
+		ExchangesFailed:    1,
+		MeanProcessingTime: 5,
+	}}, stats)
+}
+
+func TestTopJolokiaNotEnabled(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	cmd, options := initializeTopCmdOptions(t, &it, newIntegrationPod("my-it", "my-it-1", true))
+	options.fetch = func(pod *corev1.Pod) ([]topRouteStats, error) {
+		return nil, errJolokiaNotEnabled
+	}
+
+	_, err := test.ExecuteCommand(cmd, "top", "my-it", "-o", "json")
+	require.EqualError(t, err, "unable to collect the route statistics of integration my-it, Pod my-it-1: jolokia trait not enabled")
+
+	output, err := test.ExecuteCommand(cmd, "top", "-o", "json")
+	require.NoError(t, err)
+	assert.Equal(t, "[]\n", output)
+}
+
+func TestTopInsecureFlag(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	cmd, options := initializeTopCmdOptions(t, &it)
+	options.fetch = func(pod *corev1.Pod) ([]topRouteStats, error) {
+		return nil, nil
+	}
+
+	_, err := test.ExecuteCommand(cmd, "top", "-o", "json")
+	require.NoError(t, err)
+	assert.False(t, options.Insecure)
+	_, err = test.ExecuteCommand(cmd, "top", "-o", "json", "--insecure")
+	require.NoError(t, err)
+	assert.True(t, options.Insecure)
+}
+
+func TestTopInvalidOutput(t *testing.T) {
+	cmd, _ := initializeTopCmdOptions(t)
+	_, err := test.ExecuteCommand(cmd, "top", "-o", "yaml")
+	require.EqualError(t, err, "invalid output format yaml, only json is supported")
+}
+
+func TestPrintTopStats(t *testing.T) {
+	previous := []topRouteStats{{Integration: "my-it", Pod: "my-it-1", Context: "camel-1", RouteID: "route1", ExchangesTotal: 10}}
+	stats := []topRouteStats{
+		{Integration: "my-it", Pod: "my-it-1", Context: "camel-1", RouteID: "route1", ExchangesTotal: 20, ExchangesFailed: 2, ExchangesInflight: 1, LastProcessingTime: 3, MeanProcessingTime: 4},
+		{Integration: "my-it", Pod: "my-it-1", Context: "camel-1", RouteID: "route2", ExchangesTotal: 5},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, printTopStats(&out, stats, previous, 2*time.Second))
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, []string{"my-it", "my-it-1", "route1", "20", "2", "1", "5.0", "3ms", "4ms"}, strings.Fields(lines[1]))
+	assert.Equal(t, []string{"my-it", "my-it-1", "route2", "5", "0", "0", "0s", "0s"}, strings.Fields(lines[2]))
+}
+
+func TestJolokiaRouteStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":200,"value":{` +
+			`"org.apache.camel:context=camel-1,name=\"route2\",type=routes":{"CamelId":"camel-1","RouteId":"route2","ExchangesTotal":3,"ExchangesFailed":0,"ExchangesInflight":0,"LastProcessingTime":1,"MeanProcessingTime":2},` +
+			`"org.apache.camel:context=camel-1,name=\"route1\",type=routes":{"CamelId":"camel-1","RouteId":"route1","ExchangesTotal":12,"ExchangesFailed":1,"ExchangesInflight":2,"LastProcessingTime":7,"MeanProcessingTime":5}}}`))
+	}))
+	defer server.Close()
+
+	clients := newJolokiaClients(context.TODO(), nil, nil, false)
+	clients.clients["my-it-1"] = jolokia.NewClient(server.URL+"/jolokia", false)
+
+	stats, err := clients.routeStats(newIntegrationPod("my-it", "my-it-1", true))
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	assert.Equal(t, topRouteStats{Context: "camel-1", RouteID: "route1", ExchangesTotal: 12, ExchangesFailed: 1, ExchangesInflight: 2, LastProcessingTime: 7, MeanProcessingTime: 5}, stats[0])
+	assert.Equal(t, "route2", stats[1].RouteID)
+}
+
+func TestJolokiaEndpointOf(t *testing.T) {
+	pod := newIntegrationPod("my-it", "my-it-1", true)
+	pod.Spec.Containers = []corev1.Container{{
+		Name:  "integration",
+		Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8080}, {Name: "jolokia", ContainerPort: 8778}},
+		Args:  []string{"-javaagent:dependencies/lib/main/org.jolokia.jolokia-agent-jvm-2.0.2-javaagent.jar=discoveryEnabled=false,host=*,password=secret,port=8778,protocol=https,user=admin"},
+	}}
+
+	endpoint, err := jolokiaEndpointOf(pod)
+	require.NoError(t, err)
+	assert.Equal(t, jolokiaEndpoint{port: 8778, protocol: "https", user: "admin", password: "secret"}, *endpoint)
+
+	pod.Spec.Containers[0].Ports = pod.Spec.Containers[0].Ports[:1]
+	_, err = jolokiaEndpointOf(pod)
+	require.ErrorIs(t, err, errJolokiaNotEnabled)
+}
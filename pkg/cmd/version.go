@@ -38,7 +38,9 @@ import (
 var VersionVariant = ""
 
 const (
-	infoVersion = "Version"
+	infoVersion         = "Version"
+	infoRuntimeVersion  = "Runtime version"
+	infoRegistryAddress = "Registry address"
 )
 
 func newCmdVersion(rootCmdOptions *RootCmdOptions) (*cobra.Command, *versionCmdOptions) {
@@ -144,8 +146,8 @@ func operatorInfo(ctx context.Context, c client.Client, namespace string) (map[s
 	infos["Name"] = platform.Name
 	infos["Version"] = platform.Status.Version
 	infos["Publish strategy"] = string(platform.Status.Build.PublishStrategy)
-	infos["Runtime version"] = platform.Status.Build.RuntimeVersion
-	infos["Registry address"] = platform.Status.Build.Registry.Address
+	infos[infoRuntimeVersion] = platform.Status.Build.RuntimeVersion
+	infos[infoRegistryAddress] = platform.Status.Build.Registry.Address
 	infos["Git commit"] = platform.Status.Info["gitCommit"]
 
 	catalog, err := camel.LoadCatalog(ctx, c, platform.Namespace, v1.RuntimeSpec{Version: platform.Status.Build.RuntimeVersion, Provider: platform.Status.Build.RuntimeProvider})
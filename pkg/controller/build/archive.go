@@ -0,0 +1,75 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes/log"
+)
+
+// writeTaskLogHeader separates the output of the tasks in the archived Build log.
+func writeTaskLogHeader(w io.Writer, name string) {
+	fmt.Fprintf(w, "=== %s ===\n", name)
+}
+
+// dumpPodLog collects the log of the containers which have run, or are running, the Build tasks.
+func dumpPodLog(ctx context.Context, c client.Client, pod *corev1.Pod) []byte {
+	var buf bytes.Buffer
+	var containers []corev1.ContainerStatus
+	containers = append(containers, pod.Status.InitContainerStatuses...)
+	containers = append(containers, pod.Status.ContainerStatuses...)
+
+	for _, container := range containers {
+		if container.State.Terminated == nil && container.State.Running == nil {
+			continue
+		}
+		writeTaskLogHeader(&buf, container.Name)
+		data, err := log.DumpLog(ctx, c, pod, corev1.PodLogOptions{Container: container.Name})
+		if err != nil {
+			fmt.Fprintf(&buf, "cannot retrieve the log of container %s: %v\n", container.Name, err)
+			continue
+		}
+		buf.WriteString(data)
+		if !strings.HasSuffix(data, "\n") {
+			buf.WriteString("\n")
+		}
+	}
+
+	return buf.Bytes()
+}
+
+// archiveBuildLog stores the Build log, so that it outlives the builder Pod. A failure
+// to archive the log is not a failure of the Build.
+func archiveBuildLog(ctx context.Context, c client.Client, build *v1.Build, data []byte) *v1.BuildLog {
+	archive, err := log.ArchiveBuildLog(ctx, c, build, data)
+	if err != nil {
+		Log.ForBuild(build).Error(err, "Cannot archive build log")
+		return nil
+	}
+
+	return archive
+}
@@ -0,0 +1,61 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	k8slog "github.com/apache/camel-k/v2/pkg/util/kubernetes/log"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+)
+
+func TestArchivePodBuildLog(t *testing.T) {
+	ctx := context.TODO()
+	terminated := corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1}}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "camel-k-my-build-builder",
+		},
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{{Name: "builder", State: terminated}},
+			ContainerStatuses: []corev1.ContainerStatus{{Name: "jib", State: corev1.ContainerState{
+				Waiting: &corev1.ContainerStateWaiting{},
+			}}},
+		},
+	}
+	c, err := test.NewFakeClient(pod)
+	require.NoError(t, err)
+	build := v1.NewBuild("ns", "my-build")
+
+	build.Status.Log = archiveBuildLog(ctx, c, build, dumpPodLog(ctx, c, pod))
+	require.NotNil(t, build.Status.Log)
+	assert.Equal(t, []string{"my-build-log-0"}, build.Status.Log.ConfigMaps)
+
+	data, err := k8slog.ReadBuildLog(ctx, c, build)
+	require.NoError(t, err)
+	// Only the containers which have run are archived
+	assert.Equal(t, "=== builder ===\nfake logs\n", string(data))
+}
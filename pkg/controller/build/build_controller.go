@@ -131,7 +131,12 @@ func (r *reconcileBuild) Reconcile(ctx context.Context, request reconcile.Reques
 		if k8serrors.IsNotFound(err) {
 			// Request object not found, could have been deleted after reconcile request.
 			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
-			// Return and don't requeue
+			// Stop the build routine if any, then return and don't requeue
+			if cancel, ok := routines.Load(request.Name); ok {
+				if cancel, ok := cancel.(context.CancelCauseFunc); ok {
+					cancel(errBuildDeleted)
+				}
+			}
 			return reconcile.Result{}, nil
 		}
 		// Error reading the object - requeue the request.
@@ -164,6 +169,7 @@ func (r *reconcileBuild) Reconcile(ctx context.Context, request reconcile.Reques
 			newInitializePodAction(r.reader),
 			newScheduleAction(r.reader, buildMonitor),
 			newMonitorPodAction(r.reader),
+			newCancelAction(r.reader),
 			newErrorRecoveryAction(),
 			newErrorAction(),
 		}
@@ -171,7 +177,26 @@ func (r *reconcileBuild) Reconcile(ctx context.Context, request reconcile.Reques
 		actions = []Action{
 			newInitializeRoutineAction(),
 			newScheduleAction(r.reader, buildMonitor),
-			newMonitorRoutineAction(),
+			newMonitorRoutineAction(r.reader),
+			newCancelAction(r.reader),
+			newErrorRecoveryAction(),
+			newErrorAction(),
+		}
+	case v1.BuildStrategyTekton:
+		actions = []Action{
+			newInitializeTektonAction(r.reader),
+			newScheduleAction(r.reader, buildMonitor),
+			newMonitorTektonAction(r.reader),
+			newCancelAction(r.reader),
+			newErrorRecoveryAction(),
+			newErrorAction(),
+		}
+	case v1.BuildStrategyExternal:
+		actions = []Action{
+			newInitializeRoutineAction(),
+			newScheduleAction(r.reader, buildMonitor),
+			newMonitorExternalAction(ip),
+			newCancelAction(r.reader),
 			newErrorRecoveryAction(),
 			newErrorAction(),
 		}
@@ -215,9 +240,9 @@ func (r *reconcileBuild) Reconcile(ctx context.Context, request reconcile.Reques
 		return reconcile.Result{RequeueAfter: requeueAfterDuration}, nil
 	}
 
-	if target.BuilderConfiguration().Strategy == v1.BuildStrategyPod &&
+	if strategy := target.BuilderConfiguration().Strategy; (strategy == v1.BuildStrategyPod || strategy == v1.BuildStrategyTekton || strategy == v1.BuildStrategyExternal) &&
 		(target.Status.Phase == v1.BuildPhasePending || target.Status.Phase == v1.BuildPhaseRunning) {
-		// Requeue running Build to poll Pod and signal timeout
+		// Requeue running Build to poll the Pod (resp. the PipelineRun or the external builder) and signal timeout
 		return reconcile.Result{RequeueAfter: podRequeueAfterDuration}, nil
 	}
 
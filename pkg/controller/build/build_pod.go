@@ -64,7 +64,7 @@ func newBuildPod(ctx context.Context, client client.Client, build *v1.Build) *co
 			Namespace: build.BuilderPodNamespace(),
 			Name:      buildPodName(build),
 			Labels: map[string]string{
-				"camel.apache.org/build":     build.Name,
+				v1.BuildLabel:                build.Name,
 				"camel.apache.org/component": "builder",
 			},
 			Annotations: build.BuilderConfiguration().Annotations,
@@ -229,6 +229,8 @@ func addBuildTaskToPod(ctx context.Context, client client.Client, build *v1.Buil
 	}
 
 	configureResources(taskName, build, &container)
+	addMavenCacheToContainer(build, pod, &container)
+	addMavenOfflineBundleToContainer(build, pod, &container)
 	addContainerToPod(build, container, pod)
 }
 
@@ -0,0 +1,156 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	tektonv1 "github.com/apache/camel-k/v2/pkg/apis/duck/tekton/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+)
+
+const (
+	// tektonBuildTaskName is the name of the Tekton Task running the Build tasks as steps.
+	tektonBuildTaskName = "build"
+	// tektonDigestResult is the name of the Tekton result a custom publishing task writes the image digest into.
+	tektonDigestResult = "digest"
+)
+
+// newBuildPipelineRun translates the Build into a Tekton PipelineRun. The Build tasks are run as the steps
+// of a single Tekton Task, in the same order and with the same containers as the builder Pod, so that they
+// share the build directory.
+func newBuildPipelineRun(ctx context.Context, c client.Client, build *v1.Build) *tektonv1.PipelineRun {
+	pod := newBuildPod(ctx, c, build)
+
+	var containers []corev1.Container
+	containers = append(containers, pod.Spec.InitContainers...)
+	containers = append(containers, pod.Spec.Containers...)
+	steps := make([]tektonv1.Step, 0, len(containers))
+	for _, container := range containers {
+		steps = append(steps, tektonv1.Step{
+			Name:             container.Name,
+			Image:            container.Image,
+			Command:          container.Command,
+			Args:             container.Args,
+			WorkingDir:       container.WorkingDir,
+			Env:              container.Env,
+			ComputeResources: container.Resources,
+			VolumeMounts:     container.VolumeMounts,
+			ImagePullPolicy:  container.ImagePullPolicy,
+			SecurityContext:  container.SecurityContext,
+		})
+	}
+
+	pipelineRun := tektonv1.NewPipelineRun(pod.Namespace, buildPipelineRunName(build))
+	pipelineRun.Labels = pod.Labels
+	pipelineRun.Annotations = pod.Annotations
+	pipelineRun.Spec = tektonv1.PipelineRunSpec{
+		PipelineSpec: &tektonv1.PipelineSpec{
+			Tasks: []tektonv1.PipelineTask{
+				{
+					Name: tektonBuildTaskName,
+					TaskSpec: &tektonv1.TaskSpec{
+						Steps:   steps,
+						Volumes: pod.Spec.Volumes,
+						Results: []tektonv1.TaskResult{
+							{
+								Name:        tektonDigestResult,
+								Description: "The digest of the image published by a custom publishing task",
+							},
+						},
+					},
+				},
+			},
+		},
+		Timeouts: &tektonv1.TimeoutFields{
+			Pipeline: build.Spec.Timeout.DeepCopy(),
+		},
+		TaskRunTemplate: tektonv1.PipelineTaskRunTemplate{
+			ServiceAccountName: pod.Spec.ServiceAccountName,
+			PodTemplate: &tektonv1.PodTemplate{
+				NodeSelector:    pod.Spec.NodeSelector,
+				SecurityContext: pod.Spec.SecurityContext,
+			},
+		},
+	}
+
+	return &pipelineRun
+}
+
+func getBuildPipelineRun(ctx context.Context, c ctrl.Reader, build *v1.Build) (*tektonv1.PipelineRun, error) {
+	pipelineRun := tektonv1.PipelineRun{}
+	err := c.Get(ctx, ctrl.ObjectKey{Namespace: build.BuilderPodNamespace(), Name: buildPipelineRunName(build)}, &pipelineRun)
+	if err != nil && k8serrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelineRun, nil
+}
+
+func deleteBuildPipelineRun(ctx context.Context, c ctrl.Writer, build *v1.Build) error {
+	pipelineRun := tektonv1.NewPipelineRun(build.BuilderPodNamespace(), buildPipelineRunName(build))
+
+	err := c.Delete(ctx, &pipelineRun, ctrl.PropagationPolicy(metav1.DeletePropagationBackground))
+	if err != nil && k8serrors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// getBuildTaskRun returns the TaskRun running the Build tasks, and its Pod, if any.
+func getBuildTaskRun(ctx context.Context, c ctrl.Reader, pipelineRun *tektonv1.PipelineRun) (*tektonv1.TaskRun, *corev1.Pod, error) {
+	for _, child := range pipelineRun.Status.ChildReferences {
+		if child.PipelineTaskName != tektonBuildTaskName {
+			continue
+		}
+		taskRun := tektonv1.TaskRun{}
+		if err := c.Get(ctx, ctrl.ObjectKey{Namespace: pipelineRun.Namespace, Name: child.Name}, &taskRun); err != nil {
+			if k8serrors.IsNotFound(err) {
+				return nil, nil, nil
+			}
+			return nil, nil, err
+		}
+		if taskRun.Status.PodName == "" {
+			return &taskRun, nil, nil
+		}
+		pod := corev1.Pod{}
+		if err := c.Get(ctx, ctrl.ObjectKey{Namespace: pipelineRun.Namespace, Name: taskRun.Status.PodName}, &pod); err != nil {
+			if k8serrors.IsNotFound(err) {
+				return &taskRun, nil, nil
+			}
+			return nil, nil, err
+		}
+		return &taskRun, &pod, nil
+	}
+
+	return nil, nil, nil
+}
+
+func buildPipelineRunName(build *v1.Build) string {
+	return "camel-k-" + build.Name + "-builder"
+}
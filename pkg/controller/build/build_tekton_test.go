@@ -0,0 +1,103 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	tektonv1 "github.com/apache/camel-k/v2/pkg/apis/duck/tekton/v1"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+)
+
+func TestNewBuildPipelineRun(t *testing.T) {
+	ctx := context.TODO()
+	c, err := test.NewFakeClient()
+	require.NoError(t, err)
+
+	build := newTektonBuild()
+	pipelineRun := newBuildPipelineRun(ctx, c, build)
+
+	assert.Equal(t, tektonv1.PipelineRunKind, pipelineRun.Kind)
+	assert.Equal(t, "tekton.dev/v1", pipelineRun.APIVersion)
+	assert.Equal(t, "ns", pipelineRun.Namespace)
+	assert.Equal(t, "camel-k-my-build-builder", pipelineRun.Name)
+	assert.Equal(t, "my-build", pipelineRun.Labels[v1.BuildLabel])
+	assert.Equal(t, map[string]string{"annotation": "value"}, pipelineRun.Annotations)
+	assert.Equal(t, 10*time.Minute, pipelineRun.Spec.Timeouts.Pipeline.Duration)
+	assert.Equal(t, "camel-k-builder", pipelineRun.Spec.TaskRunTemplate.ServiceAccountName)
+	assert.Equal(t, map[string]string{"node": "selector"}, pipelineRun.Spec.TaskRunTemplate.PodTemplate.NodeSelector)
+
+	require.Len(t, pipelineRun.Spec.PipelineSpec.Tasks, 1)
+	task := pipelineRun.Spec.PipelineSpec.Tasks[0]
+	assert.Equal(t, "build", task.Name)
+	require.Len(t, task.TaskSpec.Steps, 3)
+	// The Build tasks are run in order, as steps sharing the build directory
+	assert.Equal(t, "builder", task.TaskSpec.Steps[0].Name)
+	assert.Equal(t, []string{"kamel", "builder", "--namespace", "ns", "--build-name", "my-build", "--task-name", "builder"}, task.TaskSpec.Steps[0].Command)
+	assert.Equal(t, "package", task.TaskSpec.Steps[1].Name)
+	assert.Equal(t, "publish", task.TaskSpec.Steps[2].Name)
+	assert.Equal(t, "my-registry/publisher", task.TaskSpec.Steps[2].Image)
+	assert.Equal(t, []string{"publish.sh"}, task.TaskSpec.Steps[2].Command)
+	for _, step := range task.TaskSpec.Steps {
+		require.Len(t, step.VolumeMounts, 1)
+		assert.Equal(t, builderVolume, step.VolumeMounts[0].Name)
+	}
+	require.Len(t, task.TaskSpec.Volumes, 1)
+	assert.Equal(t, builderVolume, task.TaskSpec.Volumes[0].Name)
+	assert.Equal(t, []tektonv1.TaskResult{{Name: "digest", Description: "The digest of the image published by a custom publishing task"}}, task.TaskSpec.Results)
+}
+
+func newTektonBuild() *v1.Build {
+	build := v1.NewBuild("ns", "my-build")
+	conf := v1.BuildConfiguration{
+		Strategy:            v1.BuildStrategyTekton,
+		ToolImage:           "camel-k-operator",
+		BuilderPodNamespace: "ns",
+		NodeSelector:        map[string]string{"node": "selector"},
+		Annotations:         map[string]string{"annotation": "value"},
+	}
+	build.Spec.Tasks = []v1.Task{
+		{
+			Builder: &v1.BuilderTask{
+				BaseTask: v1.BaseTask{Name: "builder", Configuration: conf},
+			},
+		},
+		{
+			Package: &v1.BuilderTask{
+				BaseTask: v1.BaseTask{Name: "package", Configuration: conf},
+			},
+		},
+		{
+			Custom: &v1.UserTask{
+				BaseTask:          v1.BaseTask{Name: "publish", Configuration: conf},
+				ContainerImage:    "my-registry/publisher",
+				ContainerCommands: []string{"publish.sh"},
+				PublishingImage:   "my-registry/my-image:1",
+			},
+		},
+	}
+	build.Spec.Timeout = metav1.Duration{Duration: 10 * time.Minute}
+	return build
+}
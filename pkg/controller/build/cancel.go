@@ -0,0 +1,135 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	tektonv1 "github.com/apache/camel-k/v2/pkg/apis/duck/tekton/v1"
+	"github.com/apache/camel-k/v2/pkg/builder/external"
+	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+var (
+	errBuildCancelled = errors.New("build cancelled")
+	errBuildDeleted   = errors.New("build deleted")
+)
+
+func newCancelAction(reader ctrl.Reader) Action {
+	return &cancelAction{
+		reader: reader,
+	}
+}
+
+type cancelAction struct {
+	baseAction
+	reader ctrl.Reader
+}
+
+// Name returns a common name of the action.
+func (action *cancelAction) Name() string {
+	return "cancel"
+}
+
+// CanHandle tells whether this action can handle the build.
+func (action *cancelAction) CanHandle(build *v1.Build) bool {
+	return build.Status.Phase == v1.BuildPhaseCancelling
+}
+
+// Handle handles the builds.
+func (action *cancelAction) Handle(ctx context.Context, build *v1.Build) (*v1.Build, error) {
+	switch build.BuilderConfiguration().Strategy {
+	case v1.BuildStrategyRoutine:
+		if cancel, ok := routines.Load(build.Name); ok {
+			// Interrupt the running tasks, the routine reports the cancellation when it returns
+			if cancel, ok := cancel.(context.CancelCauseFunc); ok {
+				action.L.Info("Interrupting build routine")
+				cancel(errBuildCancelled)
+			}
+			return nil, nil
+		}
+	case v1.BuildStrategyPod:
+		pod, err := getBuilderPod(ctx, action.reader, build)
+		if err != nil {
+			return nil, err
+		}
+		if pod != nil {
+			// Keep the log of the tasks that have run
+			build.Status.Log = archiveBuildLog(ctx, action.client, build, dumpPodLog(ctx, action.client, pod))
+			if err := deleteBuilderPod(ctx, action.client, build); err != nil {
+				return nil, fmt.Errorf("cannot delete build pod: %w", err)
+			}
+		}
+	case v1.BuildStrategyTekton:
+		pipelineRun, err := getBuildPipelineRun(ctx, action.reader, build)
+		if err != nil {
+			return nil, err
+		}
+		if pipelineRun != nil && isPipelineRunCancellable(pipelineRun) {
+			// Keep the log of the tasks that have run
+			if _, pod, err := getBuildTaskRun(ctx, action.reader, pipelineRun); err == nil && pod != nil {
+				build.Status.Log = archiveBuildLog(ctx, action.client, build, dumpPodLog(ctx, action.client, pod))
+			}
+			// The PipelineRun is cancelled rather than deleted, so that it remains for auditing
+			target := pipelineRun.DeepCopy()
+			target.Spec.Status = tektonv1.PipelineRunSpecStatusCancelled
+			if err := action.client.Patch(ctx, target, ctrl.MergeFrom(pipelineRun)); err != nil {
+				return nil, fmt.Errorf("cannot cancel build pipeline run: %w", err)
+			}
+		}
+	case v1.BuildStrategyExternal:
+		ip, err := platform.GetForResource(ctx, action.client, build)
+		if err != nil {
+			return nil, err
+		}
+		if ip.Status.Build.ExternalBuilder != nil {
+			c, err := newExternalBuilderClient(ctx, action.client, ip)
+			if err != nil {
+				return nil, err
+			}
+			if err := c.Cancel(ctx, build); err != nil && !errors.Is(err, external.ErrBuildNotFound) {
+				return nil, fmt.Errorf("cannot cancel build in the external builder: %w", err)
+			}
+			// Keep the log of the tasks that have run
+			externalBuildLogs.forget(build)
+			if data, err := c.Logs(ctx, build); err == nil {
+				build.Status.Log = archiveBuildLog(ctx, action.client, build, data)
+			}
+		}
+	}
+
+	build.Status.Phase = v1.BuildPhaseCancelled
+	build.Status.Error = errBuildCancelled.Error()
+	if build.Status.StartedAt != nil {
+		duration := metav1.Now().Sub(build.Status.StartedAt.Time)
+		build.Status.Duration = duration.String()
+		// Account for the Build metrics
+		observeBuildResult(build, build.Status.Phase, kubernetes.GetCamelCreator(build), duration)
+	}
+	monitorFinishedBuild(build)
+
+	return build, nil
+}
@@ -0,0 +1,198 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/apis"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	tektonv1 "github.com/apache/camel-k/v2/pkg/apis/duck/tekton/v1"
+	"github.com/apache/camel-k/v2/pkg/builder/external"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+)
+
+func TestCancelPodBuild(t *testing.T) {
+	ctx := context.TODO()
+	build := v1.NewBuild("ns", "my-build")
+	build.Spec.Tasks = []v1.Task{{
+		Builder: &v1.BuilderTask{
+			BaseTask: v1.BaseTask{
+				Name: "builder",
+				Configuration: v1.BuildConfiguration{
+					Strategy:            v1.BuildStrategyPod,
+					BuilderPodNamespace: "ns",
+				},
+			},
+		},
+	}}
+	build.Status.Phase = v1.BuildPhaseCancelling
+	now := metav1.Now()
+	build.Status.StartedAt = &now
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "camel-k-my-build-builder",
+			Labels: map[string]string{
+				v1.BuildLabel: build.Name,
+			},
+		},
+	}
+	c, err := test.NewFakeClient(build, pod)
+	require.NoError(t, err)
+
+	a := newCancelAction(c)
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	require.True(t, a.CanHandle(build))
+
+	handled, err := a.Handle(ctx, build)
+	require.NoError(t, err)
+	require.NotNil(t, handled)
+	assert.Equal(t, v1.BuildPhaseCancelled, handled.Status.Phase)
+	assert.Equal(t, "build cancelled", handled.Status.Error)
+	assert.NotEmpty(t, handled.Status.Duration)
+	assert.NotNil(t, handled.Status.Log)
+	deleted, err := getBuilderPod(ctx, c, build)
+	require.NoError(t, err)
+	assert.Nil(t, deleted)
+}
+
+func TestCancelRoutineBuild(t *testing.T) {
+	ctx := context.TODO()
+	build := v1.NewBuild("ns", "my-routine-build")
+	build.Spec.Tasks = []v1.Task{{
+		Builder: &v1.BuilderTask{
+			BaseTask: v1.BaseTask{
+				Name: "builder",
+				Configuration: v1.BuildConfiguration{
+					Strategy: v1.BuildStrategyRoutine,
+				},
+			},
+		},
+	}}
+	build.Status.Phase = v1.BuildPhaseCancelling
+	c, err := test.NewFakeClient(build)
+	require.NoError(t, err)
+
+	a := newCancelAction(c)
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	buildCtx, cancel := context.WithCancelCause(ctx)
+	routines.Store(build.Name, cancel)
+	// The running routine reports the cancellation
+	handled, err := a.Handle(ctx, build)
+	require.NoError(t, err)
+	assert.Nil(t, handled)
+	assert.ErrorIs(t, context.Cause(buildCtx), errBuildCancelled)
+
+	// No routine is running, e.g. the operator has restarted
+	routines.Delete(build.Name)
+	handled, err = a.Handle(ctx, build)
+	require.NoError(t, err)
+	require.NotNil(t, handled)
+	assert.Equal(t, v1.BuildPhaseCancelled, handled.Status.Phase)
+}
+
+func TestCancelExternalBuild(t *testing.T) {
+	ctx := context.TODO()
+	server := test.NewExternalBuilder("")
+	defer server.Close()
+
+	build := newExternalBuild()
+	build.Status.Phase = v1.BuildPhaseCancelling
+	ip := newExternalBuilderPlatform(server.URL(), "")
+	c, err := test.NewFakeClient(build, ip)
+	require.NoError(t, err)
+	server.SetStatus("ns", "my-build", external.BuildStatus{Phase: v1.BuildPhaseRunning})
+	server.SetLog("ns", "my-build", "Downloading dependencies\n")
+
+	a := newCancelAction(c)
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	handled, err := a.Handle(ctx, build)
+	require.NoError(t, err)
+	require.NotNil(t, handled)
+	assert.Equal(t, v1.BuildPhaseCancelled, handled.Status.Phase)
+	assert.NotNil(t, handled.Status.Log)
+	assert.True(t, server.Cancelled("ns", "my-build"))
+}
+
+func TestCancelTektonBuild(t *testing.T) {
+	ctx := context.TODO()
+	build := newTektonBuild()
+	build.Status.Phase = v1.BuildPhaseCancelling
+	pipelineRun := newTektonPipelineRun(build, apis.Condition{
+		Type:   apis.ConditionSucceeded,
+		Status: corev1.ConditionUnknown,
+	})
+	c, err := test.NewFakeClient(build, pipelineRun)
+	require.NoError(t, err)
+
+	a := newCancelAction(c)
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	handled, err := a.Handle(ctx, build)
+	require.NoError(t, err)
+	require.NotNil(t, handled)
+	assert.Equal(t, v1.BuildPhaseCancelled, handled.Status.Phase)
+	cancelled, err := getBuildPipelineRun(ctx, c, build)
+	require.NoError(t, err)
+	require.NotNil(t, cancelled)
+	assert.Equal(t, tektonv1.PipelineRunSpecStatusCancelled, cancelled.Spec.Status)
+}
+
+func TestRoutineStatusUpdateDoesNotOverrideCancellation(t *testing.T) {
+	ctx := context.TODO()
+	build := v1.NewBuild("ns", "my-routine-build")
+	build.Status.Phase = v1.BuildPhaseRunning
+	c, err := test.NewFakeClient(build)
+	require.NoError(t, err)
+	require.NoError(t, c.Get(ctx, ctrl.ObjectKeyFromObject(build), build))
+
+	a := &monitorRoutineAction{reader: c}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	a.InjectRecorder(record.NewFakeRecorder(10))
+
+	// The Build is cancelled while its routine completes
+	cancelled := build.DeepCopy()
+	cancelled.Status.Phase = v1.BuildPhaseCancelling
+	require.NoError(t, c.Status().Update(ctx, cancelled))
+
+	require.NoError(t, a.updateBuildStatus(ctx, build, v1.BuildStatus{Phase: v1.BuildPhaseSucceeded}))
+	require.NoError(t, c.Get(ctx, ctrl.ObjectKeyFromObject(cancelled), cancelled))
+	assert.Equal(t, v1.BuildPhaseCancelling, cancelled.Status.Phase)
+
+	// The routine reports the cancellation
+	require.NoError(t, a.updateBuildStatus(ctx, build, v1.BuildStatus{Phase: v1.BuildPhaseCancelled}))
+	require.NoError(t, c.Get(ctx, ctrl.ObjectKeyFromObject(cancelled), cancelled))
+	assert.Equal(t, v1.BuildPhaseCancelled, cancelled.Status.Phase)
+}
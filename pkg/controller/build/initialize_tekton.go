@@ -0,0 +1,68 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+func newInitializeTektonAction(reader ctrl.Reader) Action {
+	return &initializeTektonAction{
+		reader: reader,
+	}
+}
+
+type initializeTektonAction struct {
+	baseAction
+	reader ctrl.Reader
+}
+
+// Name returns a common name of the action.
+func (action *initializeTektonAction) Name() string {
+	return "initialize-tekton"
+}
+
+// CanHandle tells whether this action can handle the build.
+func (action *initializeTektonAction) CanHandle(build *v1.Build) bool {
+	return build.Status.Phase == "" || build.Status.Phase == v1.BuildPhaseInitialization
+}
+
+// Handle handles the builds.
+func (action *initializeTektonAction) Handle(ctx context.Context, build *v1.Build) (*v1.Build, error) {
+	action.L.Info("Initializing Build")
+
+	// Delete the PipelineRun of a previous attempt, if any
+	if err := deleteBuildPipelineRun(ctx, action.client, build); err != nil {
+		return nil, fmt.Errorf("cannot delete build pipeline run: %w", err)
+	}
+
+	pipelineRun, err := getBuildPipelineRun(ctx, action.reader, build)
+	if err != nil || pipelineRun != nil {
+		// We return and wait for the PipelineRun to be deleted before de-queue the build.
+		return nil, err
+	}
+
+	build.Status.Phase = v1.BuildPhaseScheduling
+
+	return build, nil
+}
@@ -0,0 +1,121 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util/defaults"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+const (
+	mavenCacheVolume            = "camel-k-maven-cache"
+	defaultMavenCacheCapacity   = "10Gi"
+	defaultMavenCacheAccessMode = corev1.ReadWriteMany
+)
+
+// mavenCacheOf returns the Maven cache of the build, if any, along with the local repository it is mounted as.
+func mavenCacheOf(build *v1.Build) (*v1.MavenCacheSpec, string) {
+	for _, task := range build.Spec.Tasks {
+		t := task.Builder
+		if t == nil {
+			t = task.Package
+		}
+		// The offline bundle, when any, is the local repository in place of the cache
+		if t == nil || t.Maven.Cache == nil || t.Maven.OfflineBundle != nil {
+			continue
+		}
+		localRepository := t.Maven.LocalRepository
+		if localRepository == "" {
+			localRepository = defaults.LocalRepository
+		}
+
+		return t.Maven.Cache, localRepository
+	}
+
+	return nil, ""
+}
+
+// ensureMavenCache creates the PersistentVolumeClaim of the Maven cache of the build, when it does not exist.
+func ensureMavenCache(ctx context.Context, c client.Client, build *v1.Build) error {
+	cache, _ := mavenCacheOf(build)
+	if cache == nil {
+		return nil
+	}
+	pvc, err := kubernetes.LookupPersistentVolumeClaim(ctx, c, build.BuilderPodNamespace(), cache.PersistentVolumeClaim)
+	if err != nil || pvc != nil {
+		return err
+	}
+
+	capacity := cache.Capacity
+	if capacity == "" {
+		capacity = defaultMavenCacheCapacity
+	}
+	if _, err := resource.ParseQuantity(capacity); err != nil {
+		return fmt.Errorf("invalid Maven cache capacity %q: %w", capacity, err)
+	}
+	accessMode := cache.AccessMode
+	if accessMode == "" {
+		accessMode = defaultMavenCacheAccessMode
+	}
+	pvc = kubernetes.NewPersistentVolumeClaim(build.BuilderPodNamespace(), cache.PersistentVolumeClaim,
+		cache.StorageClassName, capacity, accessMode)
+	if cache.StorageClassName == "" {
+		// Use the default storage class
+		pvc.Spec.StorageClassName = nil
+	}
+	// The cache outlives the builds, so that it is not owned by any of them
+	pvc.Labels = map[string]string{
+		"app":                        "camel-k",
+		"camel.apache.org/component": "maven-cache",
+	}
+	Log.ForBuild(build).Infof("Creating Maven cache PersistentVolumeClaim %s", cache.PersistentVolumeClaim)
+
+	return c.Create(ctx, pvc)
+}
+
+// addMavenCacheToContainer mounts the Maven cache of the build, if any, as the local repository of the container.
+func addMavenCacheToContainer(build *v1.Build, pod *corev1.Pod, container *corev1.Container) {
+	cache, localRepository := mavenCacheOf(build)
+	if cache == nil {
+		return
+	}
+	if !hasVolume(pod, mavenCacheVolume) {
+		pod.Spec.Volumes = append(pod.Spec.Volumes,
+			corev1.Volume{
+				Name: mavenCacheVolume,
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: cache.PersistentVolumeClaim,
+					},
+				},
+			},
+		)
+	}
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      mavenCacheVolume,
+		MountPath: localRepository,
+	})
+}
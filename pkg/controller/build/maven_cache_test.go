@@ -0,0 +1,143 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+)
+
+func newMavenCacheBuild(cache *v1.MavenCacheSpec) *v1.Build {
+	build := v1.NewBuild("ns", "my-build")
+	build.Spec.Tasks = []v1.Task{
+		{
+			Builder: &v1.BuilderTask{
+				BaseTask: v1.BaseTask{
+					Name: "builder",
+					Configuration: v1.BuildConfiguration{
+						Strategy:            v1.BuildStrategyPod,
+						BuilderPodNamespace: "ns",
+					},
+				},
+				Maven: v1.MavenBuildSpec{
+					MavenSpec: v1.MavenSpec{
+						LocalRepository: "/etc/maven/m2",
+						Cache:           cache,
+					},
+				},
+			},
+		},
+		{
+			Custom: &v1.UserTask{
+				BaseTask: v1.BaseTask{
+					Name: "custom",
+				},
+			},
+		},
+	}
+
+	return build
+}
+
+func TestBuildPodMavenCache(t *testing.T) {
+	c, err := test.NewFakeClient()
+	require.NoError(t, err)
+	build := newMavenCacheBuild(&v1.MavenCacheSpec{PersistentVolumeClaim: "maven-cache"})
+
+	pod := newBuildPod(context.TODO(), c, build)
+
+	assert.Contains(t, pod.Spec.Volumes, corev1.Volume{
+		Name: mavenCacheVolume,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: "maven-cache",
+			},
+		},
+	})
+	mount := corev1.VolumeMount{
+		Name:      mavenCacheVolume,
+		MountPath: "/etc/maven/m2",
+	}
+	// Only the builder tasks use the cache
+	assert.Contains(t, pod.Spec.InitContainers[0].VolumeMounts, mount)
+	assert.NotContains(t, pod.Spec.Containers[0].VolumeMounts, mount)
+}
+
+func TestMavenCacheWithOfflineBundle(t *testing.T) {
+	build := newMavenCacheBuild(&v1.MavenCacheSpec{PersistentVolumeClaim: "maven-cache"})
+	build.Spec.Tasks[0].Builder.Maven.OfflineBundle = &v1.MavenOfflineBundleSpec{PersistentVolumeClaim: "maven-offline-bundle"}
+
+	cache, _ := mavenCacheOf(build)
+	assert.Nil(t, cache)
+}
+
+func TestEnsureMavenCache(t *testing.T) {
+	ctx := context.TODO()
+	c, err := test.NewFakeClient()
+	require.NoError(t, err)
+	build := newMavenCacheBuild(&v1.MavenCacheSpec{
+		PersistentVolumeClaim: "maven-cache",
+		Capacity:              "20Gi",
+	})
+
+	require.NoError(t, ensureMavenCache(ctx, c, build))
+	pvc, err := kubernetes.LookupPersistentVolumeClaim(ctx, c, "ns", "maven-cache")
+	require.NoError(t, err)
+	require.NotNil(t, pvc)
+	assert.Nil(t, pvc.Spec.StorageClassName)
+	assert.Equal(t, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}, pvc.Spec.AccessModes)
+	assert.Equal(t, resource.MustParse("20Gi"), pvc.Spec.Resources.Requests[corev1.ResourceStorage])
+	assert.Empty(t, pvc.OwnerReferences)
+
+	// The existing claim is reused
+	require.NoError(t, ensureMavenCache(ctx, c, build))
+
+	build = newMavenCacheBuild(&v1.MavenCacheSpec{
+		PersistentVolumeClaim: "invalid-cache",
+		Capacity:              "lots",
+	})
+	require.Error(t, ensureMavenCache(ctx, c, build))
+
+	require.NoError(t, ensureMavenCache(ctx, c, newMavenCacheBuild(nil)))
+}
+
+func TestObserveMavenCacheUsage(t *testing.T) {
+	hit := newMavenCacheBuild(nil)
+	hit.Labels = map[string]string{v1.IntegrationKitLayoutLabel: "cache-test"}
+	hit.Status.MavenCache = &v1.MavenCacheStatus{}
+	miss := hit.DeepCopy()
+	miss.Status.MavenCache.Downloaded = 42
+
+	observeMavenCacheUsage(hit)
+	observeMavenCacheUsage(miss)
+	observeMavenCacheUsage(miss)
+	observeMavenCacheUsage(newMavenCacheBuild(nil))
+
+	assert.InDelta(t, 1, testutil.ToFloat64(mavenCacheResult.WithLabelValues(mavenCacheHit, "cache-test")), 0)
+	assert.InDelta(t, 2, testutil.ToFloat64(mavenCacheResult.WithLabelValues(mavenCacheMiss, "cache-test")), 0)
+}
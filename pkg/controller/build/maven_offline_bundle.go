@@ -0,0 +1,68 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/maven"
+)
+
+const mavenOfflineBundleVolume = "camel-k-maven-offline-bundle"
+
+// mavenOfflineBundleOf returns the Maven offline bundle of the build, if any.
+func mavenOfflineBundleOf(build *v1.Build) *v1.MavenOfflineBundleSpec {
+	for _, task := range build.Spec.Tasks {
+		t := task.Builder
+		if t == nil {
+			t = task.Package
+		}
+		if t != nil && t.Maven.OfflineBundle != nil {
+			return t.Maven.OfflineBundle
+		}
+	}
+
+	return nil
+}
+
+// addMavenOfflineBundleToContainer mounts the Maven offline bundle of the build, if any, read-only in the container.
+func addMavenOfflineBundleToContainer(build *v1.Build, pod *corev1.Pod, container *corev1.Container) {
+	bundle := mavenOfflineBundleOf(build)
+	if bundle == nil {
+		return
+	}
+	if !hasVolume(pod, mavenOfflineBundleVolume) {
+		pod.Spec.Volumes = append(pod.Spec.Volumes,
+			corev1.Volume{
+				Name: mavenOfflineBundleVolume,
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: bundle.PersistentVolumeClaim,
+						ReadOnly:  true,
+					},
+				},
+			},
+		)
+	}
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      mavenOfflineBundleVolume,
+		MountPath: maven.OfflineBundlePath,
+		ReadOnly:  true,
+	})
+}
@@ -0,0 +1,65 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+)
+
+func TestBuildPodMavenOfflineBundle(t *testing.T) {
+	c, err := test.NewFakeClient()
+	require.NoError(t, err)
+	build := newMavenCacheBuild(nil)
+	build.Spec.Tasks[0].Builder.Maven.OfflineBundle = &v1.MavenOfflineBundleSpec{PersistentVolumeClaim: "maven-offline-bundle"}
+
+	pod := newBuildPod(context.TODO(), c, build)
+
+	assert.Contains(t, pod.Spec.Volumes, corev1.Volume{
+		Name: mavenOfflineBundleVolume,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: "maven-offline-bundle",
+				ReadOnly:  true,
+			},
+		},
+	})
+	mount := corev1.VolumeMount{
+		Name:      mavenOfflineBundleVolume,
+		MountPath: "/etc/maven/offline-bundle",
+		ReadOnly:  true,
+	}
+	assert.Contains(t, pod.Spec.InitContainers[0].VolumeMounts, mount)
+	assert.NotContains(t, pod.Spec.Containers[0].VolumeMounts, mount)
+}
+
+func TestBuildPodWithoutMavenOfflineBundle(t *testing.T) {
+	c, err := test.NewFakeClient()
+	require.NoError(t, err)
+
+	pod := newBuildPod(context.TODO(), c, newMavenCacheBuild(nil))
+
+	assert.False(t, hasVolume(pod, mavenOfflineBundleVolume))
+}
@@ -32,6 +32,9 @@ import (
 const (
 	buildResultLabel = "result"
 	buildTypeLabel   = "type"
+
+	mavenCacheHit  = "hit"
+	mavenCacheMiss = "miss"
 )
 
 var (
@@ -82,11 +85,33 @@ var (
 			buildTypeLabel,
 		},
 	)
+
+	mavenCacheResult = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "camel_k_build_maven_cache_total",
+			Help: "Camel K builds resolving their Maven dependencies from the cache (hit) or downloading some of them (miss)",
+		},
+		[]string{
+			buildResultLabel,
+			buildTypeLabel,
+		},
+	)
+
+	mavenCacheDownloads = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "camel_k_build_maven_cache_downloaded_artifacts",
+			Help:    "Camel K build Maven artifacts missing from the cache",
+			Buckets: []float64{0, 10, 50, 100, 200, 500},
+		},
+		[]string{
+			buildTypeLabel,
+		},
+	)
 )
 
 func init() {
 	// Register custom metrics with the global prometheus registry
-	metrics.Registry.MustRegister(buildDuration, buildRecovery, queueDuration)
+	metrics.Registry.MustRegister(buildDuration, buildRecovery, queueDuration, mavenCacheResult, mavenCacheDownloads)
 }
 
 func observeBuildQueueDuration(build *v1.Build, creator *corev1.ObjectReference) {
@@ -130,6 +155,22 @@ func observeBuildResult(build *v1.Build, phase v1.BuildPhase, creator *corev1.Ob
 	buildDuration.WithLabelValues(resultLabel, typeLabel).Observe(duration.Seconds())
 }
 
+func observeMavenCacheUsage(build *v1.Build) {
+	cache := build.Status.MavenCache
+	if cache == nil {
+		return
+	}
+
+	result := mavenCacheHit
+	if cache.Downloaded > 0 {
+		result = mavenCacheMiss
+	}
+	typeLabel := build.Labels[v1.IntegrationKitLayoutLabel]
+
+	mavenCacheResult.WithLabelValues(result, typeLabel).Inc()
+	mavenCacheDownloads.WithLabelValues(typeLabel).Observe(float64(cache.Downloaded))
+}
+
 func getBuildAttemptFor(build *v1.Build) (int, int) {
 	attempt := 0
 	attemptMax := math.MaxInt32
@@ -0,0 +1,228 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/builder/external"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+const (
+	externalBuilderTokenKey = "token"
+	// externalBuildLogInterval is the interval the log of the running external builds is archived at,
+	// so that it can be followed with "kamel build logs".
+	externalBuildLogInterval = 10 * time.Second
+)
+
+// externalBuildLogs tracks when the log of the running external builds has been archived last.
+var externalBuildLogs = externalBuildLogTracker{
+	archivedAt: make(map[types.UID]time.Time),
+}
+
+type externalBuildLogTracker struct {
+	lock       sync.Mutex
+	archivedAt map[types.UID]time.Time
+}
+
+// due returns whether the log of the build is due to be archived, and records it is archived now if so.
+func (t *externalBuildLogTracker) due(build *v1.Build) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if last, ok := t.archivedAt[build.UID]; ok && time.Since(last) < externalBuildLogInterval {
+		return false
+	}
+	t.archivedAt[build.UID] = time.Now()
+
+	return true
+}
+
+func (t *externalBuildLogTracker) forget(build *v1.Build) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.archivedAt, build.UID)
+}
+
+func newMonitorExternalAction(platform *v1.IntegrationPlatform) Action {
+	return &monitorExternalAction{
+		platform: platform,
+	}
+}
+
+type monitorExternalAction struct {
+	baseAction
+	platform *v1.IntegrationPlatform
+}
+
+// Name returns a common name of the action.
+func (action *monitorExternalAction) Name() string {
+	return "monitor-external"
+}
+
+// CanHandle tells whether this action can handle the build.
+func (action *monitorExternalAction) CanHandle(build *v1.Build) bool {
+	return build.Status.Phase == v1.BuildPhasePending || build.Status.Phase == v1.BuildPhaseRunning
+}
+
+// Handle handles the builds.
+func (action *monitorExternalAction) Handle(ctx context.Context, build *v1.Build) (*v1.Build, error) {
+	if action.platform.Status.Build.ExternalBuilder == nil {
+		build.Status.Phase = v1.BuildPhaseError
+		build.Status.Error = fmt.Sprintf("no external builder configured in IntegrationPlatform %s", action.platform.Name)
+		monitorFinishedBuild(build)
+		return build, nil
+	}
+	c, err := newExternalBuilderClient(ctx, action.client, action.platform)
+	if err != nil {
+		return nil, err
+	}
+
+	if build.Status.Phase == v1.BuildPhasePending {
+		if err := c.Submit(ctx, build); err != nil {
+			return nil, fmt.Errorf("cannot submit build to the external builder: %w", err)
+		}
+		build.Status.Phase = v1.BuildPhaseRunning
+		monitorRunningBuild(build)
+		return build, nil
+	}
+
+	status, err := c.Status(ctx, build)
+	if errors.Is(err, external.ErrBuildNotFound) {
+		// The external builder has lost track of the build, e.g. it has been restarted
+		build.Status.Phase = v1.BuildPhaseInterrupted
+		build.Status.Error = err.Error()
+		action.complete(ctx, c, build)
+		return build, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("cannot get build status from the external builder: %w", err)
+	}
+	build.Status.SetConditions(status.Conditions...)
+
+	switch status.Phase {
+	case v1.BuildPhasePending, v1.BuildPhaseRunning:
+		if build.Status.StartedAt != nil && time.Since(build.Status.StartedAt.Time) > build.Spec.Timeout.Duration {
+			if err := c.Cancel(ctx, build); err != nil && !errors.Is(err, external.ErrBuildNotFound) {
+				return nil, fmt.Errorf("cannot cancel timed out build in the external builder: %w", err)
+			}
+			build.Status.Phase = v1.BuildPhaseFailed
+			build.Status.Error = "External build timeout"
+			action.complete(ctx, c, build)
+		} else {
+			// Restore the running state of the build in the monitor in case of an operator restart
+			monitorRunningBuild(build)
+			action.archiveRunningLog(ctx, c, build)
+		}
+
+	case v1.BuildPhaseSucceeded:
+		build.Status.Phase = v1.BuildPhaseSucceeded
+		build.Status.Image = status.Image
+		build.Status.Digest = status.Digest
+		build.Status.ImagePlatforms = status.ImagePlatforms
+		build.Status.BaseImage = status.BaseImage
+		build.Status.RootImage = status.RootImage
+		if build.Status.Digest == "" {
+			build.Status.Phase = v1.BuildPhaseError
+			build.Status.SetCondition(
+				"ImageDigestAvailable",
+				corev1.ConditionFalse,
+				"ImageDigestAvailable",
+				"external build completed but no digest is available in its status",
+			)
+		}
+		action.complete(ctx, c, build)
+
+	case v1.BuildPhaseFailed, v1.BuildPhaseError:
+		build.Status.Phase = status.Phase
+		build.Status.Error = status.Error
+		if build.Status.Error == "" {
+			build.Status.Error = "External build failed"
+		}
+		action.complete(ctx, c, build)
+
+	default:
+		return nil, fmt.Errorf("unexpected build phase reported by the external builder: %q", status.Phase)
+	}
+
+	return build, nil
+}
+
+// archiveRunningLog periodically archives the log of the running build, so that it can be followed while
+// the build runs. A failure to retrieve the log is not a failure of the build.
+func (action *monitorExternalAction) archiveRunningLog(ctx context.Context, c *external.Client, build *v1.Build) {
+	if !externalBuildLogs.due(build) {
+		return
+	}
+	data, err := c.Logs(ctx, build)
+	if err != nil {
+		action.L.Error(err, "Cannot retrieve build log from the external builder")
+		return
+	}
+	if len(data) == 0 || (build.Status.Log != nil && build.Status.Log.Size == int64(len(data))) {
+		return
+	}
+	if archive := archiveBuildLog(ctx, action.client, build, data); archive != nil {
+		build.Status.Log = archive
+	}
+}
+
+// complete archives the log of the completed build and accounts for it.
+func (action *monitorExternalAction) complete(ctx context.Context, c *external.Client, build *v1.Build) {
+	externalBuildLogs.forget(build)
+	duration := metav1.Now().Sub(build.Status.StartedAt.Time)
+	build.Status.Duration = duration.String()
+	if data, err := c.Logs(ctx, build); err == nil {
+		build.Status.Log = archiveBuildLog(ctx, action.client, build, data)
+	} else if !errors.Is(err, external.ErrBuildNotFound) {
+		action.L.Error(err, "Cannot retrieve build log from the external builder")
+	}
+	monitorFinishedBuild(build)
+
+	// Account for the Build metrics
+	observeBuildResult(build, build.Status.Phase, kubernetes.GetCamelCreator(build), duration)
+}
+
+// newExternalBuilderClient returns a client for the external builder configured in the IntegrationPlatform.
+func newExternalBuilderClient(ctx context.Context, c client.Client, platform *v1.IntegrationPlatform) (*external.Client, error) {
+	spec := platform.Status.Build.ExternalBuilder
+	if spec == nil {
+		return nil, fmt.Errorf("no external builder configured in IntegrationPlatform %s", platform.Name)
+	}
+	token := ""
+	if spec.Secret != "" {
+		secret, err := c.CoreV1().Secrets(platform.Namespace).Get(ctx, spec.Secret, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("cannot get external builder secret %s: %w", spec.Secret, err)
+		}
+		token = string(secret.Data[externalBuilderTokenKey])
+	}
+
+	return external.NewClient(spec.URL, token), nil
+}
@@ -0,0 +1,216 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/builder/external"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+)
+
+func TestMonitorExternalBuild(t *testing.T) {
+	ctx := context.TODO()
+	server := test.NewExternalBuilder("my-token")
+	defer server.Close()
+
+	ip := newExternalBuilderPlatform(server.URL(), "builder-token")
+	build := newExternalBuild()
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "builder-token"},
+		Data:       map[string][]byte{"token": []byte("my-token")},
+	}
+	c, err := test.NewFakeClient(build, secret)
+	require.NoError(t, err)
+
+	a := newMonitorExternalAction(ip)
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	require.True(t, a.CanHandle(build))
+
+	// The build is submitted to the external builder
+	handled, err := a.Handle(ctx, build)
+	require.NoError(t, err)
+	require.NotNil(t, handled)
+	assert.Equal(t, v1.BuildPhaseRunning, handled.Status.Phase)
+	request, ok := server.Request("ns", "my-build")
+	require.True(t, ok)
+	assert.Equal(t, build.Spec.Tasks, request.Spec.Tasks)
+
+	// The progress of the build is reported, and its log is archived while it runs
+	server.SetLog("ns", "my-build", "Downloading dependencies\n")
+	server.SetStatus("ns", "my-build", external.BuildStatus{
+		Phase: v1.BuildPhaseRunning,
+		Conditions: []v1.BuildCondition{
+			{Type: "Builder", Status: corev1.ConditionTrue, Reason: "Completed"},
+		},
+	})
+	handled, err = a.Handle(ctx, handled)
+	require.NoError(t, err)
+	assert.Equal(t, v1.BuildPhaseRunning, handled.Status.Phase)
+	assert.NotNil(t, handled.Status.GetCondition("Builder"))
+	require.NotNil(t, handled.Status.Log)
+	assert.Equal(t, int64(len("Downloading dependencies\n")), handled.Status.Log.Size)
+
+	// The log is archived at most every interval while the build runs
+	server.SetLog("ns", "my-build", "Downloading dependencies\nCompiling\n")
+	handled, err = a.Handle(ctx, handled)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len("Downloading dependencies\n")), handled.Status.Log.Size)
+
+	// The result of the build is reported
+	server.SetStatus("ns", "my-build", external.BuildStatus{
+		Phase:  v1.BuildPhaseSucceeded,
+		Image:  "registry/my-image:1",
+		Digest: "sha256:1234",
+		ImagePlatforms: []v1.ImagePlatform{
+			{Platform: "linux/arm64", Digest: "sha256:5678"},
+		},
+	})
+	server.SetLog("ns", "my-build", "BUILD SUCCESS\n")
+	handled, err = a.Handle(ctx, handled)
+	require.NoError(t, err)
+	assert.Equal(t, v1.BuildPhaseSucceeded, handled.Status.Phase)
+	assert.Equal(t, "registry/my-image:1", handled.Status.Image)
+	assert.Equal(t, "sha256:1234", handled.Status.Digest)
+	assert.Equal(t, []v1.ImagePlatform{{Platform: "linux/arm64", Digest: "sha256:5678"}}, handled.Status.ImagePlatforms)
+	assert.NotEmpty(t, handled.Status.Duration)
+	require.NotNil(t, handled.Status.Log)
+	assert.Equal(t, int64(len("BUILD SUCCESS\n")), handled.Status.Log.Size)
+}
+
+func TestMonitorExternalBuildFailure(t *testing.T) {
+	ctx := context.TODO()
+	server := test.NewExternalBuilder("")
+	defer server.Close()
+
+	build := newExternalBuild()
+	build.Status.Phase = v1.BuildPhaseRunning
+	c, err := test.NewFakeClient(build)
+	require.NoError(t, err)
+
+	a := newMonitorExternalAction(newExternalBuilderPlatform(server.URL(), ""))
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	server.SetStatus("ns", "my-build", external.BuildStatus{
+		Phase: v1.BuildPhaseFailed,
+		Error: "compilation failure",
+	})
+	handled, err := a.Handle(ctx, build)
+	require.NoError(t, err)
+	assert.Equal(t, v1.BuildPhaseFailed, handled.Status.Phase)
+	assert.Equal(t, "compilation failure", handled.Status.Error)
+}
+
+func TestMonitorExternalBuildNotFound(t *testing.T) {
+	ctx := context.TODO()
+	server := test.NewExternalBuilder("")
+	defer server.Close()
+
+	build := newExternalBuild()
+	build.Status.Phase = v1.BuildPhaseRunning
+	c, err := test.NewFakeClient(build)
+	require.NoError(t, err)
+
+	a := newMonitorExternalAction(newExternalBuilderPlatform(server.URL(), ""))
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	handled, err := a.Handle(ctx, build)
+	require.NoError(t, err)
+	assert.Equal(t, v1.BuildPhase(v1.BuildPhaseInterrupted), handled.Status.Phase)
+}
+
+func TestMonitorExternalBuildTimeout(t *testing.T) {
+	ctx := context.TODO()
+	server := test.NewExternalBuilder("")
+	defer server.Close()
+
+	build := newExternalBuild()
+	build.Status.Phase = v1.BuildPhaseRunning
+	startedAt := metav1.NewTime(time.Now().Add(-time.Hour))
+	build.Status.StartedAt = &startedAt
+	c, err := test.NewFakeClient(build)
+	require.NoError(t, err)
+
+	a := newMonitorExternalAction(newExternalBuilderPlatform(server.URL(), ""))
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	server.SetStatus("ns", "my-build", external.BuildStatus{Phase: v1.BuildPhaseRunning})
+	handled, err := a.Handle(ctx, build)
+	require.NoError(t, err)
+	assert.Equal(t, v1.BuildPhaseFailed, handled.Status.Phase)
+	assert.Equal(t, "External build timeout", handled.Status.Error)
+	assert.True(t, server.Cancelled("ns", "my-build"))
+}
+
+func TestMonitorExternalBuildWithoutExternalBuilder(t *testing.T) {
+	build := newExternalBuild()
+	c, err := test.NewFakeClient(build)
+	require.NoError(t, err)
+
+	a := newMonitorExternalAction(newExternalBuilderPlatform("", ""))
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	handled, err := a.Handle(context.TODO(), build)
+	require.NoError(t, err)
+	assert.Equal(t, v1.BuildPhaseError, handled.Status.Phase)
+}
+
+func newExternalBuilderPlatform(url string, secret string) *v1.IntegrationPlatform {
+	ip := v1.NewIntegrationPlatform("ns", "camel-k")
+	if url != "" {
+		ip.Status.Build.ExternalBuilder = &v1.ExternalBuilderSpec{
+			URL:    url,
+			Secret: secret,
+		}
+	}
+	return &ip
+}
+
+func newExternalBuild() *v1.Build {
+	build := v1.NewBuild("ns", "my-build")
+	build.Spec.Tasks = []v1.Task{{
+		Builder: &v1.BuilderTask{
+			BaseTask: v1.BaseTask{
+				Name: "builder",
+				Configuration: v1.BuildConfiguration{
+					Strategy: v1.BuildStrategyExternal,
+				},
+			},
+			Dependencies: []string{"camel:timer"},
+		},
+	}}
+	build.Spec.Timeout = metav1.Duration{Duration: 10 * time.Minute}
+	build.Status.Phase = v1.BuildPhasePending
+	now := metav1.Now()
+	build.Status.StartedAt = &now
+	return build
+}
@@ -73,6 +73,9 @@ func (action *monitorPodAction) Handle(ctx context.Context, build *v1.Build) (*v
 		switch build.Status.Phase {
 
 		case v1.BuildPhasePending:
+			if err = ensureMavenCache(ctx, action.client, build); err != nil {
+				return nil, fmt.Errorf("cannot create Maven cache: %w", err)
+			}
 			pod = newBuildPod(ctx, action.client, build)
 			// If the Builder Pod is in the Build namespace, we can set the ownership to it. If not (global operator mode)
 			// we set the ownership to the Operator Pod instead
@@ -125,6 +128,7 @@ func (action *monitorPodAction) Handle(ctx context.Context, build *v1.Build) (*v
 			// Monitor running state of the build - this may have been done already by the schedule action but the build monitor is idempotent
 			// We do this here to potentially restore the running build state in the monitor in case of an operator restart
 			monitorRunningBuild(build)
+			action.setConditionsFromCompletedTasks(pod, &build.Status)
 		}
 
 	case corev1.PodSucceeded:
@@ -138,11 +142,13 @@ func (action *monitorPodAction) Handle(ctx context.Context, build *v1.Build) (*v
 		duration := finishedAt.Sub(build.Status.StartedAt.Time)
 		build.Status.Duration = duration.String()
 		action.setConditionsFromTerminationMessages(ctx, pod, &build.Status)
+		build.Status.Log = archiveBuildLog(ctx, action.client, build, dumpPodLog(ctx, action.client, pod))
 		monitorFinishedBuild(build)
 
 		buildCreator := kubernetes.GetCamelCreator(build)
 		// Account for the Build metrics
 		observeBuildResult(build, build.Status.Phase, buildCreator, duration)
+		observeMavenCacheUsage(build)
 
 		build.Status.Image = publishTaskImageName(build.Spec.Tasks)
 		// operator supported publishing tasks should provide the digest in the builder command process execution
@@ -182,11 +188,13 @@ func (action *monitorPodAction) Handle(ctx context.Context, build *v1.Build) (*v
 		duration := finishedAt.Sub(build.Status.StartedAt.Time)
 		build.Status.Duration = duration.String()
 		action.setConditionsFromTerminationMessages(ctx, pod, &build.Status)
+		build.Status.Log = archiveBuildLog(ctx, action.client, build, dumpPodLog(ctx, action.client, pod))
 		monitorFinishedBuild(build)
 
 		buildCreator := kubernetes.GetCamelCreator(build)
 		// Account for the Build metrics
 		observeBuildResult(build, build.Status.Phase, buildCreator, duration)
+		observeMavenCacheUsage(build)
 	}
 
 	return build, nil
@@ -339,6 +347,21 @@ func (action *monitorPodAction) setConditionsFromTerminationMessages(ctx context
 
 }
 
+// setConditionsFromCompletedTasks reports the tasks that have already completed while the builder Pod is still running,
+// so that the build progress can be tracked.
+func (action *monitorPodAction) setConditionsFromCompletedTasks(pod *corev1.Pod, buildStatus *v1.BuildStatus) {
+	for _, container := range pod.Status.InitContainerStatuses {
+		if t := container.State.Terminated; t != nil && t.ExitCode == 0 {
+			containerConditionType := v1.BuildConditionType(fmt.Sprintf("Container%sSucceeded", container.Name))
+			if buildStatus.GetCondition(containerConditionType) != nil {
+				continue
+			}
+			terminationReason := fmt.Sprintf("%s (%d)", t.Reason, t.ExitCode)
+			buildStatus.SetCondition(containerConditionType, corev1.ConditionTrue, terminationReason, t.Message)
+		}
+	}
+}
+
 // we expect that the last task is any of the supported publishing task
 // or a custom user task.
 func publishTask(tasks []v1.Task) *v1.Task {
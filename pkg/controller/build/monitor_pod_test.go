@@ -0,0 +1,65 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+func TestSetConditionsFromCompletedTasks(t *testing.T) {
+	build := v1.NewBuild("ns", "my-build")
+	pod := &corev1.Pod{
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			InitContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: "builder",
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{Reason: "Completed"},
+					},
+				},
+				{
+					Name: "quarkus-native",
+					State: corev1.ContainerState{
+						Running: &corev1.ContainerStateRunning{},
+					},
+				},
+			},
+		},
+	}
+
+	action := &monitorPodAction{}
+	action.setConditionsFromCompletedTasks(pod, &build.Status)
+
+	condition := build.Status.GetCondition("ContainerbuilderSucceeded")
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, "Completed (0)", condition.Reason)
+	assert.Nil(t, build.Status.GetCondition("Containerquarkus-nativeSucceeded"))
+	assert.Len(t, build.Status.Conditions, 1)
+
+	// completed tasks are reported only once
+	action.setConditionsFromCompletedTasks(pod, &build.Status)
+	assert.Len(t, build.Status.Conditions, 1)
+}
@@ -25,6 +25,7 @@ import (
 	"path/filepath"
 	"sync"
 
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -34,17 +35,24 @@ import (
 	"github.com/apache/camel-k/v2/pkg/builder"
 	"github.com/apache/camel-k/v2/pkg/event"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes/log"
+	"github.com/apache/camel-k/v2/pkg/util/maven"
 	"github.com/apache/camel-k/v2/pkg/util/patch"
 )
 
 var routines sync.Map
 
-func newMonitorRoutineAction() Action {
-	return &monitorRoutineAction{}
+const maxStatusUpdateAttempts = 3
+
+func newMonitorRoutineAction(reader ctrl.Reader) Action {
+	return &monitorRoutineAction{
+		reader: reader,
+	}
 }
 
 type monitorRoutineAction struct {
 	baseAction
+	reader ctrl.Reader
 }
 
 // Name returns a common name of the action.
@@ -74,10 +82,15 @@ func (action *monitorRoutineAction) Handle(ctx context.Context, build *v1.Build)
 		if err := action.updateBuildStatus(ctx, build, status); err != nil {
 			return nil, err
 		}
-		// Start the build asynchronously to avoid blocking the reconciliation loop
-		routines.Store(build.Name, true)
+		// Start the build asynchronously to avoid blocking the reconciliation loop.
+		// The routine context is canceled when the Build is cancelled.
+		buildCtx, cancel := context.WithCancelCause(ctx)
+		routines.Store(build.Name, cancel)
 
-		go action.runBuild(ctx, build)
+		go func() {
+			defer cancel(nil)
+			action.runBuild(ctx, buildCtx, build)
+		}()
 
 	case v1.BuildPhaseRunning:
 		if _, ok := routines.Load(build.Name); !ok {
@@ -97,12 +110,17 @@ func (action *monitorRoutineAction) Handle(ctx context.Context, build *v1.Build)
 }
 
 //nolint:nestif
-func (action *monitorRoutineAction) runBuild(ctx context.Context, build *v1.Build) {
+func (action *monitorRoutineAction) runBuild(ctx context.Context, buildCtx context.Context, build *v1.Build) {
 	defer routines.Delete(build.Name)
 
-	ctxWithTimeout, cancel := context.WithDeadline(ctx, build.Status.StartedAt.Add(build.Spec.Timeout.Duration))
+	ctxWithTimeout, cancel := context.WithDeadline(buildCtx, build.Status.StartedAt.Add(build.Spec.Timeout.Duration))
 	defer cancel()
 
+	// Capture the tail of the Maven output of the tasks, to archive the Build log
+	buildLog := log.NewTailBuffer(log.BuildLogBufferSize)
+	defer buildLog.Reset()
+	ctxWithTimeout = maven.WithLogWriter(ctxWithTimeout, buildLog)
+
 	status := v1.BuildStatus{}
 	buildDir := ""
 	Builder := builder.New(action.client)
@@ -165,7 +183,11 @@ tasks:
 			}
 
 			// Execute the task
+			writeTaskLogHeader(buildLog, v1.TaskName(task))
 			status = Builder.Build(build).Task(task).Do(ctxWithTimeout)
+			if status.Error != "" {
+				fmt.Fprintln(buildLog, status.Error)
+			}
 
 			lastTask := i == len(build.Spec.Tasks)-1
 			taskFailed := status.Phase == v1.BuildPhaseFailed ||
@@ -189,8 +211,20 @@ tasks:
 		}
 	}
 
+	if errors.Is(context.Cause(buildCtx), errBuildDeleted) {
+		// Nothing left to report
+		monitorFinishedBuild(build)
+		return
+	}
+	if errors.Is(context.Cause(buildCtx), errBuildCancelled) {
+		// The running task may have failed as a consequence of the cancellation
+		status.Phase = v1.BuildPhaseCancelled
+		status.Error = errBuildCancelled.Error()
+	}
+
 	duration := metav1.Now().Sub(build.Status.StartedAt.Time)
 	status.Duration = duration.String()
+	status.Log = archiveBuildLog(ctx, action.client, build, buildLog.Bytes())
 
 	monitorFinishedBuild(build)
 
@@ -202,12 +236,42 @@ tasks:
 }
 
 func (action *monitorRoutineAction) updateBuildStatus(ctx context.Context, build *v1.Build, status v1.BuildStatus) error {
+	var err error
+	// The Build may be cancelled concurrently, retry while the status update conflicts with the cancellation
+	for attempt := 0; attempt < maxStatusUpdateAttempts; attempt++ {
+		err = action.tryUpdateBuildStatus(ctx, build, status)
+		if !k8serrors.IsConflict(err) {
+			break
+		}
+	}
+
+	return err
+}
+
+func (action *monitorRoutineAction) tryUpdateBuildStatus(ctx context.Context, build *v1.Build, status v1.BuildStatus) error {
+	// Re-read the phase, so that a cancellation requested while the routine runs is not overwritten
+	current := v1.NewBuild(build.Namespace, build.Name)
+	if err := action.reader.Get(ctx, ctrl.ObjectKeyFromObject(current), current); err != nil {
+		return err
+	}
+	if isCancellation(current.Status.Phase) && status.Phase != v1.BuildPhaseCancelled {
+		action.L.Info("Build is being cancelled, skipping the status update", "phase", status.Phase)
+		return nil
+	}
+
 	target := build.DeepCopy()
 	target.Status = status
 	// Copy the failure field from the build to persist recovery state
 	target.Status.Failure = build.Status.Failure
 	// Patch the build status with the result
 	p, err := patch.MergePatch(build, target)
+	if err == nil && len(p) > 0 {
+		// The patch is rejected if the Build has changed since its phase has been read
+		base := build.DeepCopy()
+		base.ResourceVersion = ""
+		target.ResourceVersion = current.ResourceVersion
+		p, err = patch.MergePatch(base, target)
+	}
 	if err != nil {
 		action.L.Errorf(err, "Cannot patch build status: %s", build.Name)
 		event.NotifyBuildError(ctx, action.client, action.recorder, build, target, err)
@@ -232,7 +296,12 @@ func (action *monitorRoutineAction) updateBuildStatus(ctx context.Context, build
 		}
 		event.NotifyBuildUpdated(ctx, action.client, action.recorder, build, target)
 		build.Status = target.Status
+		build.ResourceVersion = target.ResourceVersion
 	}
 
 	return nil
 }
+
+func isCancellation(phase v1.BuildPhase) bool {
+	return phase == v1.BuildPhaseCancelling || phase == v1.BuildPhaseCancelled
+}
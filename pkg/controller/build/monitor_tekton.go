@@ -0,0 +1,242 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	tektonv1 "github.com/apache/camel-k/v2/pkg/apis/duck/tekton/v1"
+	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes/log"
+)
+
+func newMonitorTektonAction(reader ctrl.Reader) Action {
+	return &monitorTektonAction{
+		reader: reader,
+	}
+}
+
+type monitorTektonAction struct {
+	baseAction
+	reader ctrl.Reader
+}
+
+// Name returns a common name of the action.
+func (action *monitorTektonAction) Name() string {
+	return "monitor-tekton"
+}
+
+// CanHandle tells whether this action can handle the build.
+func (action *monitorTektonAction) CanHandle(build *v1.Build) bool {
+	return build.Status.Phase == v1.BuildPhasePending || build.Status.Phase == v1.BuildPhaseRunning
+}
+
+// Handle handles the builds.
+func (action *monitorTektonAction) Handle(ctx context.Context, build *v1.Build) (*v1.Build, error) {
+	pipelineRun, err := getBuildPipelineRun(ctx, action.reader, build)
+	if err != nil {
+		return nil, err
+	}
+
+	if pipelineRun == nil {
+		switch build.Status.Phase {
+		case v1.BuildPhasePending:
+			return nil, action.createPipelineRun(ctx, build)
+		case v1.BuildPhaseRunning:
+			// Emulate context cancellation
+			build.Status.Phase = v1.BuildPhaseInterrupted
+			build.Status.Error = "PipelineRun deleted"
+			monitorFinishedBuild(build)
+			return build, nil
+		}
+	}
+
+	taskRun, pod, err := getBuildTaskRun(ctx, action.reader, pipelineRun)
+	if err != nil {
+		return nil, err
+	}
+
+	succeeded := pipelineRun.Status.GetCondition(apis.ConditionSucceeded)
+	switch {
+	case succeeded == nil || succeeded.IsUnknown():
+		if pipelineRun.Status.StartTime != nil {
+			build.Status.Phase = v1.BuildPhaseRunning
+		}
+		// Monitor running state of the build - this may have been done already by the schedule action but the build monitor is idempotent
+		// We do this here to potentially restore the running build state in the monitor in case of an operator restart
+		monitorRunningBuild(build)
+		action.setConditionsFromSteps(ctx, taskRun, pod, &build.Status, true)
+		return build, nil
+
+	case succeeded.IsTrue():
+		build.Status.Phase = v1.BuildPhaseSucceeded
+		build.Status.Image = publishTaskImageName(build.Spec.Tasks)
+		// operator supported publishing tasks should provide the digest in the builder command process execution
+		if !operatorSupportedPublishingStrategy(build.Spec.Tasks) {
+			build.Status.Digest = taskRunResult(taskRun, tektonDigestResult)
+			if build.Status.Digest == "" {
+				// Likely to happen for users provided publishing tasks and not providing the digest image among results
+				build.Status.Phase = v1.BuildPhaseError
+				build.Status.SetCondition(
+					"ImageDigestAvailable",
+					corev1.ConditionFalse,
+					"ImageDigestAvailable",
+					fmt.Sprintf(
+						"%s publishing task completed but no digest is available in the Tekton results. Make sure that the process successfully push the image to the registry and write its digest to $(results.%s.path)",
+						publishTaskName(build.Spec.Tasks),
+						tektonDigestResult,
+					),
+				)
+			}
+		}
+
+	default:
+		phase := v1.BuildPhaseFailed
+		message := fmt.Sprintf("PipelineRun %s failed: %s", pipelineRun.Name, succeeded.Message)
+		switch succeeded.Reason {
+		case tektonv1.PipelineRunReasonTimedOut:
+			message = fmt.Sprintf("PipelineRun %s timeout", pipelineRun.Name)
+		case tektonv1.PipelineRunReasonCancelled:
+			phase = v1.BuildPhaseInterrupted
+			message = fmt.Sprintf("PipelineRun %s cancelled", pipelineRun.Name)
+		}
+		// Do not override errored build
+		if build.Status.Phase == v1.BuildPhaseError {
+			phase = v1.BuildPhaseError
+		}
+		build.Status.Phase = phase
+		build.Status.Error = message
+	}
+
+	finishedAt := metav1.Now()
+	if pipelineRun.Status.CompletionTime != nil {
+		finishedAt = *pipelineRun.Status.CompletionTime
+	}
+	duration := finishedAt.Sub(build.Status.StartedAt.Time)
+	build.Status.Duration = duration.String()
+	action.setConditionsFromSteps(ctx, taskRun, pod, &build.Status, false)
+	if pod != nil {
+		build.Status.Log = archiveBuildLog(ctx, action.client, build, dumpPodLog(ctx, action.client, pod))
+	}
+	monitorFinishedBuild(build)
+
+	buildCreator := kubernetes.GetCamelCreator(build)
+	// Account for the Build metrics
+	observeBuildResult(build, build.Status.Phase, buildCreator, duration)
+	observeMavenCacheUsage(build)
+
+	return build, nil
+}
+
+func (action *monitorTektonAction) createPipelineRun(ctx context.Context, build *v1.Build) error {
+	if err := ensureMavenCache(ctx, action.client, build); err != nil {
+		return fmt.Errorf("cannot create Maven cache: %w", err)
+	}
+	pipelineRun := newBuildPipelineRun(ctx, action.client, build)
+	// If the PipelineRun is in the Build namespace, we can set the ownership to it. If not (global operator mode)
+	// we set the ownership to the Operator Pod instead
+	var owner metav1.Object
+	owner = build
+	if build.Namespace != pipelineRun.Namespace {
+		operatorPod := platform.GetOperatorPod(ctx, action.client, pipelineRun.Namespace)
+		if operatorPod != nil {
+			owner = operatorPod
+		}
+	}
+	if err := controllerutil.SetControllerReference(owner, pipelineRun, action.client.GetScheme()); err != nil {
+		return err
+	}
+
+	if err := action.client.Create(ctx, pipelineRun); err != nil {
+		return fmt.Errorf("cannot create build pipeline run: %w", err)
+	}
+
+	return nil
+}
+
+// setConditionsFromSteps sets a condition for the steps which have terminated. When the build is running, only the
+// steps which have successfully completed are reported, so that the build progress can be tracked.
+func (action *monitorTektonAction) setConditionsFromSteps(ctx context.Context, taskRun *tektonv1.TaskRun, pod *corev1.Pod, buildStatus *v1.BuildStatus, completedOnly bool) {
+	if taskRun == nil {
+		return
+	}
+	for _, step := range taskRun.Status.Steps {
+		t := step.Terminated
+		if t == nil || (completedOnly && t.ExitCode != 0) {
+			continue
+		}
+		// Dynamic condition type (it depends on each step name), consistent with the builder Pod containers
+		conditionType := v1.BuildConditionType(fmt.Sprintf("Container%sSucceeded", step.Name))
+		if completedOnly && buildStatus.GetCondition(conditionType) != nil {
+			continue
+		}
+		status := corev1.ConditionTrue
+		message := ""
+		if t.ExitCode != 0 {
+			status = corev1.ConditionFalse
+			message = action.stepLogTail(ctx, pod, step)
+		}
+		buildStatus.SetCondition(conditionType, status, fmt.Sprintf("%s (%d)", t.Reason, t.ExitCode), message)
+	}
+}
+
+// stepLogTail returns the last lines of the log of a failed step.
+func (action *monitorTektonAction) stepLogTail(ctx context.Context, pod *corev1.Pod, step tektonv1.StepState) string {
+	if pod != nil {
+		var maxLines int64 = 10
+		message, err := log.DumpLog(ctx, action.client, pod, corev1.PodLogOptions{
+			Container: step.Container,
+			TailLines: &maxLines,
+		})
+		if err == nil {
+			return message
+		}
+		action.L.Errorf(err, "Dumping log for %s step in %s Pod failed", step.Name, pod.Name)
+	}
+
+	return fmt.Sprintf("Operator was not able to retrieve the error message, please, check the log of the %s step", step.Name)
+}
+
+func taskRunResult(taskRun *tektonv1.TaskRun, name string) string {
+	if taskRun == nil {
+		return ""
+	}
+	for _, result := range taskRun.Status.Results {
+		if result.Name == name {
+			return result.Value
+		}
+	}
+
+	return ""
+}
+
+// isPipelineRunCancellable tells whether the PipelineRun is still running.
+func isPipelineRunCancellable(pipelineRun *tektonv1.PipelineRun) bool {
+	succeeded := pipelineRun.Status.GetCondition(apis.ConditionSucceeded)
+	return pipelineRun.Spec.Status != tektonv1.PipelineRunSpecStatusCancelled && (succeeded == nil || succeeded.IsUnknown())
+}
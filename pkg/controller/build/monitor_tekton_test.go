@@ -0,0 +1,188 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	tektonv1 "github.com/apache/camel-k/v2/pkg/apis/duck/tekton/v1"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+)
+
+func TestMonitorTektonBuildCreatesPipelineRun(t *testing.T) {
+	ctx := context.TODO()
+	build := newTektonBuild()
+	build.Status.Phase = v1.BuildPhasePending
+	now := metav1.Now()
+	build.Status.StartedAt = &now
+	c, err := test.NewFakeClient(build)
+	require.NoError(t, err)
+
+	a := newMonitorTektonAction(c)
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	require.True(t, a.CanHandle(build))
+
+	handled, err := a.Handle(ctx, build)
+	require.NoError(t, err)
+	assert.Nil(t, handled)
+
+	pipelineRun, err := getBuildPipelineRun(ctx, c, build)
+	require.NoError(t, err)
+	require.NotNil(t, pipelineRun)
+	require.Len(t, pipelineRun.OwnerReferences, 1)
+	assert.Equal(t, build.Name, pipelineRun.OwnerReferences[0].Name)
+}
+
+func TestMonitorTektonBuildRunning(t *testing.T) {
+	ctx := context.TODO()
+	build := newTektonBuild()
+	build.Status.Phase = v1.BuildPhasePending
+	now := metav1.Now()
+	build.Status.StartedAt = &now
+	pipelineRun := newTektonPipelineRun(build, apis.Condition{
+		Type:   apis.ConditionSucceeded,
+		Status: corev1.ConditionUnknown,
+		Reason: "Running",
+	})
+	taskRun := newTektonTaskRun(build, tektonv1.StepState{
+		Name:      "builder",
+		Container: "step-builder",
+		ContainerState: corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed"},
+		},
+	}, tektonv1.StepState{
+		Name:      "package",
+		Container: "step-package",
+		ContainerState: corev1.ContainerState{
+			Running: &corev1.ContainerStateRunning{},
+		},
+	})
+	c, err := test.NewFakeClient(build, pipelineRun, taskRun)
+	require.NoError(t, err)
+
+	a := newMonitorTektonAction(c)
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	handled, err := a.Handle(ctx, build)
+	require.NoError(t, err)
+	require.NotNil(t, handled)
+	assert.Equal(t, v1.BuildPhaseRunning, handled.Status.Phase)
+	condition := handled.Status.GetCondition("ContainerbuilderSucceeded")
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Nil(t, handled.Status.GetCondition("ContainerpackageSucceeded"))
+}
+
+func TestMonitorTektonBuildSucceeded(t *testing.T) {
+	ctx := context.TODO()
+	build := newTektonBuild()
+	build.Status.Phase = v1.BuildPhaseRunning
+	now := metav1.Now()
+	build.Status.StartedAt = &now
+	pipelineRun := newTektonPipelineRun(build, apis.Condition{
+		Type:   apis.ConditionSucceeded,
+		Status: corev1.ConditionTrue,
+		Reason: "Succeeded",
+	})
+	pipelineRun.Status.CompletionTime = &now
+	taskRun := newTektonTaskRun(build)
+	taskRun.Status.Results = []tektonv1.TaskRunResult{{Name: "digest", Value: "sha256:1234"}}
+	c, err := test.NewFakeClient(build, pipelineRun, taskRun)
+	require.NoError(t, err)
+
+	a := newMonitorTektonAction(c)
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	handled, err := a.Handle(ctx, build)
+	require.NoError(t, err)
+	require.NotNil(t, handled)
+	assert.Equal(t, v1.BuildPhaseSucceeded, handled.Status.Phase)
+	assert.Equal(t, "my-registry/my-image:1", handled.Status.Image)
+	assert.Equal(t, "sha256:1234", handled.Status.Digest)
+	assert.NotEmpty(t, handled.Status.Duration)
+}
+
+func TestMonitorTektonBuildTimeout(t *testing.T) {
+	ctx := context.TODO()
+	build := newTektonBuild()
+	build.Status.Phase = v1.BuildPhaseRunning
+	now := metav1.Now()
+	build.Status.StartedAt = &now
+	pipelineRun := newTektonPipelineRun(build, apis.Condition{
+		Type:    apis.ConditionSucceeded,
+		Status:  corev1.ConditionFalse,
+		Reason:  tektonv1.PipelineRunReasonTimedOut,
+		Message: "PipelineRun timed out",
+	})
+	c, err := test.NewFakeClient(build, pipelineRun)
+	require.NoError(t, err)
+
+	a := newMonitorTektonAction(c)
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	handled, err := a.Handle(ctx, build)
+	require.NoError(t, err)
+	require.NotNil(t, handled)
+	assert.Equal(t, v1.BuildPhaseFailed, handled.Status.Phase)
+	assert.Equal(t, "PipelineRun camel-k-my-build-builder timeout", handled.Status.Error)
+}
+
+func newTektonPipelineRun(build *v1.Build, succeeded apis.Condition) *tektonv1.PipelineRun {
+	pipelineRun := tektonv1.NewPipelineRun(build.BuilderPodNamespace(), buildPipelineRunName(build))
+	startTime := metav1.Now()
+	pipelineRun.Status = tektonv1.PipelineRunStatus{
+		Status: duckv1.Status{
+			Conditions: duckv1.Conditions{succeeded},
+		},
+		StartTime: &startTime,
+		ChildReferences: []tektonv1.ChildStatusReference{
+			{Kind: "TaskRun", Name: pipelineRun.Name + "-build", PipelineTaskName: "build"},
+		},
+	}
+	return &pipelineRun
+}
+
+func newTektonTaskRun(build *v1.Build, steps ...tektonv1.StepState) *tektonv1.TaskRun {
+	return &tektonv1.TaskRun{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: tektonv1.SchemeGroupVersion.String(),
+			Kind:       "TaskRun",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: build.BuilderPodNamespace(),
+			Name:      buildPipelineRunName(build) + "-build",
+		},
+		Status: tektonv1.TaskRunStatus{
+			Steps: steps,
+		},
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util/audit"
+)
+
+// auditTrail records the audit entries of the Integration changes when they are reconciled. The most recent audited
+// generation is persisted in the Integration status, so that the changes are not recorded again when the Integrations
+// are reconciled after the operator restarts.
+type auditTrail struct {
+	lock sync.Mutex
+	// observed holds the last version of the Integrations seen by the reconciliation, to summarize their changes and
+	// record their deletion
+	observed map[types.NamespacedName]*v1.Integration
+}
+
+var trail = auditTrail{
+	observed: make(map[types.NamespacedName]*v1.Integration),
+}
+
+// observe records the changes of the Integration that are not audited yet, i.e. its creation, its spec changes and its
+// rebuild, and persists its audited generation.
+func (t *auditTrail) observe(ctx context.Context, c client.Client, it *v1.Integration) error {
+	if !audit.Enabled() {
+		return nil
+	}
+	key := types.NamespacedName{Namespace: it.Namespace, Name: it.Name}
+	t.lock.Lock()
+	previous := t.observed[key]
+	t.observed[key] = it.DeepCopy()
+	t.lock.Unlock()
+
+	entries := make([]audit.Entry, 0)
+	// The rebuilds keep the audited generation, and are only known from the previous version of the Integration
+	if previous != nil && previous.Status.Phase != v1.IntegrationPhaseNone && it.Status.Phase == v1.IntegrationPhaseNone {
+		entries = append(entries, newAuditEntry(it, audit.ActionRebuilt, nil))
+	}
+	switch {
+	case it.Status.AuditedGeneration == it.Generation:
+	case it.Status.AuditedGeneration == 0 && it.Status.ObservedGeneration == 0:
+		entries = append(entries, newAuditEntry(it, audit.ActionCreated, nil))
+	case it.Status.AuditedGeneration == 0 && it.Status.ObservedGeneration == it.Generation:
+		// The Integration has been reconciled before the audit trail was enabled
+	default:
+		// The changes made while the operator was not running cannot be summarized
+		var changes []string
+		if previous != nil && previous.Generation == it.Status.AuditedGeneration {
+			changes = audit.Changes(previous, it)
+		}
+		entries = append(entries, newAuditEntry(it, audit.ActionUpdated, changes))
+	}
+	for _, entry := range entries {
+		t.record(ctx, key, entry)
+	}
+
+	if it.Status.AuditedGeneration == it.Generation {
+		return nil
+	}
+	target := it.DeepCopy()
+	target.Status.AuditedGeneration = it.Generation
+	if err := c.Status().Patch(ctx, target, ctrl.MergeFrom(it)); err != nil {
+		return err
+	}
+	target.DeepCopyInto(it)
+
+	return nil
+}
+
+// reconciled records the digest of the Integration, once its changes are reconciled, that is when the generation
+// observed by the reconciliation changes.
+func (t *auditTrail) reconciled(ctx context.Context, base *v1.Integration, it *v1.Integration) {
+	if !audit.Enabled() || it.Status.ObservedGeneration == base.Status.ObservedGeneration || it.Status.Digest == "" {
+		return
+	}
+	key := types.NamespacedName{Namespace: it.Namespace, Name: it.Name}
+	t.record(ctx, key, audit.Entry{
+		Action:      audit.ActionReconciled,
+		Namespace:   it.Namespace,
+		Integration: it.Name,
+		Generation:  it.Status.ObservedGeneration,
+		Digest:      it.Status.Digest,
+	})
+}
+
+// deleted records the deletion of the Integration, when it has been seen by the reconciliation since the operator
+// started.
+func (t *auditTrail) deleted(ctx context.Context, key types.NamespacedName) {
+	t.lock.Lock()
+	previous, ok := t.observed[key]
+	delete(t.observed, key)
+	t.lock.Unlock()
+	if !ok || !audit.Enabled() {
+		return
+	}
+
+	t.record(ctx, key, newAuditEntry(previous, audit.ActionDeleted, nil))
+}
+
+func (t *auditTrail) record(ctx context.Context, key types.NamespacedName, entry audit.Entry) {
+	if err := audit.Record(ctx, entry); err != nil {
+		Log.WithValues("request-namespace", key.Namespace, "request-name", key.Name).
+			Error(err, "unable to record the audit entry")
+	}
+}
+
+func newAuditEntry(it *v1.Integration, action audit.Action, changes []string) audit.Entry {
+	return audit.Entry{
+		Action:      action,
+		Namespace:   it.Namespace,
+		Integration: it.Name,
+		Generation:  it.Generation,
+		User:        audit.Author(it),
+		Changes:     changes,
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util/audit"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+)
+
+func TestAuditTrail(t *testing.T) {
+	var buf bytes.Buffer
+	audit.SetSink(audit.NewWriterSink(&buf))
+	defer audit.SetSink(nil)
+	ctx := context.TODO()
+	key := types.NamespacedName{Namespace: "ns", Name: "my-it"}
+
+	it := v1.NewIntegration("ns", "my-it")
+	it.Generation = 1
+	it.Annotations = map[string]string{v1.IntegrationChangedByAnnotation: "alice"}
+	c := newAuditFakeClient(t, &it)
+	require.NoError(t, c.Get(ctx, key, &it))
+
+	require.NoError(t, trail.observe(ctx, c, &it))
+	entries := auditEntries(t, &buf)
+	require.Len(t, entries, 1)
+	assert.Equal(t, audit.ActionCreated, entries[0].Action)
+	assert.Equal(t, "alice", entries[0].User)
+	assert.Equal(t, int64(1), it.Status.AuditedGeneration)
+	persisted := v1.NewIntegration("ns", "my-it")
+	require.NoError(t, c.Get(ctx, key, &persisted))
+	assert.Equal(t, int64(1), persisted.Status.AuditedGeneration)
+
+	// The digest is recorded once the change is reconciled
+	reconciled := it.DeepCopy()
+	reconciled.Status.ObservedGeneration = 1
+	reconciled.Status.Digest = "d1"
+	trail.reconciled(ctx, &it, reconciled)
+	// Reconciled once only
+	trail.reconciled(ctx, reconciled, reconciled)
+	it = *reconciled
+
+	// The audited changes are not recorded again, even after the operator restarts
+	require.NoError(t, trail.observe(ctx, c, &it))
+	trail.observed = make(map[types.NamespacedName]*v1.Integration)
+	require.NoError(t, trail.observe(ctx, c, &it))
+	assert.Len(t, auditEntries(t, &buf), 2)
+
+	updated := it.DeepCopy()
+	updated.Generation = 2
+	updated.Spec.Traits.Cron = &trait.CronTrait{Schedule: "* * * * *"}
+	updated.Annotations[v1.IntegrationChangedByAnnotation] = "bob"
+	require.NoError(t, c.Update(ctx, updated))
+	require.NoError(t, trail.observe(ctx, c, updated))
+
+	// Status only changes are not recorded
+	running := updated.DeepCopy()
+	running.Status.Phase = v1.IntegrationPhaseRunning
+	require.NoError(t, c.Update(ctx, running))
+	require.NoError(t, trail.observe(ctx, c, running))
+
+	rebuilt := running.DeepCopy()
+	rebuilt.Status = v1.IntegrationStatus{AuditedGeneration: running.Status.AuditedGeneration}
+	require.NoError(t, c.Update(ctx, rebuilt))
+	require.NoError(t, trail.observe(ctx, c, rebuilt))
+
+	trail.deleted(ctx, key)
+	trail.deleted(ctx, key)
+
+	entries = auditEntries(t, &buf)
+	require.Len(t, entries, 5)
+	assert.Equal(t, audit.ActionReconciled, entries[1].Action)
+	assert.Equal(t, int64(1), entries[1].Generation)
+	assert.Equal(t, "d1", entries[1].Digest)
+	assert.Equal(t, audit.ActionUpdated, entries[2].Action)
+	assert.Equal(t, "bob", entries[2].User)
+	assert.Equal(t, []string{"trait cron added"}, entries[2].Changes)
+	assert.Equal(t, int64(2), entries[2].Generation)
+	assert.Equal(t, audit.ActionRebuilt, entries[3].Action)
+	assert.Equal(t, audit.ActionDeleted, entries[4].Action)
+	assert.Empty(t, trail.observed)
+}
+
+func TestAuditTrailChangesWhileStopped(t *testing.T) {
+	var buf bytes.Buffer
+	audit.SetSink(audit.NewWriterSink(&buf))
+	defer audit.SetSink(nil)
+	ctx := context.TODO()
+
+	it := v1.NewIntegration("ns", "my-it")
+	it.Generation = 3
+	it.Status.ObservedGeneration = 2
+	it.Status.AuditedGeneration = 2
+	c := newAuditFakeClient(t, &it)
+	require.NoError(t, c.Get(ctx, ctrl.ObjectKeyFromObject(&it), &it))
+
+	require.NoError(t, trail.observe(ctx, c, &it))
+	entries := auditEntries(t, &buf)
+	require.Len(t, entries, 1)
+	assert.Equal(t, audit.ActionUpdated, entries[0].Action)
+	assert.Equal(t, int64(3), entries[0].Generation)
+	assert.Empty(t, entries[0].Changes)
+	trail.deleted(ctx, ctrl.ObjectKeyFromObject(&it))
+}
+
+func TestAuditTrailDisabled(t *testing.T) {
+	audit.SetSink(nil)
+	it := v1.NewIntegration("ns", "my-it")
+	require.NoError(t, trail.observe(context.TODO(), nil, &it))
+	assert.Empty(t, trail.observed)
+}
+
+// newAuditFakeClient returns a fake client persisting the status patches of the Integrations, that the fake client
+// does not consider as a sub-resource.
+func newAuditFakeClient(t *testing.T, it *v1.Integration) client.Client {
+	t.Helper()
+	c, err := test.NewFakeClient(it)
+	require.NoError(t, err)
+	fakeClient := c.(*test.FakeClient) //nolint
+	fakeClient.Intercept(&interceptor.Funcs{
+		SubResourcePatch: func(ctx context.Context, client ctrl.Client, subResourceName string, obj ctrl.Object, patch ctrl.Patch, opts ...ctrl.SubResourcePatchOption) error {
+			return client.Patch(ctx, obj, patch)
+		},
+	})
+
+	return c
+}
+
+func auditEntries(t *testing.T, buf *bytes.Buffer) []audit.Entry {
+	t.Helper()
+	entries := make([]audit.Entry, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(buf.Bytes()))
+	for scanner.Scan() {
+		entry, ok := audit.Parse(scanner.Bytes())
+		require.True(t, ok)
+		entries = append(entries, entry)
+	}
+	return entries
+}
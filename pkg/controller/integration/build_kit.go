@@ -21,8 +21,12 @@ import (
 	"context"
 	"fmt"
 
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/platform"
 	"github.com/apache/camel-k/v2/pkg/trait"
+	"github.com/apache/camel-k/v2/pkg/util/defaults"
 	"github.com/apache/camel-k/v2/pkg/util/digest"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
 )
@@ -53,6 +57,10 @@ func (action *buildKitAction) Handle(ctx context.Context, integration *v1.Integr
 	}
 	if hash != integration.Status.Digest {
 		action.L.Info("Integration %s digest has changed: resetting its status. Will check if it needs to be rebuilt and restarted.", integration.Name)
+		if err := action.cancelSupersededBuild(ctx, integration); err != nil {
+			return nil, fmt.Errorf("failed to cancel superseded build for integration %s/%s: %w",
+				integration.Namespace, integration.Name, err)
+		}
 		integration.Initialize()
 		integration.Status.Digest = hash
 		return integration, nil
@@ -78,6 +86,17 @@ func (action *buildKitAction) Handle(ctx context.Context, integration *v1.Integr
 
 	}
 
+	pooling, err := lookupKitPooling(ctx, action.client, integration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lookup kit pooling for integration %s/%s: %w",
+			integration.Namespace, integration.Name, err)
+	}
+	matches := kitMatches
+	if pooling != nil {
+		// A kit shared by several Integrations may have more dependencies than the Integration requires
+		matches = pooledKitMatches
+	}
+
 	action.L.Debug("Applying traits to integration",
 		"integration", integration.Name,
 		"namespace", integration.Namespace)
@@ -99,7 +118,7 @@ kits:
 			k := &existingKits[i]
 
 			action.L.Debug("Comparing existing kit with environment", "env kit", kit.Name, "existing kit", k.Name)
-			match, err := kitMatches(&kit, k)
+			match, err := matches(&kit, k)
 			if err != nil {
 				return nil, fmt.Errorf("error occurred matches integration kits with environment for integration %s/%s: %w",
 					integration.Namespace, integration.Name, err)
@@ -119,6 +138,18 @@ kits:
 			}
 		}
 
+		if pooling != nil {
+			seed, err := poolKit(ctx, action.client, &kit, pooling)
+			if err != nil {
+				return nil, fmt.Errorf("failed to pool integration kit for integration %s/%s: %w",
+					integration.Namespace, integration.Name, err)
+			}
+			if seed != nil {
+				action.L.Info("Creating a shared integration kit", "integration", integration.Name,
+					"namespace", integration.Namespace, "integration kit", kit.Name, "extended kit", seed.Name)
+			}
+		}
+
 		action.L.Debug("No existing kit available for integration. Creating a new one.",
 			"integration", integration.Name,
 			"namespace", integration.Namespace,
@@ -205,3 +236,63 @@ func (action *buildKitAction) checkIntegrationKit(ctx context.Context, integrati
 
 	return nil, nil
 }
+
+// cancelSupersededBuild cancels the running Build of the kit of the Integration, when the kit no longer meets the
+// requirements of the updated Integration, unless the kit is also awaited by other Integrations.
+func (action *buildKitAction) cancelSupersededBuild(ctx context.Context, integration *v1.Integration) error {
+	ref := integration.Status.IntegrationKit
+	if ref == nil {
+		return nil
+	}
+	kit, err := kubernetes.GetIntegrationKit(ctx, action.client, ref.Name, ref.Namespace)
+	if err != nil {
+		return ctrl.IgnoreNotFound(err)
+	}
+	if kit.Labels[v1.IntegrationKitTypeLabel] != v1.IntegrationKitTypePlatform ||
+		kit.Status.Phase != v1.IntegrationKitPhaseBuildSubmitted && kit.Status.Phase != v1.IntegrationKitPhaseBuildRunning {
+		return nil
+	}
+
+	// Kits may be shared by the Integrations of several namespaces with a global operator
+	var opts []ctrl.ListOption
+	if !platform.IsCurrentOperatorGlobal() {
+		opts = append(opts, ctrl.InNamespace(integration.Namespace))
+	}
+	integrations := v1.NewIntegrationList()
+	if err := action.client.List(ctx, &integrations, opts...); err != nil {
+		return err
+	}
+	for _, it := range integrations.Items {
+		if (it.Namespace != integration.Namespace || it.Name != integration.Name) && it.Status.IntegrationKit != nil &&
+			it.Status.IntegrationKit.Name == kit.Name && it.Status.IntegrationKit.Namespace == kit.Namespace {
+			return nil
+		}
+	}
+
+	// The digest also changes when the configuration of the Integration changes, in which case the kit may still
+	// meet its requirements
+	updated := integration.DeepCopy()
+	updated.Initialize()
+	if _, err := trait.Apply(ctx, action.client, updated, nil); err != nil {
+		// The error is reported by the initialization of the Integration
+		action.L.Debug("Unable to apply traits to the updated integration, not cancelling its build", "error", err.Error())
+		return nil
+	}
+	updated.Status.Version = defaults.Version
+	if match, err := integrationMatches(ctx, action.client, updated, kit); err != nil || match {
+		return err
+	}
+
+	build, err := kubernetes.GetBuild(ctx, action.client, kit.Name, kit.Namespace)
+	if err != nil {
+		return ctrl.IgnoreNotFound(err)
+	}
+	if build.Status.IsFinished() || build.Status.Phase == v1.BuildPhaseCancelling {
+		return nil
+	}
+
+	action.L.Info("Cancelling superseded build", "build", build.Name, "namespace", build.Namespace)
+
+	return kubernetes.CancelBuild(ctx, action.client, build,
+		fmt.Sprintf("Build superseded by a new version of Integration %s", integration.Name))
+}
@@ -0,0 +1,131 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/trait"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/defaults"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelSupersededBuild(t *testing.T) {
+	controller := true
+	newKit := func(name string) (*v1.IntegrationKit, *v1.Build) {
+		kit := v1.NewIntegrationKit("ns", name)
+		kit.Labels = map[string]string{v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform}
+		kit.Status.Phase = v1.IntegrationKitPhaseBuildRunning
+		build := v1.NewBuild("ns", name)
+		build.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKitKind,
+			Name:       name,
+			Controller: &controller,
+		}}
+		build.Status.Phase = v1.BuildPhaseRunning
+
+		return kit, build
+	}
+	newIntegration := func(name string, kit *v1.IntegrationKit) *v1.Integration {
+		it := v1.NewIntegration("ns", name)
+		it.SetIntegrationKit(kit)
+
+		return &it
+	}
+
+	kit, build := newKit("kit-1")
+	sharedKit, sharedBuild := newKit("kit-2")
+	it := newIntegration("my-it", kit)
+	other := newIntegration("my-other-it", sharedKit)
+	shared := newIntegration("my-shared-it", sharedKit)
+
+	c, err := test.NewFakeClient(kit, build, sharedKit, sharedBuild, it, other, shared)
+	require.NoError(t, err)
+	a := buildKitAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	require.NoError(t, a.cancelSupersededBuild(context.TODO(), it))
+	require.NoError(t, c.Get(context.TODO(), ctrl.ObjectKeyFromObject(build), build))
+	assert.Equal(t, v1.BuildPhaseCancelling, build.Status.Phase)
+	require.NoError(t, c.Get(context.TODO(), ctrl.ObjectKeyFromObject(kit), kit))
+	assert.Equal(t, v1.IntegrationKitPhaseError, kit.Status.Phase)
+	assert.Equal(t, "Build superseded by a new version of Integration my-it", kit.Status.Failure.Reason)
+
+	// The Build is still awaited by another Integration
+	require.NoError(t, a.cancelSupersededBuild(context.TODO(), other))
+	require.NoError(t, c.Get(context.TODO(), ctrl.ObjectKeyFromObject(sharedBuild), sharedBuild))
+	assert.Equal(t, v1.BuildPhaseRunning, sharedBuild.Status.Phase)
+}
+
+func TestCancelSupersededBuildMatchingKit(t *testing.T) {
+	defaultCatalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+	catalog := v1.NewCamelCatalog("ns", "camel-k-catalog")
+	catalog.Spec = defaultCatalog.CamelCatalogSpec
+	platform := v1.NewIntegrationPlatform("ns", "camel-k")
+	platform.Status.Phase = v1.IntegrationPlatformPhaseReady
+	platform.Status.Build.RuntimeVersion = catalog.Spec.Runtime.Version
+
+	it := v1.NewIntegration("ns", "my-it")
+	it.Spec.Sources = []v1.SourceSpec{
+		v1.NewSourceSpec("routes.yaml", "- from:\n    uri: timer:tick\n    steps:\n      - to: log:info\n", v1.LanguageYaml),
+	}
+	// The kit is built for the updated Integration, whose configuration only has changed
+	initialized := it.DeepCopy()
+	initialized.Initialize()
+	c, err := test.NewFakeClient(&catalog, &platform)
+	require.NoError(t, err)
+	_, err = trait.Apply(context.TODO(), c, initialized, nil)
+	require.NoError(t, err)
+
+	kit := v1.NewIntegrationKit("ns", "kit-1")
+	kit.Labels = map[string]string{v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform}
+	kit.Spec.Dependencies = initialized.Status.Dependencies
+	kit.Status.Phase = v1.IntegrationKitPhaseBuildRunning
+	kit.Status.Version = defaults.Version
+	kit.Status.RuntimeVersion = initialized.Status.RuntimeVersion
+	kit.Status.RuntimeProvider = initialized.Status.RuntimeProvider
+	build := v1.NewBuild("ns", "kit-1")
+	build.Status.Phase = v1.BuildPhaseRunning
+	it.SetIntegrationKit(kit)
+	it.Spec.Configuration = []v1.ConfigurationSpec{{Type: "property", Value: "my.property=changed"}}
+
+	c, err = test.NewFakeClient(&catalog, &platform, kit, build, &it)
+	require.NoError(t, err)
+	a := buildKitAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	require.NoError(t, a.cancelSupersededBuild(context.TODO(), &it))
+	require.NoError(t, c.Get(context.TODO(), ctrl.ObjectKeyFromObject(build), build))
+	assert.Equal(t, v1.BuildPhaseRunning, build.Status.Phase)
+	require.NoError(t, c.Get(context.TODO(), ctrl.ObjectKeyFromObject(kit), kit))
+	assert.Equal(t, v1.IntegrationKitPhaseBuildRunning, kit.Status.Phase)
+}
@@ -0,0 +1,224 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/trait"
+)
+
+// idleReplicasAnnotation records the replicas of the Integration, or of the Pipe owning it, scaled down to zero by
+// the idle trait, so that they are restored when it is woken up.
+const idleReplicasAnnotation = "camel.apache.org/idle.replicas"
+
+// reconcileIdle scales the Integration down to zero once it has not processed any exchange for the idle trait timeout,
+// and wakes it up on the idle trait wake schedule.
+func (action *monitorAction) reconcileIdle(ctx context.Context, integration *v1.Integration) error {
+	if integration.Status.Phase != v1.IntegrationPhaseRunning {
+		return nil
+	}
+	cond := integration.Status.GetCondition(v1.IntegrationConditionIdle)
+	idle := integration.Spec.Traits.Idle
+	if idle == nil || !pointer.BoolDeref(idle.Enabled, false) {
+		if cond == nil {
+			return nil
+		}
+		// Do not leave the Integration scaled down to zero when the trait gets disabled
+		if cond.Status == corev1.ConditionTrue && pointer.Int32Deref(integration.Spec.Replicas, 1) == 0 {
+			if err := action.wakeUpIntegration(ctx, integration); err != nil {
+				return err
+			}
+		}
+		integration.Status.RemoveCondition(v1.IntegrationConditionIdle)
+		return nil
+	}
+	if cond == nil {
+		return nil
+	}
+
+	timeout, err := trait.ParseIdleTimeout(idle.Timeout)
+	if err != nil {
+		return err
+	}
+	schedule, err := trait.ParseWakeSchedule(idle.WakeSchedule)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+
+	if cond.Status == corev1.ConditionTrue {
+		if pointer.Int32Deref(integration.Spec.Replicas, 1) > 0 {
+			// Scaled up by the user
+			integration.Status.SetCondition(v1.IntegrationConditionIdle, corev1.ConditionFalse,
+				v1.IntegrationConditionActiveReason, "integration scaled up")
+			return nil
+		}
+		if schedule != nil && !schedule.Next(cond.LastTransitionTime.Time).After(now) {
+			action.L.Infof("Waking up idle integration %s/%s on schedule", integration.Namespace, integration.Name)
+			if err := action.wakeUpIntegration(ctx, integration); err != nil {
+				return err
+			}
+			integration.Status.SetCondition(v1.IntegrationConditionIdle, corev1.ConditionFalse,
+				v1.IntegrationConditionActiveReason, "integration woken up on schedule")
+		}
+		return nil
+	}
+
+	// The idle period is only measured from the metrics scraped from running Pods, so that an Integration whose
+	// metrics cannot be scraped is never considered idle
+	metrics := integration.Status.Metrics
+	if metrics == nil || metrics.Pods == 0 || metrics.LastActivity == nil || metrics.ExchangesInflight > 0 {
+		return nil
+	}
+	since := metrics.LastActivity.Time
+	if now.Sub(since) < timeout {
+		return nil
+	}
+
+	action.L.Infof("Scaling idle integration %s/%s down to zero", integration.Namespace, integration.Name)
+	if err := action.scaleDownIntegration(ctx, integration); err != nil {
+		return err
+	}
+	integration.Status.SetCondition(v1.IntegrationConditionIdle, corev1.ConditionTrue,
+		v1.IntegrationConditionIdleReason,
+		fmt.Sprintf("no exchange since %s, scaled to zero", since.UTC().Format(time.RFC3339)))
+
+	return nil
+}
+
+// scaleDownIntegration records the replicas of the Integration, or of the Pipe owning it, and scales it down to zero.
+func (action *monitorAction) scaleDownIntegration(ctx context.Context, integration *v1.Integration) error {
+	target := scaleTargetOf(integration)
+	scale, err := action.getScale(ctx, target)
+	if err != nil {
+		return err
+	}
+	if scale.Spec.Replicas > 0 {
+		if err := action.patchIdleReplicas(ctx, target, strconv.Itoa(int(scale.Spec.Replicas))); err != nil {
+			return err
+		}
+	}
+
+	return action.scale(ctx, integration, target, scale, 0)
+}
+
+// wakeUpIntegration restores the replicas of the Integration, or of the Pipe owning it, recorded when it has been
+// scaled down to zero, defaulting to one replica.
+func (action *monitorAction) wakeUpIntegration(ctx context.Context, integration *v1.Integration) error {
+	target := scaleTargetOf(integration)
+	if err := action.client.Get(ctx, ctrl.ObjectKeyFromObject(target), target); err != nil {
+		return err
+	}
+	replicas := int32(1)
+	if value, ok := target.GetAnnotations()[idleReplicasAnnotation]; ok {
+		if r, err := strconv.ParseInt(value, 10, 32); err == nil && r > 0 {
+			replicas = int32(r)
+		} else {
+			action.L.Infof("Ignoring invalid %s annotation %q of %s %s/%s", idleReplicasAnnotation, value, target.GetObjectKind().GroupVersionKind().Kind, target.GetNamespace(), target.GetName())
+		}
+	}
+	scale, err := action.getScale(ctx, target)
+	if err != nil {
+		return err
+	}
+	if err := action.scale(ctx, integration, target, scale, replicas); err != nil {
+		return err
+	}
+	if _, ok := target.GetAnnotations()[idleReplicasAnnotation]; ok {
+		return action.patchIdleReplicas(ctx, target, "")
+	}
+
+	return nil
+}
+
+// scaleTargetOf returns the resource scaled by the idle trait, i.e. the Integration, or the Pipe owning it.
+func scaleTargetOf(integration *v1.Integration) ctrl.Object {
+	if owner := metav1.GetControllerOf(integration); owner != nil && owner.Kind == v1.PipeKind {
+		pipe := v1.NewPipe(integration.Namespace, owner.Name)
+		return &pipe
+	}
+	it := v1.NewIntegration(integration.Namespace, integration.Name)
+
+	return &it
+}
+
+func scaleResourceOf(target ctrl.Object) schema.GroupResource {
+	resource := schema.GroupResource{Group: v1.SchemeGroupVersion.Group, Resource: "integrations"}
+	if _, ok := target.(*v1.Pipe); ok {
+		resource.Resource = "pipes"
+	}
+
+	return resource
+}
+
+func (action *monitorAction) getScale(ctx context.Context, target ctrl.Object) (*autoscalingv1.Scale, error) {
+	scales, err := action.client.ScalesClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return scales.Scales(target.GetNamespace()).Get(ctx, scaleResourceOf(target), target.GetName(), metav1.GetOptions{})
+}
+
+// scale updates the replicas of the Integration, or of the Pipe owning it, through the scale subresource.
+func (action *monitorAction) scale(ctx context.Context, integration *v1.Integration, target ctrl.Object, scale *autoscalingv1.Scale, replicas int32) error {
+	scales, err := action.client.ScalesClient()
+	if err != nil {
+		return err
+	}
+	scale.Spec = autoscalingv1.ScaleSpec{Replicas: replicas}
+	if _, err := scales.Scales(target.GetNamespace()).Update(ctx, scaleResourceOf(target), scale, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	integration.Spec.Replicas = &replicas
+
+	return nil
+}
+
+// patchIdleReplicas records the replicas of the scaled resource, or removes them when replicas is empty.
+func (action *monitorAction) patchIdleReplicas(ctx context.Context, target ctrl.Object, replicas string) error {
+	if err := action.client.Get(ctx, ctrl.ObjectKeyFromObject(target), target); err != nil {
+		return err
+	}
+	//nolint:forcetypeassert
+	base := target.DeepCopyObject().(ctrl.Object)
+	annotations := target.GetAnnotations()
+	if replicas == "" {
+		delete(annotations, idleReplicasAnnotation)
+	} else {
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[idleReplicasAnnotation] = replicas
+	}
+	target.SetAnnotations(annotations)
+
+	return action.client.Patch(ctx, target, ctrl.MergeFrom(base))
+}
@@ -0,0 +1,240 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+)
+
+func TestReconcileIdleScalesDownToZero(t *testing.T) {
+	it := idleIntegration("10m", "")
+	setIdleCondition(it, corev1.ConditionFalse, time.Now().Add(-time.Hour))
+	it.Status.Metrics = &v1.IntegrationRuntimeMetrics{
+		Pods:         1,
+		LastActivity: &metav1.Time{Time: time.Now().Add(-20 * time.Minute)},
+	}
+	a := newIdleMonitorAction(t, it)
+
+	require.NoError(t, a.reconcileIdle(context.TODO(), it))
+	assert.Equal(t, int32(0), scaleReplicas(t, a, "integrations", "my-it"))
+	assert.Equal(t, pointer.Int32(0), it.Spec.Replicas)
+	cond := it.Status.GetCondition(v1.IntegrationConditionIdle)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, v1.IntegrationConditionIdleReason, cond.Reason)
+}
+
+func TestReconcileIdleKeepsActiveIntegration(t *testing.T) {
+	it := idleIntegration("10m", "")
+	setIdleCondition(it, corev1.ConditionFalse, time.Now().Add(-time.Hour))
+	it.Status.Metrics = &v1.IntegrationRuntimeMetrics{
+		Pods:         1,
+		LastActivity: &metav1.Time{Time: time.Now().Add(-5 * time.Minute)},
+	}
+	a := newIdleMonitorAction(t, it)
+
+	require.NoError(t, a.reconcileIdle(context.TODO(), it))
+	assert.Nil(t, it.Spec.Replicas)
+	assert.Equal(t, corev1.ConditionFalse, it.Status.GetCondition(v1.IntegrationConditionIdle).Status)
+
+	// Inflight exchanges
+	it.Status.Metrics.LastActivity = &metav1.Time{Time: time.Now().Add(-20 * time.Minute)}
+	it.Status.Metrics.ExchangesInflight = 1
+	require.NoError(t, a.reconcileIdle(context.TODO(), it))
+	assert.Nil(t, it.Spec.Replicas)
+}
+
+func TestReconcileIdleScalesPipe(t *testing.T) {
+	it := idleIntegration("1m", "")
+	it.OwnerReferences = []metav1.OwnerReference{
+		{APIVersion: v1.SchemeGroupVersion.String(), Kind: v1.PipeKind, Name: "my-pipe", Controller: pointer.Bool(true)},
+	}
+	setIdleCondition(it, corev1.ConditionFalse, time.Now().Add(-time.Hour))
+	it.Status.Metrics = &v1.IntegrationRuntimeMetrics{
+		Pods:         1,
+		LastActivity: &metav1.Time{Time: time.Now().Add(-time.Hour)},
+	}
+	pipe := v1.NewPipe("ns", "my-pipe")
+	a := newIdleMonitorAction(t, it, &pipe)
+
+	require.NoError(t, a.reconcileIdle(context.TODO(), it))
+	assert.Equal(t, corev1.ConditionTrue, it.Status.GetCondition(v1.IntegrationConditionIdle).Status)
+	assert.Equal(t, pointer.Int32(0), it.Spec.Replicas)
+	assert.Equal(t, int32(0), scaleReplicas(t, a, "pipes", "my-pipe"))
+}
+
+func TestReconcileIdleWakesUpOnSchedule(t *testing.T) {
+	it := idleIntegration("10m", "*/5 * * * *")
+	it.Spec.Replicas = pointer.Int32(0)
+	setIdleCondition(it, corev1.ConditionTrue, time.Now().Add(-time.Hour))
+	a := newIdleMonitorAction(t, it)
+
+	require.NoError(t, a.reconcileIdle(context.TODO(), it))
+	assert.Equal(t, int32(1), scaleReplicas(t, a, "integrations", "my-it"))
+	assert.Equal(t, pointer.Int32(1), it.Spec.Replicas)
+	cond := it.Status.GetCondition(v1.IntegrationConditionIdle)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, v1.IntegrationConditionActiveReason, cond.Reason)
+}
+
+func TestReconcileIdleWaitsForSchedule(t *testing.T) {
+	it := idleIntegration("10m", "0 0 1 1 *")
+	it.Spec.Replicas = pointer.Int32(0)
+	setIdleCondition(it, corev1.ConditionTrue, time.Now().Add(-time.Minute))
+	a := newIdleMonitorAction(t, it)
+
+	require.NoError(t, a.reconcileIdle(context.TODO(), it))
+	assert.Equal(t, pointer.Int32(0), it.Spec.Replicas)
+	assert.Equal(t, corev1.ConditionTrue, it.Status.GetCondition(v1.IntegrationConditionIdle).Status)
+}
+
+func TestReconcileIdleScaledUpByUser(t *testing.T) {
+	it := idleIntegration("10m", "")
+	it.Spec.Replicas = pointer.Int32(2)
+	setIdleCondition(it, corev1.ConditionTrue, time.Now().Add(-time.Hour))
+	a := newIdleMonitorAction(t, it)
+
+	require.NoError(t, a.reconcileIdle(context.TODO(), it))
+	assert.Equal(t, pointer.Int32(2), it.Spec.Replicas)
+	cond := it.Status.GetCondition(v1.IntegrationConditionIdle)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, "integration scaled up", cond.Message)
+}
+
+func TestReconcileIdleDisabled(t *testing.T) {
+	it := idleIntegration("10m", "")
+	it.Spec.Traits.Idle.Enabled = pointer.Bool(false)
+	it.Spec.Replicas = pointer.Int32(0)
+	setIdleCondition(it, corev1.ConditionTrue, time.Now().Add(-time.Hour))
+	a := newIdleMonitorAction(t, it)
+
+	require.NoError(t, a.reconcileIdle(context.TODO(), it))
+	assert.Equal(t, int32(1), scaleReplicas(t, a, "integrations", "my-it"))
+	assert.Nil(t, it.Status.GetCondition(v1.IntegrationConditionIdle))
+}
+
+func TestReconcileIdleWaitsForScrapedMetrics(t *testing.T) {
+	it := idleIntegration("10m", "")
+	setIdleCondition(it, corev1.ConditionFalse, time.Now().Add(-time.Hour))
+	a := newIdleMonitorAction(t, it)
+
+	// No metrics scraped yet
+	require.NoError(t, a.reconcileIdle(context.TODO(), it))
+	assert.Nil(t, it.Spec.Replicas)
+
+	// No running Pod scraped
+	it.Status.Metrics = &v1.IntegrationRuntimeMetrics{
+		LastActivity: &metav1.Time{Time: time.Now().Add(-time.Hour)},
+	}
+	require.NoError(t, a.reconcileIdle(context.TODO(), it))
+	assert.Nil(t, it.Spec.Replicas)
+
+	// No activity recorded
+	it.Status.Metrics = &v1.IntegrationRuntimeMetrics{Pods: 1}
+	require.NoError(t, a.reconcileIdle(context.TODO(), it))
+	assert.Nil(t, it.Spec.Replicas)
+	assert.Equal(t, corev1.ConditionFalse, it.Status.GetCondition(v1.IntegrationConditionIdle).Status)
+}
+
+func TestReconcileIdleRestoresReplicas(t *testing.T) {
+	it := idleIntegration("10m", "*/5 * * * *")
+	it.Spec.Replicas = pointer.Int32(3)
+	setIdleCondition(it, corev1.ConditionFalse, time.Now().Add(-time.Hour))
+	it.Status.Metrics = &v1.IntegrationRuntimeMetrics{
+		Pods:         3,
+		LastActivity: &metav1.Time{Time: time.Now().Add(-20 * time.Minute)},
+	}
+	a := newIdleMonitorAction(t, it)
+	scales, err := a.client.ScalesClient()
+	require.NoError(t, err)
+	resource := schema.GroupResource{Group: v1.SchemeGroupVersion.Group, Resource: "integrations"}
+	scale, err := scales.Scales("ns").Get(context.TODO(), resource, "my-it", metav1.GetOptions{})
+	require.NoError(t, err)
+	scale.Spec.Replicas = 3
+	_, err = scales.Scales("ns").Update(context.TODO(), resource, scale, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, a.reconcileIdle(context.TODO(), it))
+	assert.Equal(t, int32(0), scaleReplicas(t, a, "integrations", "my-it"))
+	updated := v1.NewIntegration("ns", "my-it")
+	require.NoError(t, a.client.Get(context.TODO(), ctrl.ObjectKeyFromObject(&updated), &updated))
+	assert.Equal(t, "3", updated.Annotations[idleReplicasAnnotation])
+
+	// Woken up on schedule
+	it.Status.RemoveCondition(v1.IntegrationConditionIdle)
+	setIdleCondition(it, corev1.ConditionTrue, time.Now().Add(-time.Hour))
+	require.NoError(t, a.reconcileIdle(context.TODO(), it))
+	assert.Equal(t, int32(3), scaleReplicas(t, a, "integrations", "my-it"))
+	assert.Equal(t, pointer.Int32(3), it.Spec.Replicas)
+	require.NoError(t, a.client.Get(context.TODO(), ctrl.ObjectKeyFromObject(&updated), &updated))
+	assert.NotContains(t, updated.Annotations, idleReplicasAnnotation)
+}
+
+func idleIntegration(timeout string, schedule string) *v1.Integration {
+	it := v1.NewIntegration("ns", "my-it")
+	it.Spec.Traits.Idle = &trait.IdleTrait{
+		Trait:        trait.Trait{Enabled: pointer.Bool(true)},
+		Timeout:      timeout,
+		WakeSchedule: schedule,
+	}
+	it.Status.Phase = v1.IntegrationPhaseRunning
+	return &it
+}
+
+func setIdleCondition(it *v1.Integration, status corev1.ConditionStatus, since time.Time) {
+	it.Status.SetConditions(v1.IntegrationCondition{
+		Type:               v1.IntegrationConditionIdle,
+		Status:             status,
+		LastTransitionTime: metav1.NewTime(since),
+	})
+}
+
+func newIdleMonitorAction(t *testing.T, objs ...runtime.Object) *monitorAction {
+	t.Helper()
+	c, err := test.NewFakeClient(objs...)
+	require.NoError(t, err)
+	a := monitorAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	return &a
+}
+
+func scaleReplicas(t *testing.T, a *monitorAction, resource string, name string) int32 {
+	t.Helper()
+	scales, err := a.client.ScalesClient()
+	require.NoError(t, err)
+	scale, err := scales.Scales("ns").Get(context.TODO(), schema.GroupResource{Group: v1.SchemeGroupVersion.Group, Resource: resource}, name, metav1.GetOptions{})
+	require.NoError(t, err)
+	return scale.Spec.Replicas
+}
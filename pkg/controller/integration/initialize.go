@@ -58,13 +58,23 @@ func (action *initializeAction) Handle(ctx context.Context, integration *v1.Inte
 		return action.importFromExternalApp(integration)
 	}
 
-	if _, err := trait.Apply(ctx, action.client, integration, nil); err != nil {
+	env, err := trait.Apply(ctx, action.client, integration, nil)
+	if err != nil {
 		integration.Status.Phase = v1.IntegrationPhaseError
 		integration.SetReadyCondition(corev1.ConditionFalse,
 			v1.IntegrationConditionInitializationFailedReason, err.Error())
 		return integration, err
 	}
 
+	if denied, err := action.enforcePolicies(env, integration); err != nil {
+		integration.Status.Phase = v1.IntegrationPhaseError
+		integration.SetReadyCondition(corev1.ConditionFalse,
+			v1.IntegrationConditionInitializationFailedReason, err.Error())
+		return integration, err
+	} else if denied {
+		return integration, nil
+	}
+
 	if integration.Status.IntegrationKit == nil {
 		ikt, err := action.lookupIntegrationKit(ctx, integration)
 		if err != nil {
@@ -85,6 +95,30 @@ func (action *initializeAction) Handle(ctx context.Context, integration *v1.Inte
 	return integration, nil
 }
 
+// enforcePolicies reports the compliance of the Integration with the IntegrationPolicies, and moves it to the error phase
+// when a denying policy is violated.
+func (action *initializeAction) enforcePolicies(env *trait.Environment, integration *v1.Integration) (bool, error) {
+	report, err := trait.EvaluatePolicies(env)
+	if err != nil {
+		return false, err
+	}
+	if report == nil {
+		integration.Status.RemoveCondition(v1.IntegrationConditionPolicyCompliant)
+		return false, nil
+	}
+
+	status, reason, message := report.Condition()
+	integration.Status.SetCondition(v1.IntegrationConditionPolicyCompliant, status, reason, message)
+	if !report.Denied() {
+		return false, nil
+	}
+
+	action.L.Info("Integration denied by policy", "violations", message)
+	integration.Status.Phase = v1.IntegrationPhaseError
+	integration.SetReadyCondition(corev1.ConditionFalse, v1.IntegrationConditionPolicyViolationReason, message)
+	return true, nil
+}
+
 func (action *initializeAction) lookupIntegrationKit(ctx context.Context, integration *v1.Integration) (*v1.IntegrationKit, error) {
 	if integration.Spec.IntegrationKit == nil || integration.Spec.IntegrationKit.Name == "" {
 		return nil, nil
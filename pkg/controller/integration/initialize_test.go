@@ -26,6 +26,7 @@ import (
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 
+	"github.com/apache/camel-k/v2/pkg/util/camel"
 	"github.com/apache/camel-k/v2/pkg/util/log"
 	"github.com/apache/camel-k/v2/pkg/util/test"
 
@@ -188,3 +189,52 @@ func TestCamelImportUnsupportedKind(t *testing.T) {
 	assert.Equal(t, v1.IntegrationConditionImportingKindAvailableReason, handledIt.Status.GetCondition(v1.IntegrationConditionReady).Reason)
 	assert.Equal(t, "Unsupported SomeKind import kind", handledIt.Status.GetCondition(v1.IntegrationConditionReady).Message)
 }
+
+func TestInitializeDeniedByPolicy(t *testing.T) {
+	defaultCatalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+	catalog := v1.NewCamelCatalog("ns", "camel-k-catalog")
+	catalog.Spec = defaultCatalog.CamelCatalogSpec
+	platform := v1.NewIntegrationPlatform("ns", "camel-k")
+	platform.Status.Phase = v1.IntegrationPlatformPhaseReady
+	platform.Status.Build.RuntimeVersion = catalog.Spec.Runtime.Version
+	policy := v1.NewIntegrationPolicy("ns", "no-exec")
+	policy.Spec.ForbiddenComponents = []string{"exec"}
+	it := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-it",
+		},
+		Spec: v1.IntegrationSpec{
+			Sources: []v1.SourceSpec{
+				v1.NewSourceSpec("routes.yaml", "- from:\n    uri: timer:tick\n    steps:\n      - to: exec:ls\n", v1.LanguageYaml),
+			},
+		},
+		Status: v1.IntegrationStatus{
+			Phase: v1.IntegrationPhaseInitialization,
+		},
+	}
+	c, err := test.NewFakeClient(&catalog, &platform, &policy, it)
+	require.NoError(t, err)
+
+	a := NewInitializeAction()
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	assert.Equal(t, v1.IntegrationPhaseError, handledIt.Status.Phase)
+	cond := handledIt.Status.GetCondition(v1.IntegrationConditionPolicyCompliant)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, v1.IntegrationConditionPolicyViolationReason, cond.Reason)
+	assert.Equal(t, "policy no-exec: component exec is forbidden", cond.Message)
+	ready := handledIt.Status.GetCondition(v1.IntegrationConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, corev1.ConditionFalse, ready.Status)
+	assert.Equal(t, v1.IntegrationConditionPolicyViolationReason, ready.Reason)
+	assert.True(t, isInInitializationFailed(handledIt.Status))
+}
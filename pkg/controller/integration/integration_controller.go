@@ -40,6 +40,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"knative.dev/serving/pkg/apis/serving"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
@@ -111,9 +112,13 @@ func integrationUpdateFunc(old *v1.Integration, it *v1.Integration) bool {
 
 	// Ignore updates to the integration status in which case metadata.Generation does not change,
 	// or except when the integration phase changes as it's used to transition from one phase
-	// to another.
+	// to another, or when routes are controlled with the kamel route command, or when the integration
+	// is suspended or resumed, or when the user recorded for the audit trail changes.
 	return old.Generation != it.Generation ||
-		old.Status.Phase != it.Status.Phase
+		old.Status.Phase != it.Status.Phase ||
+		old.Annotations[v1.IntegrationControlledRoutesAnnotation] != it.Annotations[v1.IntegrationControlledRoutesAnnotation] ||
+		old.IsSuspended() != it.IsSuspended() ||
+		old.Annotations[v1.IntegrationChangedByAnnotation] != it.Annotations[v1.IntegrationChangedByAnnotation]
 }
 
 func isIntegrationUpdated(it *v1.Integration, previous, next *v1.IntegrationCondition) bool {
@@ -327,10 +332,15 @@ func add(ctx context.Context, mgr manager.Manager, c client.Client, r reconcile.
 					return integrationUpdateFunc(old, it)
 				},
 				DeleteFunc: func(e event.DeleteEvent) bool {
+					if it, ok := e.Object.(*v1.Integration); ok {
+						metricsCollector.forget(ctrl.ObjectKeyFromObject(it))
+					}
 					// Evaluates to false if the object has been confirmed deleted
 					return !e.DeleteStateUnknown
 				},
 			}))
+	// Reconcile the Integrations whose runtime metrics have been collected
+	b.WatchesRawSource(&source.Channel{Source: metricsCollector.events}, &handler.EnqueueRequestForObject{})
 	// Watch for all the resources
 	watchIntegrationResources(c, b)
 	// Watch for the CronJob conditionally
@@ -346,7 +356,12 @@ func add(ctx context.Context, mgr manager.Manager, c client.Client, r reconcile.
 		}
 	}
 
-	return b.Complete(r)
+	if err := b.Complete(r); err != nil {
+		return err
+	}
+
+	// Scrape the runtime metrics of the Integrations out of the reconciliation loop
+	return mgr.Add(manager.RunnableFunc(metricsCollector.start))
 }
 
 func watchIntegrationResources(c client.Client, b *builder.Builder) {
@@ -505,6 +520,8 @@ func (r *reconcileIntegration) Reconcile(ctx context.Context, request reconcile.
 			// Request object not found, could have been deleted after reconcile request.
 			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
 			// Return and don't requeue
+			deleteRuntimeMetrics(request.Namespace, request.Name)
+			trail.deleted(ctx, request.NamespacedName)
 			return reconcile.Result{}, nil
 		}
 		// Error reading the object - requeue the request.
@@ -517,6 +534,10 @@ func (r *reconcileIntegration) Reconcile(ctx context.Context, request reconcile.
 		return reconcile.Result{}, nil
 	}
 
+	if err := trail.observe(ctx, r.client, &instance); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	target := instance.DeepCopy()
 	targetLog := rlog.ForIntegration(target)
 
@@ -557,6 +578,7 @@ func (r *reconcileIntegration) Reconcile(ctx context.Context, request reconcile.
 				camelevent.NotifyIntegrationError(ctx, r.client, r.recorder, &instance, newTarget, err)
 				return reconcile.Result{}, err
 			}
+			trail.reconciled(ctx, &instance, newTarget)
 		}
 
 		// handle one action at time so the resource
@@ -566,6 +588,26 @@ func (r *reconcileIntegration) Reconcile(ctx context.Context, request reconcile.
 		break
 	}
 
+	// Requeue the running Integrations the runtime metrics are collected for, to refresh them periodically,
+	// and the scheduled Integrations, to suspend or resume them at their next scheduled transition
+	var requeueAfter time.Duration
+	if target.Status.Phase == v1.IntegrationPhaseRunning && target.Status.Metrics != nil {
+		requeueAfter = runtimeMetricsInterval
+	}
+	if next := target.Status.NextScheduledTransition; next != nil &&
+		(target.Status.Phase == v1.IntegrationPhaseRunning || target.Status.Phase == v1.IntegrationPhaseSuspended) {
+		d := time.Until(next.Time)
+		if d < time.Second {
+			d = time.Second
+		}
+		if requeueAfter == 0 || d < requeueAfter {
+			requeueAfter = d
+		}
+	}
+	if requeueAfter > 0 {
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
 	return reconcile.Result{}, nil
 }
 
@@ -19,6 +19,7 @@ package integration
 
 import (
 	"context"
+	"sort"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
@@ -35,6 +36,8 @@ import (
 	"github.com/apache/camel-k/v2/pkg/util/log"
 )
 
+const defaultKitPoolingMaxAddedDependencies = 5
+
 func lookupKitsForIntegration(ctx context.Context, c client.Client, integration *v1.Integration, options ...ctrl.ListOption) ([]v1.IntegrationKit, error) {
 	pl, err := platform.GetForResource(ctx, c, integration)
 	if err != nil && !k8serrors.IsNotFound(err) {
@@ -171,6 +174,38 @@ func statusMatches(integration *v1.Integration, kit *v1.IntegrationKit, ilog *lo
 
 // kitMatches returns whether the kit matches with the existing target kit.
 func kitMatches(kit *v1.IntegrationKit, target *v1.IntegrationKit) (bool, error) {
+	return matchKits(kit, target, false)
+}
+
+// pooledKitMatches returns whether the existing target kit, possibly shared by several Integrations,
+// provides all the dependencies of the kit.
+func pooledKitMatches(kit *v1.IntegrationKit, target *v1.IntegrationKit) (bool, error) {
+	if len(kit.Spec.Sources) > 0 || len(target.Spec.Sources) > 0 {
+		// Kits requiring sources at build time cannot be shared
+		return kitMatches(kit, target)
+	}
+	return matchKits(kit, target, true)
+}
+
+func matchKits(kit *v1.IntegrationKit, target *v1.IntegrationKit, superset bool) (bool, error) {
+	if !superset && len(kit.Spec.Dependencies) != len(target.Spec.Dependencies) {
+		return false, nil
+	}
+	if match, err := kitTraitsMatch(kit, target); !match || err != nil {
+		return false, err
+	}
+	if superset {
+		return util.StringSliceContains(target.Spec.Dependencies, kit.Spec.Dependencies), nil
+	}
+	if !util.StringSliceContains(kit.Spec.Dependencies, target.Spec.Dependencies) {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// kitTraitsMatch returns whether the kit has the same version and traits as the existing target kit.
+func kitTraitsMatch(kit *v1.IntegrationKit, target *v1.IntegrationKit) (bool, error) {
 	version := kit.Status.Version
 	if version == "" {
 		// Defaults with the version that is going to be set during the kit initialization
@@ -179,9 +214,6 @@ func kitMatches(kit *v1.IntegrationKit, target *v1.IntegrationKit) (bool, error)
 	if version != target.Status.Version {
 		return false, nil
 	}
-	if len(kit.Spec.Dependencies) != len(target.Spec.Dependencies) {
-		return false, nil
-	}
 
 	// We cannot have yet the status set
 	c1, err := trait.NewSpecTraitsOptionsForIntegrationKit(kit)
@@ -193,14 +225,107 @@ func kitMatches(kit *v1.IntegrationKit, target *v1.IntegrationKit) (bool, error)
 		return false, err
 	}
 
-	if match, err := trait.HasMatchingTraits(c1, c2); !match || err != nil {
-		return false, err
+	return trait.HasMatchingTraits(c1, c2)
+}
+
+// lookupKitPooling returns the kit pooling configuration applying to the Integration, or nil if the kit pooling is disabled.
+func lookupKitPooling(ctx context.Context, c client.Client, integration *v1.Integration) (*v1.KitPoolingSpec, error) {
+	pl, err := platform.GetForResource(ctx, c, integration)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return nil, err
 	}
-	if !util.StringSliceContains(kit.Spec.Dependencies, target.Spec.Dependencies) {
-		return false, nil
+	if pl == nil {
+		return nil, nil
+	}
+	if _, err := platform.ApplyIntegrationProfile(ctx, c, pl, integration); err != nil {
+		return nil, err
+	}
+	pooling := pl.Status.Build.KitPooling
+	if pooling == nil || !pooling.Enabled {
+		return nil, nil
 	}
 
-	return true, nil
+	return pooling, nil
+}
+
+// poolKit extends the dependencies of the kit with the ones of the closest existing kit, so that the resulting kit can be
+// shared with the Integrations running the existing kit. The kit is left untouched if no existing kit is close enough.
+func poolKit(ctx context.Context, c client.Client, kit *v1.IntegrationKit, pooling *v1.KitPoolingSpec) (*v1.IntegrationKit, error) {
+	if len(kit.Spec.Sources) > 0 {
+		// Kits requiring sources at build time cannot be shared
+		return nil, nil
+	}
+
+	maxAdded := int(pooling.MaxAddedDependencies)
+	if maxAdded <= 0 {
+		maxAdded = defaultKitPoolingMaxAddedDependencies
+	}
+
+	list := v1.NewIntegrationKitList()
+	if err := c.List(ctx, &list,
+		ctrl.InNamespace(kit.Namespace),
+		ctrl.MatchingLabels{
+			v1.IntegrationKitTypeLabel:          v1.IntegrationKitTypePlatform,
+			v1.IntegrationKitLayoutLabel:        kit.Labels[v1.IntegrationKitLayoutLabel],
+			"camel.apache.org/runtime.version":  kit.Labels["camel.apache.org/runtime.version"],
+			"camel.apache.org/runtime.provider": kit.Labels["camel.apache.org/runtime.provider"],
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	var seed *v1.IntegrationKit
+	var seedDependencies []string
+	added := 0
+	for i := range list.Items {
+		k := &list.Items[i]
+		if k.Status.Phase == v1.IntegrationKitPhaseError || len(k.Spec.Sources) > 0 {
+			continue
+		}
+		// Only the traits must match, as the dependencies are merged
+		if match, err := kitTraitsMatch(kit, k); err != nil {
+			return nil, err
+		} else if !match {
+			continue
+		}
+		dependencies := mergeDependencies(k.Spec.Dependencies, kit.Spec.Dependencies)
+		missing := len(dependencies) - len(k.Spec.Dependencies)
+		if missing > maxAdded {
+			continue
+		}
+		if pooling.MaxDependencies > 0 && len(dependencies) > int(pooling.MaxDependencies) {
+			continue
+		}
+		if seed == nil || missing < added || missing == added && len(dependencies) < len(seedDependencies) {
+			seed = k
+			seedDependencies = dependencies
+			added = missing
+		}
+	}
+	if seed == nil {
+		return nil, nil
+	}
+
+	kit.Spec.Dependencies = seedDependencies
+
+	return seed, nil
+}
+
+// mergeDependencies returns the sorted union of the dependencies.
+func mergeDependencies(dependencies ...[]string) []string {
+	set := make(map[string]bool)
+	for _, deps := range dependencies {
+		for _, d := range deps {
+			set[d] = true
+		}
+	}
+	merged := make([]string, 0, len(set))
+	for d := range set {
+		merged = append(merged, d)
+	}
+	sort.Strings(merged)
+
+	return merged
 }
 
 func hasMatchingSourcesForNative(it *v1.Integration, kit *v1.IntegrationKit) bool {
@@ -27,6 +27,7 @@ import (
 	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
 
 	"github.com/apache/camel-k/v2/pkg/trait"
+	"github.com/apache/camel-k/v2/pkg/util/defaults"
 	"github.com/apache/camel-k/v2/pkg/util/test"
 
 	"github.com/stretchr/testify/assert"
@@ -441,3 +442,80 @@ func integrationAndKitHaveSameTraits(i1 *v1.Integration, i2 *v1.IntegrationKit)
 
 	return trait.Equals(ikOpts, itOpts), nil
 }
+
+func TestPooledKitMatches(t *testing.T) {
+	kit := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel:core", "camel:timer"},
+		},
+	}
+	shared := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel:core", "camel:log", "camel:timer"},
+		},
+		Status: v1.IntegrationKitStatus{
+			Version: defaults.Version,
+		},
+	}
+
+	match, err := kitMatches(kit, shared)
+	require.NoError(t, err)
+	assert.False(t, match)
+
+	match, err = pooledKitMatches(kit, shared)
+	require.NoError(t, err)
+	assert.True(t, match)
+
+	// The shared kit must provide all the dependencies
+	match, err = pooledKitMatches(shared, kit)
+	require.NoError(t, err)
+	assert.False(t, match)
+}
+
+func TestPoolKit(t *testing.T) {
+	kitLabels := map[string]string{
+		v1.IntegrationKitTypeLabel:          v1.IntegrationKitTypePlatform,
+		v1.IntegrationKitLayoutLabel:        v1.IntegrationKitLayoutFastJar,
+		"camel.apache.org/runtime.version":  "1.2.3",
+		"camel.apache.org/runtime.provider": string(v1.RuntimeProviderQuarkus),
+	}
+	newKit := func(name string, phase v1.IntegrationKitPhase, dependencies ...string) *v1.IntegrationKit {
+		kit := v1.NewIntegrationKit("ns", name)
+		kit.Labels = make(map[string]string)
+		for k, v := range kitLabels {
+			kit.Labels[k] = v
+		}
+		kit.Spec.Dependencies = dependencies
+		kit.Status.Phase = phase
+		kit.Status.Version = defaults.Version
+		return kit
+	}
+
+	c, err := test.NewFakeClient(
+		newKit("far-kit", v1.IntegrationKitPhaseReady, "camel:a"),
+		newKit("close-kit", v1.IntegrationKitPhaseReady, "camel:core", "camel:log", "camel:timer"),
+		newKit("error-kit", v1.IntegrationKitPhaseError, "camel:core", "camel:log", "camel:timer", "camel:http"),
+	)
+	require.NoError(t, err)
+
+	kit := newKit("kit-new", v1.IntegrationKitPhaseNone, "camel:core", "camel:http", "camel:timer")
+	kit.Status.Version = ""
+	seed, err := poolKit(context.TODO(), c, kit, &v1.KitPoolingSpec{Enabled: true, MaxAddedDependencies: 1})
+	require.NoError(t, err)
+	require.NotNil(t, seed)
+	assert.Equal(t, "close-kit", seed.Name)
+	assert.Equal(t, []string{"camel:core", "camel:http", "camel:log", "camel:timer"}, kit.Spec.Dependencies)
+
+	// No kit is close enough to be extended
+	kit = newKit("kit-new", v1.IntegrationKitPhaseNone, "camel:core", "camel:http", "camel:kafka")
+	seed, err = poolKit(context.TODO(), c, kit, &v1.KitPoolingSpec{Enabled: true, MaxAddedDependencies: 1})
+	require.NoError(t, err)
+	assert.Nil(t, seed)
+	assert.Equal(t, []string{"camel:core", "camel:http", "camel:kafka"}, kit.Spec.Dependencies)
+
+	// The shared kit would be too large
+	kit = newKit("kit-new", v1.IntegrationKitPhaseNone, "camel:core", "camel:http", "camel:timer")
+	seed, err = poolKit(context.TODO(), c, kit, &v1.KitPoolingSpec{Enabled: true, MaxDependencies: 3})
+	require.NoError(t, err)
+	assert.Nil(t, seed)
+}
@@ -24,6 +24,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 )
 
 var (
@@ -50,11 +53,46 @@ var (
 			"id",
 		},
 	)
+
+	runtimeMetricsLabels = []string{
+		"namespace",
+		"name",
+		"kind",
+	}
+
+	exchanges = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "camel_k_integration_exchanges",
+			Help: "Camel K integration number of exchanges processed",
+		}, runtimeMetricsLabels,
+	)
+
+	exchangesFailed = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "camel_k_integration_exchanges_failed",
+			Help: "Camel K integration number of failed exchanges",
+		}, runtimeMetricsLabels,
+	)
+
+	exchangesInflight = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "camel_k_integration_exchanges_inflight",
+			Help: "Camel K integration number of exchanges being processed",
+		}, runtimeMetricsLabels,
+	)
+
+	exchangeMeanProcessingTime = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "camel_k_integration_exchange_mean_processing_seconds",
+			Help: "Camel K integration mean exchange processing time",
+		}, runtimeMetricsLabels,
+	)
 )
 
 func init() {
 	// Register custom metrics with the global prometheus registry
 	metrics.Registry.MustRegister(timeToFirstReadiness, integration)
+	metrics.Registry.MustRegister(exchanges, exchangesFailed, exchangesInflight, exchangeMeanProcessingTime)
 }
 
 func updateIntegrationPhase(iID string, p string) {
@@ -68,3 +106,40 @@ func updateIntegrationPhase(iID string, p string) {
 		integration.With(labels).Inc()
 	}
 }
+
+// updateRuntimeMetrics exports the runtime metrics of the Integration, or of the Pipe owning it.
+func updateRuntimeMetrics(it *v1.Integration) {
+	deleteRuntimeMetrics(it.Namespace, it.Name)
+	m := it.Status.Metrics
+	if m == nil {
+		return
+	}
+
+	labels := prometheus.Labels{
+		"namespace": it.Namespace,
+		"name":      it.Name,
+		"kind":      v1.IntegrationKind,
+	}
+	if owner := metav1.GetControllerOf(it); owner != nil && owner.Kind == v1.PipeKind {
+		labels["name"] = owner.Name
+		labels["kind"] = v1.PipeKind
+	}
+	exchanges.With(labels).Set(float64(m.ExchangesTotal))
+	exchangesFailed.With(labels).Set(float64(m.ExchangesFailed))
+	exchangesInflight.With(labels).Set(float64(m.ExchangesInflight))
+	if m.MeanProcessingTime != nil {
+		exchangeMeanProcessingTime.With(labels).Set(m.MeanProcessingTime.Seconds())
+	}
+}
+
+// deleteRuntimeMetrics removes the runtime metrics exported for the Integration.
+func deleteRuntimeMetrics(namespace string, name string) {
+	labels := prometheus.Labels{
+		"namespace": namespace,
+		"name":      name,
+	}
+	exchanges.DeletePartialMatch(labels)
+	exchangesFailed.DeletePartialMatch(labels)
+	exchangesInflight.DeletePartialMatch(labels)
+	exchangeMeanProcessingTime.DeletePartialMatch(labels)
+}
@@ -61,7 +61,8 @@ func (action *monitorAction) Name() string {
 func (action *monitorAction) CanHandle(integration *v1.Integration) bool {
 	return integration.Status.Phase == v1.IntegrationPhaseDeploying ||
 		integration.Status.Phase == v1.IntegrationPhaseRunning ||
-		integration.Status.Phase == v1.IntegrationPhaseError
+		integration.Status.Phase == v1.IntegrationPhaseError ||
+		integration.Status.Phase == v1.IntegrationPhaseSuspended
 }
 
 func (action *monitorAction) Handle(ctx context.Context, integration *v1.Integration) (*v1.Integration, error) {
@@ -194,6 +195,32 @@ func (action *monitorAction) monitorPods(ctx context.Context, environment *trait
 	podCount := int32(len(pendingPods.Items) + nonTerminatingPods)
 	integration.Status.Replicas = &podCount
 
+	// The workloads are kept, scaled down to zero, while the Integration is suspended
+	if integration.IsInactive() {
+		message := "integration suspended"
+		if !integration.IsSuspended() {
+			message = "integration out of schedule"
+		}
+		if podCount > 0 {
+			// The Integration is only reported as suspended once all its Pods are gone
+			message = fmt.Sprintf("%s, waiting for %d pod(s) to terminate", message, podCount)
+			if integration.Status.Phase == v1.IntegrationPhaseSuspended {
+				integration.Status.Phase = v1.IntegrationPhaseRunning
+			}
+		} else {
+			integration.Status.Phase = v1.IntegrationPhaseSuspended
+		}
+		integration.SetReadyCondition(corev1.ConditionFalse, v1.IntegrationConditionSuspendedReason, message)
+		integration.Status.Metrics = nil
+		updateRuntimeMetrics(integration)
+		return integration, nil
+	}
+	if integration.Status.Phase == v1.IntegrationPhaseSuspended {
+		integration.Status.Phase = v1.IntegrationPhaseDeploying
+	}
+
+	updateControlledRoutesCondition(integration)
+
 	// Reconcile Integration phase and ready condition
 	if integration.Status.Phase == v1.IntegrationPhaseDeploying {
 		integration.Status.Phase = v1.IntegrationPhaseRunning
@@ -204,6 +231,12 @@ func (action *monitorAction) monitorPods(ctx context.Context, environment *trait
 		return nil, err
 	}
 
+	action.collectRuntimeMetrics(ctx, environment, integration, runningPods.Items)
+	updateRuntimeMetrics(integration)
+	if err := action.reconcileIdle(ctx, integration); err != nil {
+		return nil, err
+	}
+
 	return integration, nil
 }
 
@@ -213,7 +246,8 @@ func isInInitializationFailed(status v1.IntegrationStatus) bool {
 	}
 	if cond := status.GetCondition(v1.IntegrationConditionReady); cond != nil {
 		if cond.Status == corev1.ConditionFalse &&
-			cond.Reason == v1.IntegrationConditionInitializationFailedReason {
+			(cond.Reason == v1.IntegrationConditionInitializationFailedReason ||
+				cond.Reason == v1.IntegrationConditionPolicyViolationReason) {
 			return true
 		}
 	}
@@ -478,6 +512,8 @@ func (action *monitorAction) probeReadiness(ctx context.Context, environment *tr
 	readyPods := 0
 	unreadyPods := 0
 
+	controlledRoutes := integration.GetControlledRoutes()
+
 	runtimeReady := true
 	runtimeFailed := false
 	probeReadinessOk := true
@@ -565,7 +601,7 @@ func (action *monitorAction) probeReadiness(ctx context.Context, environment *tr
 				return readyPods, false, err
 			}
 			for _, check := range health.Checks {
-				if check.Status == v1.HealthCheckStatusUp {
+				if check.Status == v1.HealthCheckStatusUp || isControlledRouteCheck(check, controlledRoutes) {
 					continue
 				}
 
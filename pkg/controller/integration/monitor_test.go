@@ -20,11 +20,13 @@ package integration
 import (
 	"context"
 	"testing"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
@@ -108,6 +110,78 @@ func TestMonitorIntegration(t *testing.T) {
 	assert.Equal(t, v1.IntegrationConditionDeploymentReadyReason, handledIt.Status.GetCondition(v1.IntegrationConditionReady).Reason)
 }
 
+func TestMonitorSuspendedIntegration(t *testing.T) {
+	c, it, err := nominalEnvironment()
+	require.NoError(t, err)
+	it.Annotations = map[string]string{
+		v1.IntegrationSuspendedAnnotation: "true",
+	}
+
+	a := monitorAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	// The Integration is not reported as suspended while its Pods are running
+	assert.Equal(t, v1.IntegrationPhaseRunning, handledIt.Status.Phase)
+	assert.Equal(t, corev1.ConditionFalse, handledIt.Status.GetCondition(v1.IntegrationConditionReady).Status)
+	assert.Equal(t, v1.IntegrationConditionSuspendedReason, handledIt.Status.GetCondition(v1.IntegrationConditionReady).Reason)
+	assert.Equal(t, "integration suspended, waiting for 1 pod(s) to terminate", handledIt.Status.GetCondition(v1.IntegrationConditionReady).Message)
+
+	deleteIntegrationPods(t, c)
+	handledIt, err = a.Handle(context.TODO(), handledIt)
+	require.NoError(t, err)
+	assert.Equal(t, v1.IntegrationPhaseSuspended, handledIt.Status.Phase)
+	assert.Equal(t, corev1.ConditionFalse, handledIt.Status.GetCondition(v1.IntegrationConditionReady).Status)
+	assert.Equal(t, v1.IntegrationConditionSuspendedReason, handledIt.Status.GetCondition(v1.IntegrationConditionReady).Reason)
+	assert.Equal(t, "integration suspended", handledIt.Status.GetCondition(v1.IntegrationConditionReady).Message)
+
+	// Resume the Integration
+	assert.True(t, a.CanHandle(handledIt))
+	delete(handledIt.Annotations, v1.IntegrationSuspendedAnnotation)
+	require.NoError(t, c.Create(context.TODO(), integrationPod()))
+	handledIt, err = a.Handle(context.TODO(), handledIt)
+	require.NoError(t, err)
+	assert.Equal(t, v1.IntegrationPhaseRunning, handledIt.Status.Phase)
+	assert.Equal(t, corev1.ConditionTrue, handledIt.Status.GetCondition(v1.IntegrationConditionReady).Status)
+}
+
+func TestMonitorOutOfScheduleIntegration(t *testing.T) {
+	c, it, err := nominalEnvironment()
+	require.NoError(t, err)
+	// Only active on a day that is never today
+	tomorrow := time.Now().UTC().AddDate(0, 0, 1)
+	it.Spec.Traits.Schedule = &trait.ScheduleTrait{
+		Trait:      trait.Trait{Enabled: pointer.Bool(true)},
+		Windows:    []string{tomorrow.Weekday().String()[:3] + " 00:00-00:01"},
+		Exclusions: []string{tomorrow.Format("2006-01-02")},
+	}
+	it.Status.Digest, err = digest.ComputeForIntegration(it, nil, nil)
+	require.NoError(t, err)
+
+	a := monitorAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	deleteIntegrationPods(t, c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	assert.Equal(t, v1.IntegrationPhaseSuspended, handledIt.Status.Phase)
+	assert.Equal(t, corev1.ConditionFalse, handledIt.Status.GetCondition(v1.IntegrationConditionScheduled).Status)
+	assert.Equal(t, "integration out of schedule", handledIt.Status.GetCondition(v1.IntegrationConditionReady).Message)
+	assert.NotNil(t, handledIt.Status.NextScheduledTransition)
+
+	// Disable the schedule
+	handledIt.Spec.Traits.Schedule = nil
+	handledIt.Status.Digest, err = digest.ComputeForIntegration(handledIt, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, c.Create(context.TODO(), integrationPod()))
+	handledIt, err = a.Handle(context.TODO(), handledIt)
+	require.NoError(t, err)
+	assert.Equal(t, v1.IntegrationPhaseRunning, handledIt.Status.Phase)
+	assert.Nil(t, handledIt.Status.GetCondition(v1.IntegrationConditionScheduled))
+	assert.Nil(t, handledIt.Status.NextScheduledTransition)
+}
+
 func TestMonitorFailureIntegration(t *testing.T) {
 	c, it, err := nominalEnvironment()
 	require.NoError(t, err)
@@ -203,7 +277,12 @@ func nominalEnvironment() (client.Client, *v1.Integration, error) {
 	}
 	hash, _ := digest.ComputeForIntegration(it, nil, nil)
 	it.Status.Digest = hash
-	pod := &corev1.Pod{
+	c, err := test.NewFakeClient(catalog, platform, it, kit, integrationPod())
+	return c, it, err
+}
+
+func integrationPod() *corev1.Pod {
+	return &corev1.Pod{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: appsv1.SchemeGroupVersion.String(),
 			Kind:       "Pod",
@@ -233,6 +312,9 @@ func nominalEnvironment() (client.Client, *v1.Integration, error) {
 			},
 		},
 	}
-	c, err := test.NewFakeClient(catalog, platform, it, kit, pod)
-	return c, it, err
+}
+
+func deleteIntegrationPods(t *testing.T, c client.Client) {
+	t.Helper()
+	require.NoError(t, c.DeleteAllOf(context.TODO(), &corev1.Pod{}, ctrl.InNamespace("ns"), ctrl.MatchingLabels{v1.IntegrationLabel: "my-it"}))
 }
@@ -0,0 +1,79 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+// updateControlledRoutesCondition reports the Camel routes stopped or suspended with the kamel route command.
+func updateControlledRoutesCondition(integration *v1.Integration) {
+	routes := integration.GetControlledRoutes()
+	if len(routes) == 0 {
+		integration.Status.RemoveCondition(v1.IntegrationConditionRoutesControlled)
+		return
+	}
+
+	ids := make([]string, 0, len(routes))
+	for id := range routes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	states := make([]string, len(ids))
+	for i, id := range ids {
+		states[i] = id + " " + routes[id]
+	}
+	integration.Status.SetCondition(
+		v1.IntegrationConditionRoutesControlled,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionRoutesControlledReason,
+		strings.Join(states, ", "),
+	)
+}
+
+// isControlledRouteCheck returns true when the health check reports the state of a route stopped or suspended
+// with the kamel route command, so that the route is not considered failing.
+func isControlledRouteCheck(check v1.HealthCheckResponse, routes map[string]string) bool {
+	if len(routes) == 0 {
+		return false
+	}
+
+	id := ""
+	if len(check.Data) > 0 {
+		data := make(map[string]interface{})
+		if err := json.Unmarshal(check.Data, &data); err == nil {
+			if routeID, ok := data["route.id"].(string); ok {
+				id = routeID
+			}
+		}
+	}
+	if id == "" {
+		if _, routeID, ok := strings.Cut(check.Name, "route:"); ok {
+			id = routeID
+		}
+	}
+	_, ok := routes[id]
+
+	return ok
+}
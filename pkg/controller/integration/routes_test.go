@@ -0,0 +1,66 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+func TestUpdateControlledRoutesCondition(t *testing.T) {
+	it := v1.NewIntegration("ns", "my-it")
+	it.SetControlledRoutes(map[string]string{"route2": "Suspended", "route1": "Stopped"})
+	updateControlledRoutesCondition(&it)
+
+	condition := it.Status.GetCondition(v1.IntegrationConditionRoutesControlled)
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, "route1 Stopped, route2 Suspended", condition.Message)
+
+	it.SetControlledRoutes(nil)
+	updateControlledRoutesCondition(&it)
+	assert.Nil(t, it.Status.GetCondition(v1.IntegrationConditionRoutesControlled))
+}
+
+func TestIsControlledRouteCheck(t *testing.T) {
+	routes := map[string]string{"route1": "Stopped"}
+
+	assert.True(t, isControlledRouteCheck(v1.HealthCheckResponse{
+		Name:   "camel-routes",
+		Status: v1.HealthCheckStatusDown,
+		Data:   []byte(`{"route.id": "route1", "route.status": "Stopped"}`),
+	}, routes))
+	assert.True(t, isControlledRouteCheck(v1.HealthCheckResponse{
+		Name:   "route:route1",
+		Status: v1.HealthCheckStatusDown,
+	}, routes))
+	assert.False(t, isControlledRouteCheck(v1.HealthCheckResponse{
+		Name:   "camel-routes",
+		Status: v1.HealthCheckStatusDown,
+		Data:   []byte(`{"route.id": "route2", "route.status": "Stopped"}`),
+	}, routes))
+	assert.False(t, isControlledRouteCheck(v1.HealthCheckResponse{
+		Name:   "route:route1",
+		Status: v1.HealthCheckStatusDown,
+	}, nil))
+}
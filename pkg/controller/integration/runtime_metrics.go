@@ -0,0 +1,277 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/trait"
+	utilk8s "github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+const (
+	// runtimeMetricsInterval is the interval at which the runtime metrics of the running Integrations are collected.
+	runtimeMetricsInterval = 30 * time.Second
+	runtimeMetricsPath     = "/q/metrics"
+	runtimeMetricsTimeout  = 5 * time.Second
+	// runtimeMetricsWorkers bounds the number of Integrations whose Pods are scraped concurrently.
+	runtimeMetricsWorkers = 4
+	// runtimeMetricsMeanProcessingTimeTolerance is the relative change of the mean processing time
+	// below which the Integration status is not updated.
+	runtimeMetricsMeanProcessingTimeTolerance = 0.1
+
+	prometheusTraitID = trait.ID("prometheus")
+)
+
+// The names of the Camel micrometer metrics, with the legacy and the Prometheus naming policies.
+var (
+	exchangesTotalMetrics         = []string{"camel_exchanges_total", "CamelExchangesTotal_total", "CamelExchangesTotal"}
+	exchangesFailedMetrics        = []string{"camel_exchanges_failed_total", "CamelExchangesFailed_total", "CamelExchangesFailed"}
+	exchangesInflightMetrics      = []string{"camel_exchanges_inflight", "CamelExchangesInflight"}
+	exchangeProcessingTimeMetrics = []string{"camel_route_policy_seconds", "CamelRoutePolicy_seconds"}
+)
+
+// runtimeMetrics are the Camel runtime metrics scraped from a single Pod.
+type runtimeMetrics struct {
+	exchangesTotal      float64
+	exchangesFailed     float64
+	exchangesInflight   float64
+	processingTimeSum   float64
+	processingTimeCount float64
+}
+
+// collectRuntimeMetrics applies the runtime metrics collected since the previous reconciliation to the Integration status,
+// and submits the scraping of the Camel metrics of the ready Pods, that runs asynchronously.
+func (action *monitorAction) collectRuntimeMetrics(ctx context.Context, environment *trait.Environment, integration *v1.Integration, pods []corev1.Pod) {
+	key := ctrl.ObjectKeyFromObject(integration)
+	if environment.GetTrait(prometheusTraitID) == nil {
+		integration.Status.Metrics = nil
+		metricsCollector.forget(key)
+		return
+	}
+	if metrics := metricsCollector.collected(key); metrics != nil {
+		metrics.LastActivity = lastActivity(integration.Status.Metrics, metrics, *metrics.CollectedAt)
+		if runtimeMetricsChanged(integration.Status.Metrics, metrics) {
+			integration.Status.Metrics = metrics
+		}
+	}
+	port := environment.GetIntegrationContainerPort()
+	if port == nil {
+		return
+	}
+
+	ready := make([]corev1.Pod, 0, len(pods))
+	for i := range pods {
+		pod := pods[i]
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		if condition := utilk8s.GetPodCondition(pod, corev1.PodReady); condition == nil || condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		ready = append(ready, pod)
+	}
+	metricsCollector.submit(runtimeMetricsRequest{
+		client: action.client,
+		key:    key,
+		pods:   ready,
+		port:   int(port.ContainerPort),
+	})
+}
+
+// runtimeMetricsChanged returns whether the collected metrics differ meaningfully from the ones of the Integration
+// status, so that the Integration is not updated at every collection.
+func runtimeMetricsChanged(previous *v1.IntegrationRuntimeMetrics, current *v1.IntegrationRuntimeMetrics) bool {
+	if previous == nil {
+		return true
+	}
+	if previous.Pods != current.Pods ||
+		previous.ExchangesTotal != current.ExchangesTotal ||
+		previous.ExchangesFailed != current.ExchangesFailed ||
+		previous.ExchangesInflight != current.ExchangesInflight {
+		return true
+	}
+	if (previous.LastActivity == nil) != (current.LastActivity == nil) ||
+		(previous.LastActivity != nil && !previous.LastActivity.Equal(current.LastActivity)) {
+		return true
+	}
+	if (previous.MeanProcessingTime == nil) != (current.MeanProcessingTime == nil) {
+		return true
+	}
+	if previous.MeanProcessingTime != nil {
+		delta := math.Abs(float64(current.MeanProcessingTime.Duration - previous.MeanProcessingTime.Duration))
+		return delta > float64(previous.MeanProcessingTime.Duration)*runtimeMetricsMeanProcessingTimeTolerance
+	}
+
+	return false
+}
+
+// lastActivity returns the last time exchanges were observed, comparing the collected metrics with the previous ones.
+// The first collection after the Pods have started counts as an activity, so that the idle period starts there.
+func lastActivity(previous *v1.IntegrationRuntimeMetrics, current *v1.IntegrationRuntimeMetrics, now metav1.Time) *metav1.Time {
+	if current.Pods == 0 {
+		if previous != nil {
+			return previous.LastActivity
+		}
+		return nil
+	}
+	if previous == nil || previous.Pods == 0 || previous.LastActivity == nil {
+		return &now
+	}
+	if current.ExchangesTotal != previous.ExchangesTotal || current.ExchangesInflight > 0 {
+		return &now
+	}
+
+	return previous.LastActivity
+}
+
+// scrapeRuntimeMetrics scrapes the Camel metrics of the given Pods, and aggregates them.
+func scrapeRuntimeMetrics(ctx context.Context, request runtimeMetricsRequest) *v1.IntegrationRuntimeMetrics {
+	scraped := make([]runtimeMetrics, 0, len(request.pods))
+	for i := range request.pods {
+		pod := &request.pods[i]
+		body, err := proxyGetMetrics(ctx, request.client, pod, request.port)
+		if err != nil {
+			Log.Debugf("Unable to scrape the metrics of Pod %s/%s: %s", pod.Namespace, pod.Name, err.Error())
+			continue
+		}
+		m, err := parseRuntimeMetrics(bytes.NewReader(body))
+		if err != nil {
+			Log.Debugf("Unable to parse the metrics of Pod %s/%s: %s", pod.Namespace, pod.Name, err.Error())
+			continue
+		}
+		scraped = append(scraped, m)
+	}
+
+	now := metav1.Now()
+	metrics := aggregateRuntimeMetrics(scraped)
+	metrics.CollectedAt = &now
+
+	return metrics
+}
+
+func proxyGetMetrics(ctx context.Context, c kubernetes.Interface, pod *corev1.Pod, port int) ([]byte, error) {
+	metricsCtx, cancel := context.WithTimeout(ctx, runtimeMetricsTimeout)
+	defer cancel()
+
+	return c.CoreV1().
+		Pods(pod.Namespace).
+		ProxyGet("http", pod.Name, strconv.Itoa(port), runtimeMetricsPath, nil).
+		DoRaw(metricsCtx)
+}
+
+// aggregateRuntimeMetrics sums up the metrics of the Pods, and computes the mean processing time over all the Pods.
+func aggregateRuntimeMetrics(scraped []runtimeMetrics) *v1.IntegrationRuntimeMetrics {
+	var total runtimeMetrics
+	for _, m := range scraped {
+		total.exchangesTotal += m.exchangesTotal
+		total.exchangesFailed += m.exchangesFailed
+		total.exchangesInflight += m.exchangesInflight
+		total.processingTimeSum += m.processingTimeSum
+		total.processingTimeCount += m.processingTimeCount
+	}
+
+	metrics := v1.IntegrationRuntimeMetrics{
+		Pods:              int32(len(scraped)),
+		ExchangesTotal:    int64(total.exchangesTotal),
+		ExchangesFailed:   int64(total.exchangesFailed),
+		ExchangesInflight: int64(total.exchangesInflight),
+	}
+	if total.processingTimeCount > 0 {
+		mean := time.Duration(total.processingTimeSum / total.processingTimeCount * float64(time.Second))
+		metrics.MeanProcessingTime = &metav1.Duration{Duration: mean.Round(time.Microsecond)}
+	}
+
+	return &metrics
+}
+
+// parseRuntimeMetrics extracts the Camel exchange metrics from the Prometheus text exposition of a Pod.
+func parseRuntimeMetrics(r io.Reader) (runtimeMetrics, error) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return runtimeMetrics{}, err
+	}
+
+	m := runtimeMetrics{
+		exchangesTotal:    sumMetric(families, exchangesTotalMetrics, metricValue),
+		exchangesFailed:   sumMetric(families, exchangesFailedMetrics, metricValue),
+		exchangesInflight: sumMetric(families, exchangesInflightMetrics, metricValue),
+		processingTimeSum: sumMetric(families, exchangeProcessingTimeMetrics, func(metric *dto.Metric) float64 {
+			return metric.GetSummary().GetSampleSum() + metric.GetHistogram().GetSampleSum()
+		}),
+		processingTimeCount: sumMetric(families, exchangeProcessingTimeMetrics, func(metric *dto.Metric) float64 {
+			return float64(metric.GetSummary().GetSampleCount() + metric.GetHistogram().GetSampleCount())
+		}),
+	}
+
+	return m, nil
+}
+
+// sumMetric sums the values of the first metric family found amongst names. The series of the Camel context
+// are preferred to the series of the routes when both are exposed, so that the exchanges are not counted twice.
+func sumMetric(families map[string]*dto.MetricFamily, names []string, value func(*dto.Metric) float64) float64 {
+	for _, name := range names {
+		family, ok := families[name]
+		if !ok {
+			continue
+		}
+		contextOnly := false
+		for _, metric := range family.GetMetric() {
+			if metricLabel(metric, "kind") == "CamelContext" {
+				contextOnly = true
+				break
+			}
+		}
+		sum := 0.0
+		for _, metric := range family.GetMetric() {
+			if contextOnly && metricLabel(metric, "kind") != "CamelContext" {
+				continue
+			}
+			sum += value(metric)
+		}
+		return sum
+	}
+	return 0
+}
+
+func metricValue(metric *dto.Metric) float64 {
+	return metric.GetCounter().GetValue() + metric.GetGauge().GetValue() + metric.GetUntyped().GetValue()
+}
+
+func metricLabel(metric *dto.Metric, name string) string {
+	for _, label := range metric.GetLabel() {
+		if label.GetName() == name {
+			return label.GetValue()
+		}
+	}
+	return ""
+}
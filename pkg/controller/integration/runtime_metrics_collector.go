@@ -0,0 +1,150 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+// runtimeMetricsQueueSize bounds the number of Integrations waiting for their Pods to be scraped.
+const runtimeMetricsQueueSize = 256
+
+// runtimeMetricsRequest is the scraping of the Camel metrics of the ready Pods of an Integration.
+type runtimeMetricsRequest struct {
+	client kubernetes.Interface
+	key    types.NamespacedName
+	pods   []corev1.Pod
+	port   int
+}
+
+// runtimeMetricsCollector scrapes the Pods of the Integrations out of the reconciliation loop, with a bounded
+// number of workers, and holds the collected metrics until the Integrations are reconciled.
+type runtimeMetricsCollector struct {
+	queue chan runtimeMetricsRequest
+	// events triggers the reconciliation of the Integrations whose metrics have been collected
+	events    chan event.GenericEvent
+	lock      sync.Mutex
+	pending   map[types.NamespacedName]bool
+	scrapedAt map[types.NamespacedName]time.Time
+	results   map[types.NamespacedName]*v1.IntegrationRuntimeMetrics
+}
+
+var metricsCollector = newRuntimeMetricsCollector()
+
+func newRuntimeMetricsCollector() *runtimeMetricsCollector {
+	return &runtimeMetricsCollector{
+		queue:     make(chan runtimeMetricsRequest, runtimeMetricsQueueSize),
+		events:    make(chan event.GenericEvent, runtimeMetricsQueueSize),
+		pending:   make(map[types.NamespacedName]bool),
+		scrapedAt: make(map[types.NamespacedName]time.Time),
+		results:   make(map[types.NamespacedName]*v1.IntegrationRuntimeMetrics),
+	}
+}
+
+// start runs the workers scraping the Pods, until the context is done.
+func (c *runtimeMetricsCollector) start(ctx context.Context) error {
+	var wg sync.WaitGroup
+	for i := 0; i < runtimeMetricsWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case request := <-c.queue:
+					c.collect(ctx, request)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return nil
+}
+
+// submit queues the scraping of the Pods of the Integration, unless it is already queued, or it has been
+// scraped recently. The scraping is skipped when the queue is full, and submitted again at the next reconciliation.
+func (c *runtimeMetricsCollector) submit(request runtimeMetricsRequest) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.pending[request.key] || time.Since(c.scrapedAt[request.key]) < runtimeMetricsInterval/2 {
+		return
+	}
+	select {
+	case c.queue <- request:
+		c.pending[request.key] = true
+	default:
+		Log.Debugf("Too many integrations waiting for their runtime metrics, skipping %s", request.key)
+	}
+}
+
+func (c *runtimeMetricsCollector) collect(ctx context.Context, request runtimeMetricsRequest) {
+	metrics := scrapeRuntimeMetrics(ctx, request)
+
+	c.lock.Lock()
+	if !c.pending[request.key] {
+		// The Integration has been forgotten while its Pods were scraped
+		c.lock.Unlock()
+		return
+	}
+	delete(c.pending, request.key)
+	c.scrapedAt[request.key] = time.Now()
+	c.results[request.key] = metrics
+	c.lock.Unlock()
+
+	select {
+	case c.events <- event.GenericEvent{Object: &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: request.key.Namespace, Name: request.key.Name},
+	}}:
+	default:
+		// The Integration is reconciled periodically anyway
+	}
+}
+
+// collected returns the metrics collected for the Integration since the previous call, if any.
+func (c *runtimeMetricsCollector) collected(key types.NamespacedName) *v1.IntegrationRuntimeMetrics {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	metrics := c.results[key]
+	delete(c.results, key)
+
+	return metrics
+}
+
+// forget drops the state of the Integration, whose metrics are not collected anymore.
+func (c *runtimeMetricsCollector) forget(key types.NamespacedName) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.pending, key)
+	delete(c.scrapedAt, key)
+	delete(c.results, key)
+}
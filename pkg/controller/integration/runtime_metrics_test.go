@@ -0,0 +1,223 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+const camelMetrics = `# HELP camel_exchanges_total Total number of processed exchanges
+# TYPE camel_exchanges_total counter
+camel_exchanges_total{camelContext="camel-1",kind="CamelContext",routeId=""} 12.0
+camel_exchanges_total{camelContext="camel-1",kind="CamelRoute",routeId="route1"} 8.0
+camel_exchanges_total{camelContext="camel-1",kind="CamelRoute",routeId="route2"} 4.0
+# HELP camel_exchanges_failed_total Number of failed exchanges
+# TYPE camel_exchanges_failed_total counter
+camel_exchanges_failed_total{camelContext="camel-1",kind="CamelContext",routeId=""} 2.0
+camel_exchanges_failed_total{camelContext="camel-1",kind="CamelRoute",routeId="route1"} 2.0
+# HELP camel_exchanges_inflight Route inflight messages
+# TYPE camel_exchanges_inflight gauge
+camel_exchanges_inflight{camelContext="camel-1",kind="CamelRoute",routeId="route1"} 1.0
+camel_exchanges_inflight{camelContext="camel-1",kind="CamelRoute",routeId="route2"} 0.0
+# HELP camel_route_policy_seconds Route performance metrics
+# TYPE camel_route_policy_seconds summary
+camel_route_policy_seconds_count{camelContext="camel-1",kind="CamelRoute",routeId="route1"} 8
+camel_route_policy_seconds_sum{camelContext="camel-1",kind="CamelRoute",routeId="route1"} 0.4
+camel_route_policy_seconds_count{camelContext="camel-1",kind="CamelRoute",routeId="route2"} 4
+camel_route_policy_seconds_sum{camelContext="camel-1",kind="CamelRoute",routeId="route2"} 0.8
+# HELP jvm_threads_live_threads The current number of live threads
+# TYPE jvm_threads_live_threads gauge
+jvm_threads_live_threads 42.0
+`
+
+const legacyCamelMetrics = `# TYPE CamelExchangesTotal_total counter
+CamelExchangesTotal_total{camelContext="camel-1",routeId="route1"} 3.0
+# TYPE CamelExchangesFailed_total counter
+CamelExchangesFailed_total{camelContext="camel-1",routeId="route1"} 1.0
+# TYPE CamelExchangesInflight gauge
+CamelExchangesInflight{camelContext="camel-1",routeId="route1"} 2.0
+`
+
+func TestParseRuntimeMetrics(t *testing.T) {
+	m, err := parseRuntimeMetrics(strings.NewReader(camelMetrics))
+	require.NoError(t, err)
+	assert.Equal(t, 12.0, m.exchangesTotal)
+	assert.Equal(t, 2.0, m.exchangesFailed)
+	assert.Equal(t, 1.0, m.exchangesInflight)
+	assert.InDelta(t, 1.2, m.processingTimeSum, 0.0001)
+	assert.Equal(t, 12.0, m.processingTimeCount)
+}
+
+func TestParseRuntimeMetricsLegacyNames(t *testing.T) {
+	m, err := parseRuntimeMetrics(strings.NewReader(legacyCamelMetrics))
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, m.exchangesTotal)
+	assert.Equal(t, 1.0, m.exchangesFailed)
+	assert.Equal(t, 2.0, m.exchangesInflight)
+	assert.Equal(t, 0.0, m.processingTimeCount)
+}
+
+func TestParseRuntimeMetricsInvalid(t *testing.T) {
+	_, err := parseRuntimeMetrics(strings.NewReader("camel_exchanges_total{ 12"))
+	require.Error(t, err)
+}
+
+func TestAggregateRuntimeMetrics(t *testing.T) {
+	m := aggregateRuntimeMetrics([]runtimeMetrics{
+		{exchangesTotal: 12, exchangesFailed: 2, exchangesInflight: 1, processingTimeSum: 1.2, processingTimeCount: 12},
+		{exchangesTotal: 8, exchangesFailed: 0, exchangesInflight: 3, processingTimeSum: 0.8, processingTimeCount: 8},
+	})
+	assert.Equal(t, int32(2), m.Pods)
+	assert.Equal(t, int64(20), m.ExchangesTotal)
+	assert.Equal(t, int64(2), m.ExchangesFailed)
+	assert.Equal(t, int64(4), m.ExchangesInflight)
+	require.NotNil(t, m.MeanProcessingTime)
+	assert.Equal(t, 100*time.Millisecond, m.MeanProcessingTime.Duration)
+
+	m = aggregateRuntimeMetrics(nil)
+	assert.Equal(t, int32(0), m.Pods)
+	assert.Nil(t, m.MeanProcessingTime)
+}
+
+func TestLastActivity(t *testing.T) {
+	before := metav1.NewTime(time.Now().Add(-time.Hour))
+	now := metav1.Now()
+
+	// first collection
+	last := lastActivity(nil, &v1.IntegrationRuntimeMetrics{Pods: 1}, now)
+	require.NotNil(t, last)
+	assert.Equal(t, now, *last)
+	// no exchange since the previous collection
+	previous := &v1.IntegrationRuntimeMetrics{Pods: 1, ExchangesTotal: 5, LastActivity: &before}
+	assert.Equal(t, &before, lastActivity(previous, &v1.IntegrationRuntimeMetrics{Pods: 1, ExchangesTotal: 5}, now))
+	// new exchanges
+	assert.Equal(t, &now, lastActivity(previous, &v1.IntegrationRuntimeMetrics{Pods: 1, ExchangesTotal: 6}, now))
+	// inflight exchanges
+	assert.Equal(t, &now, lastActivity(previous, &v1.IntegrationRuntimeMetrics{Pods: 1, ExchangesTotal: 5, ExchangesInflight: 1}, now))
+	// no ready Pods
+	assert.Equal(t, &before, lastActivity(previous, &v1.IntegrationRuntimeMetrics{}, now))
+	// Pods started again
+	assert.Equal(t, &now, lastActivity(&v1.IntegrationRuntimeMetrics{LastActivity: &before}, &v1.IntegrationRuntimeMetrics{Pods: 1}, now))
+}
+
+func TestRuntimeMetricsChanged(t *testing.T) {
+	before := metav1.NewTime(time.Now().Add(-time.Hour))
+	previous := &v1.IntegrationRuntimeMetrics{
+		Pods:               1,
+		ExchangesTotal:     5,
+		MeanProcessingTime: &metav1.Duration{Duration: 100 * time.Millisecond},
+		LastActivity:       &before,
+	}
+	current := previous.DeepCopy()
+	now := metav1.Now()
+	current.CollectedAt = &now
+
+	assert.True(t, runtimeMetricsChanged(nil, current))
+	// only the collection time differs
+	assert.False(t, runtimeMetricsChanged(previous, current))
+	// the mean processing time varies within the tolerance
+	current.MeanProcessingTime = &metav1.Duration{Duration: 105 * time.Millisecond}
+	assert.False(t, runtimeMetricsChanged(previous, current))
+	current.MeanProcessingTime = &metav1.Duration{Duration: 150 * time.Millisecond}
+	assert.True(t, runtimeMetricsChanged(previous, current))
+	current = previous.DeepCopy()
+	current.ExchangesTotal = 6
+	assert.True(t, runtimeMetricsChanged(previous, current))
+	current = previous.DeepCopy()
+	current.Pods = 0
+	assert.True(t, runtimeMetricsChanged(previous, current))
+	current = previous.DeepCopy()
+	current.LastActivity = &now
+	assert.True(t, runtimeMetricsChanged(previous, current))
+}
+
+func TestRuntimeMetricsCollector(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	c := newRuntimeMetricsCollector()
+	go func() {
+		_ = c.start(ctx)
+	}()
+	key := types.NamespacedName{Namespace: "ns", Name: "my-it"}
+
+	c.submit(runtimeMetricsRequest{key: key})
+	e := <-c.events
+	assert.Equal(t, "my-it", e.Object.GetName())
+	metrics := c.collected(key)
+	require.NotNil(t, metrics)
+	assert.Equal(t, int32(0), metrics.Pods)
+	assert.NotNil(t, metrics.CollectedAt)
+	assert.Nil(t, c.collected(key))
+
+	// the Pods are not scraped again until the next interval
+	c.submit(runtimeMetricsRequest{key: key})
+	assert.Empty(t, c.queue)
+	c.forget(key)
+	c.submit(runtimeMetricsRequest{key: key})
+	<-c.events
+	assert.NotNil(t, c.collected(key))
+}
+
+func TestUpdateRuntimeMetricsForPipe(t *testing.T) {
+	it := v1.NewIntegration("ns", "my-pipe")
+	it.OwnerReferences = []metav1.OwnerReference{
+		{APIVersion: v1.SchemeGroupVersion.String(), Kind: v1.PipeKind, Name: "my-pipe", Controller: pointer.Bool(true)},
+	}
+	it.Status.Metrics = &v1.IntegrationRuntimeMetrics{
+		ExchangesTotal:     20,
+		ExchangesFailed:    2,
+		MeanProcessingTime: &metav1.Duration{Duration: 100 * time.Millisecond},
+	}
+	updateRuntimeMetrics(&it)
+
+	labels := prometheus.Labels{"namespace": "ns", "name": "my-pipe", "kind": v1.PipeKind}
+	assert.Equal(t, 20.0, gaugeValue(t, exchanges.With(labels)))
+	assert.Equal(t, 2.0, gaugeValue(t, exchangesFailed.With(labels)))
+	assert.Equal(t, 0.1, gaugeValue(t, exchangeMeanProcessingTime.With(labels)))
+
+	deleteRuntimeMetrics("ns", "my-pipe")
+	assert.Equal(t, 0, testCollectCount(exchanges))
+}
+
+func gaugeValue(t *testing.T, g prometheus.Gauge) float64 {
+	t.Helper()
+	m := dto.Metric{}
+	require.NoError(t, g.Write(&m))
+	return m.GetGauge().GetValue()
+}
+
+func testCollectCount(col prometheus.Collector) int {
+	count := 0
+	collect(col, func(m *dto.Metric) {
+		count++
+	})
+	return count
+}
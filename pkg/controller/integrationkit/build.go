@@ -80,6 +80,7 @@ func (action *buildAction) handleBuildSubmitted(ctx context.Context, kit *v1.Int
 	if err != nil && k8serrors.IsNotFound(err) ||
 		build.Status.Phase == v1.BuildPhaseError ||
 		build.Status.Phase == v1.BuildPhaseInterrupted ||
+		build.Status.Phase == v1.BuildPhaseCancelled ||
 		build.Status.Phase == v1.BuildPhaseSucceeded {
 
 		b, err := action.createBuild(ctx, kit)
@@ -156,7 +157,7 @@ func (action *buildAction) createBuild(ctx context.Context, kit *v1.IntegrationK
 	}
 
 	//nolint:contextcheck
-	if buildConfig.Strategy == v1.BuildStrategyPod {
+	if buildConfig.Strategy == v1.BuildStrategyPod || buildConfig.Strategy == v1.BuildStrategyTekton {
 		err = platform.CreateBuilderServiceAccount(env.Ctx, env.Client, env.Platform)
 		if err != nil {
 			return nil, fmt.Errorf("error while creating Camel K Builder service account: %w", err)
@@ -212,6 +213,9 @@ func (action *buildAction) handleBuildRunning(ctx context.Context, kit *v1.Integ
 	switch build.Status.Phase {
 	case v1.BuildPhaseRunning:
 		action.L.Info("Build running")
+		if setNativeBuildConditions(kit, build) {
+			return kit, nil
+		}
 	case v1.BuildPhaseSucceeded:
 		// we should ensure that the integration kit is still in the right phase,
 		// if not there is a chance that the kit has been modified by the user
@@ -226,6 +230,7 @@ func (action *buildAction) handleBuildRunning(ctx context.Context, kit *v1.Integ
 		kit.Status.RootImage = build.Status.RootImage
 		kit.Status.BaseImage = build.Status.BaseImage
 		kit.Status.Image = build.Status.Image
+		kit.Status.ImagePlatforms = build.Status.ImagePlatforms
 
 		// Address the image by repository digest instead of tag if possible
 		if build.Status.Digest != "" {
@@ -241,6 +246,7 @@ func (action *buildAction) handleBuildRunning(ctx context.Context, kit *v1.Integ
 		}
 
 		kit.Status.Phase = v1.IntegrationKitPhaseReady
+		setNativeBuildConditions(kit, build)
 		kit.Status.Artifacts = make([]v1.Artifact, 0, len(build.Status.Artifacts))
 
 		for _, a := range build.Status.Artifacts {
@@ -254,7 +260,7 @@ func (action *buildAction) handleBuildRunning(ctx context.Context, kit *v1.Integ
 		}
 
 		return kit, err
-	case v1.BuildPhaseError, v1.BuildPhaseInterrupted:
+	case v1.BuildPhaseError, v1.BuildPhaseInterrupted, v1.BuildPhaseCancelled:
 		// we should ensure that the integration kit is still in the right phase,
 		// if not there is a chance that the kit has been modified by the user
 		if kit.Status.Phase != v1.IntegrationKitPhaseBuildRunning {
@@ -268,6 +274,7 @@ func (action *buildAction) handleBuildRunning(ctx context.Context, kit *v1.Integ
 		// Let's copy the build failure to the integration kit status
 		kit.Status.Failure = build.Status.Failure
 		kit.Status.Phase = v1.IntegrationKitPhaseError
+		setNativeBuildConditions(kit, build)
 
 		return kit, nil
 	}
@@ -125,8 +125,10 @@ func add(_ context.Context, mgr manager.Manager, r reconcile.Reconciler) error {
 				}
 				// Ignore updates to the build CR except when the build phase changes
 				// as it's used to transition the integration kit from one phase
-				// to another during the image build
-				return oldBuild.Status.Phase != newBuild.Status.Phase
+				// to another during the image build, or when a build task completes,
+				// as it's reported into the native kit conditions
+				return oldBuild.Status.Phase != newBuild.Status.Phase ||
+					len(oldBuild.Status.Conditions) != len(newBuild.Status.Conditions)
 			},
 		},
 	)
@@ -0,0 +1,74 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integrationkit
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+// nativeBuildSteps maps the builder Pod containers of a native build to the kit conditions tracking its progress.
+var nativeBuildSteps = []struct {
+	container string
+	condition v1.IntegrationKitConditionType
+}{
+	{container: "builder", condition: v1.IntegrationKitConditionNativeSourcesGenerated},
+	{container: "quarkus-native", condition: v1.IntegrationKitConditionNativeExecutableCompiled},
+}
+
+// setNativeBuildConditions reports the progress of a native build as kit conditions, and returns whether any condition has changed.
+func setNativeBuildConditions(kit *v1.IntegrationKit, build *v1.Build) bool {
+	if kit.Labels[v1.IntegrationKitLayoutLabel] != v1.IntegrationKitLayoutNativeSources {
+		return false
+	}
+
+	changed := false
+	for _, step := range nativeBuildSteps {
+		status := corev1.ConditionUnknown
+		reason := v1.IntegrationKitConditionNativeBuildInProgressReason
+		message := fmt.Sprintf("waiting for the %s task to complete", step.container)
+
+		containerCondition := build.Status.GetCondition(v1.BuildConditionType(fmt.Sprintf("Container%sSucceeded", step.container)))
+		switch {
+		case containerCondition != nil && containerCondition.Status == corev1.ConditionTrue,
+			containerCondition == nil && build.Status.Phase == v1.BuildPhaseSucceeded:
+			status = corev1.ConditionTrue
+			reason = v1.IntegrationKitConditionNativeBuildCompletedReason
+			message = fmt.Sprintf("%s task completed", step.container)
+		case containerCondition != nil:
+			status = corev1.ConditionFalse
+			reason = v1.IntegrationKitConditionNativeBuildFailedReason
+			message = containerCondition.Message
+		case build.Status.IsFinished():
+			status = corev1.ConditionFalse
+			reason = v1.IntegrationKitConditionNativeBuildFailedReason
+			message = fmt.Sprintf("%s task not completed: build %s", step.container, build.Status.Phase)
+		}
+
+		if c := kit.Status.GetCondition(step.condition); c != nil && c.Status == status && c.Reason == reason {
+			continue
+		}
+		kit.Status.SetCondition(step.condition, status, reason, message)
+		changed = true
+	}
+
+	return changed
+}
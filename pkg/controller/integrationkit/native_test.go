@@ -0,0 +1,73 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integrationkit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+func TestSetNativeBuildConditions(t *testing.T) {
+	kit := v1.NewIntegrationKit("ns", "my-kit")
+	kit.Labels = map[string]string{
+		v1.IntegrationKitLayoutLabel: v1.IntegrationKitLayoutNativeSources,
+	}
+	build := v1.NewBuild("ns", "my-kit")
+	build.Status.Phase = v1.BuildPhaseRunning
+
+	assert.True(t, setNativeBuildConditions(kit, build))
+	assertKitCondition(t, kit, v1.IntegrationKitConditionNativeSourcesGenerated, corev1.ConditionUnknown)
+	assertKitCondition(t, kit, v1.IntegrationKitConditionNativeExecutableCompiled, corev1.ConditionUnknown)
+	assert.False(t, setNativeBuildConditions(kit, build))
+
+	build.Status.SetCondition("ContainerbuilderSucceeded", corev1.ConditionTrue, "Completed (0)", "")
+	assert.True(t, setNativeBuildConditions(kit, build))
+	assertKitCondition(t, kit, v1.IntegrationKitConditionNativeSourcesGenerated, corev1.ConditionTrue)
+	assertKitCondition(t, kit, v1.IntegrationKitConditionNativeExecutableCompiled, corev1.ConditionUnknown)
+
+	build.Status.Phase = v1.BuildPhaseFailed
+	build.Status.SetCondition("Containerquarkus-nativeSucceeded", corev1.ConditionFalse, "Error (1)", "out of memory")
+	assert.True(t, setNativeBuildConditions(kit, build))
+	assertKitCondition(t, kit, v1.IntegrationKitConditionNativeSourcesGenerated, corev1.ConditionTrue)
+	assertKitCondition(t, kit, v1.IntegrationKitConditionNativeExecutableCompiled, corev1.ConditionFalse)
+	assert.Equal(t, "out of memory", kit.Status.GetCondition(v1.IntegrationKitConditionNativeExecutableCompiled).Message)
+}
+
+func TestSetNativeBuildConditionsJvmKit(t *testing.T) {
+	kit := v1.NewIntegrationKit("ns", "my-kit")
+	kit.Labels = map[string]string{
+		v1.IntegrationKitLayoutLabel: v1.IntegrationKitLayoutFastJar,
+	}
+	build := v1.NewBuild("ns", "my-kit")
+	build.Status.Phase = v1.BuildPhaseSucceeded
+
+	assert.False(t, setNativeBuildConditions(kit, build))
+	assert.Empty(t, kit.Status.Conditions)
+}
+
+func assertKitCondition(t *testing.T, kit *v1.IntegrationKit, conditionType v1.IntegrationKitConditionType, status corev1.ConditionStatus) {
+	t.Helper()
+	condition := kit.Status.GetCondition(conditionType)
+	require.NotNil(t, condition)
+	assert.Equal(t, status, condition.Status)
+}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/apache/camel-k/v2/pkg/kamelet/repository"
 	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/trait"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
 	"github.com/apache/camel-k/v2/pkg/util/log"
 	"github.com/apache/camel-k/v2/pkg/util/patch"
@@ -80,6 +81,21 @@ func initializePipe(ctx context.Context, c client.Client, l log.Logger, pipe *v1
 		)
 		return pipe, err
 	}
+	if denied, err := checkPolicies(ctx, c, pipe, it); err != nil {
+		pipe.Status.Phase = v1.PipePhaseError
+		pipe.Status.SetErrorCondition(
+			v1.PipeConditionReady,
+			"IntegrationError",
+			err,
+		)
+		return pipe, err
+	} else if denied {
+		l.Infof("Pipe %s denied by policy", pipe.Name)
+		// The denied Integration is not applied, so that the Integration generated by a previous version of the Pipe,
+		// if any, is left as is, like the workloads of a denied Integration
+		pipe.Status.Phase = v1.PipePhaseError
+		return pipe, nil
+	}
 	if _, err := kubernetes.ReplaceResource(ctx, c, it); err != nil {
 		return nil, fmt.Errorf("could not create integration for Pipe: %w", err)
 	}
@@ -92,6 +108,24 @@ func initializePipe(ctx context.Context, c client.Client, l log.Logger, pipe *v1
 	return target, nil
 }
 
+// checkPolicies reports the compliance of the Integration generated for the Pipe with the IntegrationPolicies, and
+// returns true when a denying policy is violated.
+func checkPolicies(ctx context.Context, c client.Client, pipe *v1.Pipe, it *v1.Integration) (bool, error) {
+	report, err := trait.EvaluatePoliciesFor(ctx, c, it)
+	if err != nil || report == nil {
+		return false, err
+	}
+
+	status, reason, message := report.Condition()
+	pipe.Status.SetCondition(v1.PipeConditionPolicyCompliant, status, reason, message)
+	if !report.Denied() {
+		return false, nil
+	}
+
+	pipe.Status.SetCondition(v1.PipeConditionReady, corev1.ConditionFalse, reason, message)
+	return true, nil
+}
+
 func propagateIcon(ctx context.Context, c client.Client, l log.Logger, pipe *v1.Pipe) {
 	icon, err := findIcon(ctx, c, pipe)
 	if err != nil {
@@ -28,6 +28,7 @@ import (
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 
+	"github.com/apache/camel-k/v2/pkg/util/camel"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
 	"github.com/apache/camel-k/v2/pkg/util/log"
 	"github.com/apache/camel-k/v2/pkg/util/test"
@@ -280,3 +281,57 @@ func TestNewPipeUnsupportedRef(t *testing.T) {
 	assert.Equal(t, "could not find any suitable binding provider for v1/Service my-svc in namespace ns. "+
 		"Bindings available: [\"kamelet\" \"knative-uri\" \"camel-uri\" \"knative-ref\"]", cond.Message)
 }
+
+func TestNewPipeDeniedByPolicy(t *testing.T) {
+	pipe := &v1.Pipe{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.PipeKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-pipe",
+		},
+		Spec: v1.PipeSpec{
+			Source: v1.Endpoint{
+				URI: pointer.String("timer:tick"),
+			},
+			Sink: v1.Endpoint{
+				URI: pointer.String("exec:ls"),
+			},
+		},
+	}
+	defaultCatalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+	catalog := v1.NewCamelCatalog("ns", "camel-k-catalog")
+	catalog.Spec = defaultCatalog.CamelCatalogSpec
+	platform := v1.NewIntegrationPlatform("ns", "camel-k")
+	platform.Status.Phase = v1.IntegrationPlatformPhaseReady
+	platform.Status.Build.RuntimeVersion = catalog.Spec.Runtime.Version
+	policy := v1.NewIntegrationPolicy("ns", "no-exec")
+	policy.Spec.ForbiddenComponents = []string{"exec"}
+	// The Integration generated by a previous version of the Pipe
+	previous := v1.NewIntegration(pipe.Namespace, pipe.Name)
+	c, err := test.NewFakeClient(pipe, &catalog, &platform, &policy, &previous)
+	require.NoError(t, err)
+
+	a := NewInitializeAction()
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledPipe, err := a.Handle(context.TODO(), pipe)
+	require.NoError(t, err)
+	assert.Equal(t, v1.PipePhaseError, handledPipe.Status.Phase)
+	cond := handledPipe.Status.GetCondition(v1.PipeConditionPolicyCompliant)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, "policy no-exec: component exec is forbidden", cond.Message)
+	ready := handledPipe.Status.GetCondition(v1.PipeConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, corev1.ConditionFalse, ready.Status)
+	assert.Equal(t, v1.IntegrationConditionPolicyViolationReason, ready.Reason)
+	// The Integration generated by the previous version of the Pipe is left as is
+	it := v1.NewIntegration(pipe.Namespace, pipe.Name)
+	require.NoError(t, c.Get(context.Background(), ctrl.ObjectKeyFromObject(&it), &it))
+	assert.Empty(t, it.Spec.Sources)
+	assert.Empty(t, it.Spec.Flows)
+}
@@ -47,7 +47,8 @@ func (action *monitorAction) Name() string {
 func (action *monitorAction) CanHandle(binding *v1.Pipe) bool {
 	return binding.Status.Phase == v1.PipePhaseCreating ||
 		binding.Status.Phase == v1.PipePhaseError ||
-		binding.Status.Phase == v1.PipePhaseReady
+		binding.Status.Phase == v1.PipePhaseReady ||
+		binding.Status.Phase == v1.PipePhaseSuspended
 }
 
 func (action *monitorAction) Handle(ctx context.Context, pipe *v1.Pipe) (*v1.Pipe, error) {
@@ -121,6 +122,19 @@ func (action *monitorAction) Handle(ctx context.Context, pipe *v1.Pipe) (*v1.Pip
 		return target, nil
 	}
 
+	// Suspend or resume the Integration along with the Pipe
+	if pipe.IsSuspended() != it.IsSuspended() {
+		patched := it.DeepCopy()
+		if pipe.IsSuspended() {
+			v1.SetAnnotation(&patched.ObjectMeta, v1.IntegrationSuspendedAnnotation, "true")
+		} else {
+			delete(patched.Annotations, v1.IntegrationSuspendedAnnotation)
+		}
+		if err := action.client.Patch(ctx, patched, client.MergeFrom(&it)); err != nil {
+			return nil, fmt.Errorf("could not update integration for Pipe %q: %w", pipe.Name, err)
+		}
+	}
+
 	// Map integration phase and conditions to Pipe
 	target := pipe.DeepCopy()
 
@@ -134,6 +148,10 @@ func (action *monitorAction) Handle(ctx context.Context, pipe *v1.Pipe) (*v1.Pip
 		target.Status.Phase = v1.PipePhaseError
 		setPipeReadyCondition(target, &it)
 
+	case v1.IntegrationPhaseSuspended:
+		target.Status.Phase = v1.PipePhaseSuspended
+		setPipeReadyCondition(target, &it)
+
 	default:
 		target.Status.Phase = v1.PipePhaseCreating
 
@@ -157,6 +175,7 @@ func (action *monitorAction) Handle(ctx context.Context, pipe *v1.Pipe) (*v1.Pip
 	// Mirror status replicas and selector
 	target.Status.Replicas = it.Status.Replicas
 	target.Status.Selector = it.Status.Selector
+	target.Status.Metrics = it.Status.Metrics
 
 	return target, nil
 }
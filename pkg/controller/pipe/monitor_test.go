@@ -24,6 +24,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 
@@ -328,3 +329,68 @@ func TestPipeIntegrationCreatingFromPipeCreatingPhase(t *testing.T) {
 	assert.Equal(t, corev1.ConditionFalse, handledPipe.Status.GetCondition(v1.PipeConditionReady).Status)
 	assert.Equal(t, "Integration \"my-pipe\" is in \"Creating\" phase", handledPipe.Status.GetCondition(v1.PipeConditionReady).Message)
 }
+
+func TestPipeSuspended(t *testing.T) {
+	pipe := &v1.Pipe{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.PipeKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-pipe",
+		},
+		Spec: v1.PipeSpec{
+			Source: v1.Endpoint{
+				URI: pointer.String("timer:tick"),
+			},
+			Sink: v1.Endpoint{
+				URI: pointer.String("log:info"),
+			},
+		},
+		Status: v1.PipeStatus{
+			Phase: v1.PipePhaseReady,
+		},
+	}
+
+	c, err := test.NewFakeClient(pipe)
+	require.NoError(t, err)
+	it, err := CreateIntegrationFor(context.TODO(), c, pipe)
+	require.NoError(t, err)
+	it.Status.Phase = v1.IntegrationPhaseRunning
+	it.Status.SetCondition(v1.IntegrationConditionReady, corev1.ConditionTrue, "Running", "Running")
+	pipe.Annotations = map[string]string{
+		v1.IntegrationSuspendedAnnotation: "true",
+	}
+	c, err = test.NewFakeClient(pipe, it)
+	require.NoError(t, err)
+
+	a := NewMonitorAction()
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledPipe, err := a.Handle(context.TODO(), pipe)
+	require.NoError(t, err)
+	assert.Equal(t, v1.PipePhaseReady, handledPipe.Status.Phase)
+
+	// The suspension is propagated to the Integration
+	updated := v1.NewIntegration("ns", "my-pipe")
+	require.NoError(t, c.Get(context.TODO(), ctrl.ObjectKeyFromObject(&updated), &updated))
+	assert.True(t, updated.IsSuspended())
+
+	// The Pipe is suspended along with the Integration
+	updated.Status.Phase = v1.IntegrationPhaseSuspended
+	updated.Status.SetCondition(v1.IntegrationConditionReady, corev1.ConditionFalse, v1.IntegrationConditionSuspendedReason, "integration suspended")
+	require.NoError(t, c.Update(context.TODO(), &updated))
+	handledPipe, err = a.Handle(context.TODO(), handledPipe)
+	require.NoError(t, err)
+	assert.Equal(t, v1.PipePhaseSuspended, handledPipe.Status.Phase)
+	assert.Equal(t, corev1.ConditionFalse, handledPipe.Status.GetCondition(v1.PipeConditionReady).Status)
+	assert.True(t, a.CanHandle(handledPipe))
+
+	// Resuming the Pipe resumes the Integration
+	delete(handledPipe.Annotations, v1.IntegrationSuspendedAnnotation)
+	_, err = a.Handle(context.TODO(), handledPipe)
+	require.NoError(t, err)
+	require.NoError(t, c.Get(context.TODO(), ctrl.ObjectKeyFromObject(&updated), &updated))
+	assert.False(t, updated.IsSuspended())
+}
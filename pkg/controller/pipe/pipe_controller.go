@@ -99,9 +99,10 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 
 				// Ignore updates to the binding status in which case metadata.Generation
 				// does not change, or except when the binding phase changes as it's used
-				// to transition from one phase to another
+				// to transition from one phase to another, or when the binding is suspended or resumed
 				return oldPipe.Generation != newPipe.Generation ||
-					oldPipe.Status.Phase != newPipe.Status.Phase
+					oldPipe.Status.Phase != newPipe.Status.Phase ||
+					oldPipe.IsSuspended() != newPipe.IsSuspended()
 			},
 			DeleteFunc: func(e event.DeleteEvent) bool {
 				// Evaluates to false if the object has been confirmed deleted
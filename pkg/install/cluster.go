@@ -176,6 +176,12 @@ func installCRDs(ctx context.Context, c client.Client, collection *kubernetes.Co
 		return err
 	}
 
+	// Install CRD for Integration Policy (if needed)
+	if err := installCRD(ctx, c, "IntegrationPolicy", "v1", "camel.apache.org_integrationpolicies.yaml",
+		v1beta1Customizer, collection, force); err != nil {
+		return err
+	}
+
 	// Install CRD for Integration Kit (if needed)
 	if err := installCRD(ctx, c, "IntegrationKit", "v1", "camel.apache.org_integrationkits.yaml",
 		v1beta1Customizer, collection, force); err != nil {
@@ -307,6 +313,11 @@ func areAllCrdInstalled(c client.Client) (int, error) {
 	} else if !ok {
 		return 1, nil
 	}
+	if ok, err := isCrdInstalled(c, "IntegrationPolicy", "v1"); err != nil {
+		return 1, fmt.Errorf("error installing IntegrationPolicy CRDs: %w", err)
+	} else if !ok {
+		return 1, nil
+	}
 	if ok, err := isCrdInstalled(c, "IntegrationKit", "v1"); err != nil {
 		return 2, fmt.Errorf("error installing IntegrationKit CRDs: %w", err)
 	} else if !ok {
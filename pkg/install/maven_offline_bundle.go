@@ -0,0 +1,248 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package install
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/utils/pointer"
+
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/apache/camel-k/v2/pkg/util/defaults"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/maven"
+	"github.com/apache/camel-k/v2/pkg/util/tar"
+)
+
+// MavenOfflineBundleName is the name of the PersistentVolumeClaim holding the Maven offline bundle.
+const MavenOfflineBundleName = "camel-k-maven-offline-bundle"
+
+const (
+	mavenOfflineBundleLoaderPath    = "/bundle"
+	mavenOfflineBundleLoaderTimeout = 5 * time.Minute
+	// The loader runs as the operator user, so that the bundle is readable by the operator and the builder Pods
+	mavenOfflineBundleLoaderUser  = 1001
+	minMavenOfflineBundleCapacity = 1024 * 1024 * 1024
+)
+
+// MavenOfflineBundleConfiguration represents the configuration required to install a Maven offline bundle.
+type MavenOfflineBundleConfiguration struct {
+	Namespace        string
+	Bundle           string
+	LoaderImage      string
+	StorageClassName string
+	AccessMode       corev1.PersistentVolumeAccessMode
+}
+
+// MavenOfflineBundle creates the PersistentVolumeClaim of the Maven offline bundle, when it does not exist,
+// and loads the content of the bundle archive into it, through a short-lived loader Pod.
+func MavenOfflineBundle(ctx context.Context, c client.Client, cfg MavenOfflineBundleConfiguration) error {
+	bundle, err := os.Open(cfg.Bundle)
+	if err != nil {
+		return err
+	}
+	defer util.CloseQuietly(bundle)
+
+	size, err := tar.UncompressedSize(bundle)
+	if err != nil {
+		return fmt.Errorf("invalid Maven offline bundle %s: %w", cfg.Bundle, err)
+	}
+	if _, err := bundle.Seek(0, 0); err != nil {
+		return err
+	}
+
+	pvc, err := kubernetes.LookupPersistentVolumeClaim(ctx, c, cfg.Namespace, MavenOfflineBundleName)
+	if err != nil {
+		return err
+	}
+	if pvc == nil {
+		pvc = kubernetes.NewPersistentVolumeClaim(cfg.Namespace, MavenOfflineBundleName,
+			cfg.StorageClassName, mavenOfflineBundleCapacity(size), cfg.AccessMode)
+		if cfg.StorageClassName == "" {
+			// Use the default storage class
+			pvc.Spec.StorageClassName = nil
+		}
+		pvc.Labels = map[string]string{
+			"app":                        "camel-k",
+			"camel.apache.org/component": "maven-offline-bundle",
+		}
+		if err := c.Create(ctx, pvc); err != nil {
+			return err
+		}
+	}
+
+	pod := newMavenOfflineBundleLoaderPod(cfg)
+	if err := c.Create(ctx, pod); err != nil {
+		return err
+	}
+	defer func() {
+		// The loader is removed whatever the outcome of the loading
+		_ = c.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{})
+	}()
+
+	err = wait.PollUntilContextTimeout(ctx, time.Second, mavenOfflineBundleLoaderTimeout, true, func(ctx context.Context) (bool, error) {
+		p, err := c.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		switch p.Status.Phase {
+		case corev1.PodRunning:
+			return true, nil
+		case corev1.PodFailed, corev1.PodSucceeded:
+			return false, fmt.Errorf("the Maven offline bundle loader Pod %s terminated unexpectedly", pod.Name)
+		default:
+			return false, nil
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return loadMavenOfflineBundle(ctx, c, pod, bundle)
+}
+
+// addMavenOfflineBundleToDeployment mounts the given Maven offline bundle read-only in the containers of the Deployment.
+func addMavenOfflineBundleToDeployment(d *appsv1.Deployment, claimName string) {
+	d.Spec.Template.Spec.Volumes = append(d.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name: MavenOfflineBundleName,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: claimName,
+				ReadOnly:  true,
+			},
+		},
+	})
+	for i := range d.Spec.Template.Spec.Containers {
+		d.Spec.Template.Spec.Containers[i].VolumeMounts = append(d.Spec.Template.Spec.Containers[i].VolumeMounts,
+			corev1.VolumeMount{
+				Name:      MavenOfflineBundleName,
+				MountPath: maven.OfflineBundlePath,
+				ReadOnly:  true,
+			})
+	}
+}
+
+// mavenOfflineBundleCapacity returns the capacity of the PersistentVolumeClaim for a bundle of the given size,
+// leaving some room for the bundle to be updated.
+func mavenOfflineBundleCapacity(size int64) string {
+	capacity := size + size/2
+	if capacity < minMavenOfflineBundleCapacity {
+		capacity = minMavenOfflineBundleCapacity
+	}
+
+	return resource.NewQuantity(capacity, resource.BinarySI).String()
+}
+
+func newMavenOfflineBundleLoaderPod(cfg MavenOfflineBundleConfiguration) *corev1.Pod {
+	image := cfg.LoaderImage
+	if image == "" {
+		// The operator image provides the tar command, and is available wherever the operator can be installed
+		image = defaults.OperatorImage()
+	}
+	securityContext := kubernetes.DefaultOperatorSecurityContext()
+	// The loader image may run as root
+	securityContext.RunAsUser = pointer.Int64(mavenOfflineBundleLoaderUser)
+
+	return &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    cfg.Namespace,
+			GenerateName: MavenOfflineBundleName + "-loader-",
+			Labels: map[string]string{
+				"app":                        "camel-k",
+				"camel.apache.org/component": "maven-offline-bundle",
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers: []corev1.Container{
+				{
+					Name:            "loader",
+					Image:           image,
+					Command:         []string{"sleep", fmt.Sprintf("%.0f", mavenOfflineBundleLoaderTimeout.Seconds()*2)},
+					SecurityContext: securityContext,
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      MavenOfflineBundleName,
+							MountPath: mavenOfflineBundleLoaderPath,
+						},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: MavenOfflineBundleName,
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: MavenOfflineBundleName,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// loadMavenOfflineBundle streams the bundle archive to the loader Pod, that extracts it into the PersistentVolumeClaim.
+func loadMavenOfflineBundle(ctx context.Context, c client.Client, pod *corev1.Pod, bundle *os.File) error {
+	r := c.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("exec")
+
+	r.VersionedParams(&corev1.PodExecOptions{
+		Container: pod.Spec.Containers[0].Name,
+		Command:   []string{"tar", "-xzf", "-", "-C", mavenOfflineBundleLoaderPath},
+		Stdin:     true,
+		Stderr:    true,
+		TTY:       false,
+	}, scheme.ParameterCodec)
+
+	exec, err := remotecommand.NewSPDYExecutor(c.GetConfig(), "POST", r.URL())
+	if err != nil {
+		return err
+	}
+
+	var stderr bytes.Buffer
+	if err := exec.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:  bundle,
+		Stderr: &stderr,
+		Tty:    false,
+	}); err != nil {
+		return fmt.Errorf("failure while loading the Maven offline bundle: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	return nil
+}
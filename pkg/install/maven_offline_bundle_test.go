@@ -0,0 +1,69 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package install
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/apache/camel-k/v2/pkg/util/defaults"
+)
+
+func TestMavenOfflineBundleCapacity(t *testing.T) {
+	assert.Equal(t, "1Gi", mavenOfflineBundleCapacity(0))
+	assert.Equal(t, "1Gi", mavenOfflineBundleCapacity(512*1024*1024))
+	assert.Equal(t, "3Gi", mavenOfflineBundleCapacity(2*1024*1024*1024))
+}
+
+func TestAddMavenOfflineBundleToDeployment(t *testing.T) {
+	d := appsv1.Deployment{}
+	d.Spec.Template.Spec.Containers = []corev1.Container{{Name: "camel-k-operator"}}
+
+	addMavenOfflineBundleToDeployment(&d, "my-bundle")
+
+	assert.Equal(t, []corev1.Volume{{
+		Name: "camel-k-maven-offline-bundle",
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: "my-bundle",
+				ReadOnly:  true,
+			},
+		},
+	}}, d.Spec.Template.Spec.Volumes)
+	assert.Equal(t, []corev1.VolumeMount{{
+		Name:      "camel-k-maven-offline-bundle",
+		MountPath: "/etc/maven/offline-bundle",
+		ReadOnly:  true,
+	}}, d.Spec.Template.Spec.Containers[0].VolumeMounts)
+}
+
+func TestMavenOfflineBundleLoaderPod(t *testing.T) {
+	pod := newMavenOfflineBundleLoaderPod(MavenOfflineBundleConfiguration{Namespace: "ns"})
+
+	container := pod.Spec.Containers[0]
+	assert.Equal(t, defaults.OperatorImage(), container.Image)
+	assert.Equal(t, int64(1001), *container.SecurityContext.RunAsUser)
+	assert.True(t, *container.SecurityContext.RunAsNonRoot)
+	assert.Equal(t, "/bundle", container.VolumeMounts[0].MountPath)
+
+	pod = newMavenOfflineBundleLoaderPod(MavenOfflineBundleConfiguration{Namespace: "ns", LoaderImage: "registry.my.org/busybox"})
+	assert.Equal(t, "registry.my.org/busybox", pod.Spec.Containers[0].Image)
+}
@@ -61,6 +61,7 @@ type OperatorConfiguration struct {
 	NodeSelectors         []string
 	ResourcesRequirements []string
 	EnvVars               []string
+	MavenOfflineBundle    string
 }
 
 type OperatorHealthConfiguration struct {
@@ -166,6 +167,14 @@ func OperatorOrCollect(ctx context.Context, cmd *cobra.Command, c client.Client,
 			}
 		}
 
+		if cfg.MavenOfflineBundle != "" {
+			if d, ok := o.(*appsv1.Deployment); ok {
+				if d.Labels["camel.apache.org/component"] == "operator" {
+					addMavenOfflineBundleToDeployment(d, cfg.MavenOfflineBundle)
+				}
+			}
+		}
+
 		if d, ok := o.(*appsv1.Deployment); ok {
 			if d.Labels["camel.apache.org/component"] == "operator" {
 				// Metrics endpoint port
@@ -295,6 +304,13 @@ func OperatorOrCollect(ctx context.Context, cmd *cobra.Command, c client.Client,
 		fmt.Fprintln(cmd.ErrOrStderr(), "Warning: the operator will not be able to create KEDA resources. Try installing as cluster-admin.")
 	}
 
+	if err = installTektonBindings(ctx, c, cfg.Namespace, customizer, collection, force, cfg.Global); err != nil {
+		if k8serrors.IsAlreadyExists(err) {
+			return err
+		}
+		fmt.Fprintln(cmd.ErrOrStderr(), "Warning: the operator will not be able to run builds with the tekton strategy. Try installing as cluster-admin.")
+	}
+
 	if err = installPodMonitors(ctx, c, cfg.Namespace, customizer, collection, force, cfg.Global); err != nil {
 		if k8serrors.IsAlreadyExists(err) {
 			return err
@@ -528,6 +544,20 @@ func installKedaBindings(ctx context.Context, c client.Client, namespace string,
 	}
 }
 
+func installTektonBindings(ctx context.Context, c client.Client, namespace string, customizer ResourceCustomizer, collection *kubernetes.Collection, force bool, global bool) error {
+	if global {
+		return ResourcesOrCollect(ctx, c, namespace, collection, force, customizer,
+			"/config/rbac/descoped/operator-cluster-role-tekton.yaml",
+			"/config/rbac/descoped/operator-cluster-role-binding-tekton.yaml",
+		)
+	} else {
+		return ResourcesOrCollect(ctx, c, namespace, collection, force, customizer,
+			"/config/rbac/namespaced/operator-role-tekton.yaml",
+			"/config/rbac/namespaced/operator-role-binding-tekton.yaml",
+		)
+	}
+}
+
 func installEvents(ctx context.Context, c client.Client, namespace string, customizer ResourceCustomizer, collection *kubernetes.Collection, force bool, global bool) error {
 	if global {
 		return ResourcesOrCollect(ctx, c, namespace, collection, force, customizer,
@@ -38,6 +38,7 @@ import (
 	"github.com/apache/camel-k/v2/pkg/kamelet/repository"
 	"github.com/apache/camel-k/v2/pkg/util/defaults"
 	"github.com/apache/camel-k/v2/pkg/util/log"
+	"github.com/apache/camel-k/v2/pkg/util/maven"
 	"github.com/apache/camel-k/v2/pkg/util/openshift"
 	image "github.com/apache/camel-k/v2/pkg/util/registry"
 )
@@ -99,7 +100,7 @@ func ConfigureDefaults(ctx context.Context, c client.Client, p *v1.IntegrationPl
 		return err
 	}
 
-	if p.Status.Build.BuildConfiguration.Strategy == v1.BuildStrategyPod {
+	if p.Status.Build.BuildConfiguration.Strategy == v1.BuildStrategyPod || p.Status.Build.BuildConfiguration.Strategy == v1.BuildStrategyTekton {
 		if err := CreateBuilderServiceAccount(ctx, c, p); err != nil {
 			return fmt.Errorf("cannot ensure service account is present: %w", err)
 		}
@@ -282,6 +283,16 @@ func applyPlatformSpec(source *v1.IntegrationPlatform, target *v1.IntegrationPla
 		copy(target.Status.Build.Maven.Extension, source.Status.Build.Maven.Extension)
 	}
 
+	if target.Status.Build.Maven.Cache == nil && source.Status.Build.Maven.Cache != nil {
+		log.Debugf("Integration Platform %s [%s]: setting Maven cache", target.Name, target.Namespace)
+		target.Status.Build.Maven.Cache = source.Status.Build.Maven.Cache.DeepCopy()
+	}
+
+	if target.Status.Build.Maven.OfflineBundle == nil && source.Status.Build.Maven.OfflineBundle != nil {
+		log.Debugf("Integration Platform %s [%s]: setting Maven offline bundle", target.Name, target.Namespace)
+		target.Status.Build.Maven.OfflineBundle = source.Status.Build.Maven.OfflineBundle.DeepCopy()
+	}
+
 	if target.Status.Build.Registry.Address == "" && source.Status.Build.Registry.Address != "" {
 		log.Debugf("Integration Platform %s [%s]: setting registry", target.Name, target.Namespace)
 		source.Status.Build.Registry.DeepCopyInto(&target.Status.Build.Registry)
@@ -304,6 +315,16 @@ func applyPlatformSpec(source *v1.IntegrationPlatform, target *v1.IntegrationPla
 		target.Status.Build.MaxRunningBuilds = source.Status.Build.MaxRunningBuilds
 	}
 
+	if target.Status.Build.KitPooling == nil && source.Status.Build.KitPooling != nil {
+		log.Debugf("Integration Platform %s [%s]: setting kit pooling", target.Name, target.Namespace)
+		target.Status.Build.KitPooling = source.Status.Build.KitPooling.DeepCopy()
+	}
+
+	if target.Status.Build.ExternalBuilder == nil && source.Status.Build.ExternalBuilder != nil {
+		log.Debugf("Integration Platform %s [%s]: setting external builder", target.Name, target.Namespace)
+		target.Status.Build.ExternalBuilder = source.Status.Build.ExternalBuilder.DeepCopy()
+	}
+
 	if len(target.Status.Kamelet.Repositories) == 0 {
 		log.Debugf("Integration Platform %s [%s]: setting kamelet repositories", target.Name, target.Namespace)
 		target.Status.Kamelet.Repositories = source.Status.Kamelet.Repositories
@@ -326,6 +347,10 @@ func setPlatformDefaults(p *v1.IntegrationPlatform, verbose bool) error {
 	if p.Status.Build.Maven.LocalRepository == "" {
 		log.Debugf("Integration Platform %s [%s]: setting local repository", p.Name, p.Namespace)
 		p.Status.Build.Maven.LocalRepository = defaults.LocalRepository
+		if p.Status.Build.Maven.OfflineBundle != nil {
+			// The offline bundle is the read-only local repository of the builds
+			p.Status.Build.Maven.LocalRepository = maven.OfflineBundlePath
+		}
 	}
 	if len(p.Status.Build.Maven.CLIOptions) == 0 {
 		log.Debugf("Integration Platform %s [%s]: setting CLI options", p.Name, p.Namespace)
@@ -358,7 +383,9 @@ func setPlatformDefaults(p *v1.IntegrationPlatform, verbose bool) error {
 		log.Debugf("Integration Platform %s [%s]: setting max running builds", p.Name, p.Namespace)
 		if p.Status.Build.BuildConfiguration.Strategy == v1.BuildStrategyRoutine {
 			p.Status.Build.MaxRunningBuilds = 3
-		} else if p.Status.Build.BuildConfiguration.Strategy == v1.BuildStrategyPod {
+		} else if p.Status.Build.BuildConfiguration.Strategy == v1.BuildStrategyPod ||
+			p.Status.Build.BuildConfiguration.Strategy == v1.BuildStrategyTekton ||
+			p.Status.Build.BuildConfiguration.Strategy == v1.BuildStrategyExternal {
 			p.Status.Build.MaxRunningBuilds = 10
 		}
 	}
@@ -74,6 +74,12 @@ func TestApplyGlobalPlatformSpec(t *testing.T) {
 						"global_prop1": "global_value1",
 						"global_prop2": "global_value2",
 					},
+					Cache: &v1.MavenCacheSpec{
+						PersistentVolumeClaim: "maven-cache",
+					},
+					OfflineBundle: &v1.MavenOfflineBundleSpec{
+						PersistentVolumeClaim: "maven-offline-bundle",
+					},
 				},
 			},
 			Traits: v1.Traits{
@@ -123,6 +129,11 @@ func TestApplyGlobalPlatformSpec(t *testing.T) {
 	assert.Len(t, ip.Status.Build.Maven.Properties, 2)
 	assert.Equal(t, "global_value1", ip.Status.Build.Maven.Properties["global_prop1"])
 	assert.Equal(t, "global_value2", ip.Status.Build.Maven.Properties["global_prop2"])
+	require.NotNil(t, ip.Status.Build.Maven.Cache)
+	assert.Equal(t, "maven-cache", ip.Status.Build.Maven.Cache.PersistentVolumeClaim)
+	require.NotNil(t, ip.Status.Build.Maven.OfflineBundle)
+	assert.Equal(t, "maven-offline-bundle", ip.Status.Build.Maven.OfflineBundle.PersistentVolumeClaim)
+	assert.Equal(t, "/etc/maven/offline-bundle", ip.Status.Build.Maven.LocalRepository)
 }
 
 func TestPlatformS2IhUpdateOverrideLocalPlatformSpec(t *testing.T) {
@@ -0,0 +1,52 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"context"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ListIntegrationPolicies returns the policies enforced on the given resource: the ones found in the resource namespace
+// and the ones found in the operator namespace.
+func ListIntegrationPolicies(ctx context.Context, c k8sclient.Reader, o k8sclient.Object) ([]v1.IntegrationPolicy, error) {
+	namespaces := []string{o.GetNamespace()}
+	if operatorNamespace := GetOperatorNamespace(); operatorNamespace != "" && operatorNamespace != o.GetNamespace() {
+		namespaces = append(namespaces, operatorNamespace)
+	}
+
+	policies := make([]v1.IntegrationPolicy, 0)
+	for _, namespace := range namespaces {
+		lst := v1.NewIntegrationPolicyList()
+		if err := c.List(ctx, &lst, k8sclient.InNamespace(namespace)); err != nil {
+			if k8serrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+				// The IntegrationPolicy CRD may not be installed yet
+				continue
+			}
+			return nil, err
+		}
+		policies = append(policies, lst.Items...)
+	}
+
+	return policies, nil
+}
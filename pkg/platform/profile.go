@@ -85,6 +85,16 @@ func ApplyIntegrationProfile(ctx context.Context, c k8sclient.Reader, ip *v1.Int
 		}
 	}
 
+	if profile.Status.Build.Maven.Cache != nil {
+		log.Debugf("Integration Platform %s [%s]: setting Maven cache", ip.Name, ip.Namespace)
+		ip.Status.Build.Maven.Cache = profile.Status.Build.Maven.Cache.DeepCopy()
+	}
+
+	if profile.Status.Build.Maven.OfflineBundle != nil {
+		log.Debugf("Integration Platform %s [%s]: setting Maven offline bundle", ip.Name, ip.Namespace)
+		ip.Status.Build.Maven.OfflineBundle = profile.Status.Build.Maven.OfflineBundle.DeepCopy()
+	}
+
 	if len(profile.Status.Build.Maven.Extension) > 0 && len(ip.Status.Build.Maven.Extension) == 0 {
 		log.Debugf("Integration Platform %s [%s]: setting Maven extensions", ip.Name, ip.Namespace)
 		ip.Status.Build.Maven.Extension = make([]v1.MavenArtifact, len(profile.Status.Build.Maven.Extension))
@@ -108,6 +118,16 @@ func ApplyIntegrationProfile(ctx context.Context, c k8sclient.Reader, ip *v1.Int
 		ip.Status.Build.Timeout = profile.Status.Build.Timeout
 	}
 
+	if profile.Status.Build.KitPooling != nil {
+		log.Debugf("Integration Platform %s [%s]: setting kit pooling", ip.Name, ip.Namespace)
+		ip.Status.Build.KitPooling = profile.Status.Build.KitPooling.DeepCopy()
+	}
+
+	if profile.Status.Build.ExternalBuilder != nil {
+		log.Debugf("Integration Platform %s [%s]: setting external builder", ip.Name, ip.Namespace)
+		ip.Status.Build.ExternalBuilder = profile.Status.Build.ExternalBuilder.DeepCopy()
+	}
+
 	if len(profile.Status.Kamelet.Repositories) > 0 {
 		log.Debugf("Integration Platform %s [%s]: setting kamelet repositories", ip.Name, ip.Namespace)
 		ip.Status.Kamelet.Repositories = append(ip.Status.Kamelet.Repositories, profile.Status.Kamelet.Repositories...)
@@ -29,6 +29,7 @@ import (
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/util"
 )
 
 const (
@@ -52,7 +53,8 @@ func newAffinityTrait() Trait {
 }
 
 func (t *affinityTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
-	if e.Integration == nil || !pointer.BoolDeref(t.Enabled, false) {
+	// Enabled by default when the Integration image is only available for some architectures
+	if e.Integration == nil || !pointer.BoolDeref(t.Enabled, len(imageArchitectures(e)) > 0) {
 		return false, nil, nil
 	}
 
@@ -84,8 +86,9 @@ func (t *affinityTrait) Apply(e *Environment) error {
 	return nil
 }
 
-func (t *affinityTrait) addNodeAffinity(_ *Environment, podSpec *corev1.PodSpec) error {
-	if len(t.NodeAffinityLabels) == 0 {
+func (t *affinityTrait) addNodeAffinity(e *Environment, podSpec *corev1.PodSpec) error {
+	architectures := imageArchitectures(e)
+	if len(t.NodeAffinityLabels) == 0 && len(architectures) == 0 {
 		return nil
 	}
 
@@ -107,6 +110,14 @@ func (t *affinityTrait) addNodeAffinity(_ *Environment, podSpec *corev1.PodSpec)
 		}
 		nodeSelectorRequirements = append(nodeSelectorRequirements, nodeSelectorRequirement)
 	}
+	// Schedule on the nodes the Integration image is available for, unless the architecture is already constrained
+	if len(architectures) > 0 && !hasNodeSelectorRequirement(nodeSelectorRequirements, corev1.LabelArchStable) {
+		nodeSelectorRequirements = append(nodeSelectorRequirements, corev1.NodeSelectorRequirement{
+			Key:      corev1.LabelArchStable,
+			Operator: corev1.NodeSelectorOpIn,
+			Values:   architectures,
+		})
+	}
 
 	nodeAffinity := &corev1.NodeAffinity{
 		RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
@@ -257,3 +268,28 @@ func operatorToLabelSelectorOperator(operator selection.Operator) (metav1.LabelS
 	}
 	return "", fmt.Errorf("unsupported label selector operator: %s", operator)
 }
+
+// imageArchitectures returns the architectures the Integration image is available for, if known.
+func imageArchitectures(e *Environment) []string {
+	if e.IntegrationKit == nil {
+		return nil
+	}
+	var architectures []string
+	for _, p := range e.IntegrationKit.Status.ImagePlatforms {
+		if parts := strings.Split(p.Platform, "/"); len(parts) > 1 {
+			util.StringSliceUniqueAdd(&architectures, parts[1])
+		}
+	}
+
+	return architectures
+}
+
+func hasNodeSelectorRequirement(requirements []corev1.NodeSelectorRequirement, key string) bool {
+	for _, r := range requirements {
+		if r.Key == key {
+			return true
+		}
+	}
+
+	return false
+}
@@ -192,6 +192,42 @@ func testApplyPodAffinityLabelsDoesSucceed(t *testing.T, trait *affinityTrait, e
 	assert.ElementsMatch(t, [1]string{"integration-name"}, integrationRequirement.Values)
 }
 
+func TestApplyNodeAffinityImageArchitectures(t *testing.T) {
+	affinityTrait, _ := newAffinityTrait().(*affinityTrait)
+	environment, deployment := createNominalDeploymentTraitTest()
+	environment.IntegrationKit = &v1.IntegrationKit{
+		Status: v1.IntegrationKitStatus{
+			ImagePlatforms: []v1.ImagePlatform{
+				{Platform: "linux/amd64", Digest: "sha256:1234"},
+				{Platform: "linux/arm64/v8", Digest: "sha256:5678"},
+			},
+		},
+	}
+
+	// enabled by default when the image architectures are known
+	configured, _, err := affinityTrait.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, configured)
+
+	err = affinityTrait.Apply(environment)
+	require.NoError(t, err)
+
+	nodeSelectorTerms := deployment.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	assert.Equal(t, []corev1.NodeSelectorRequirement{
+		{Key: corev1.LabelArchStable, Operator: corev1.NodeSelectorOpIn, Values: []string{"amd64", "arm64"}},
+	}, nodeSelectorTerms[0].MatchExpressions)
+
+	// the user architecture constraint takes precedence
+	affinityTrait.NodeAffinityLabels = []string{"kubernetes.io/arch=amd64"}
+	err = affinityTrait.Apply(environment)
+	require.NoError(t, err)
+
+	nodeSelectorTerms = deployment.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	assert.Equal(t, []corev1.NodeSelectorRequirement{
+		{Key: corev1.LabelArchStable, Operator: corev1.NodeSelectorOpIn, Values: []string{"amd64"}},
+	}, nodeSelectorTerms[0].MatchExpressions)
+}
+
 func createNominalAffinityTest() *affinityTrait {
 	trait, _ := newAffinityTrait().(*affinityTrait)
 	trait.Enabled = pointer.Bool(true)
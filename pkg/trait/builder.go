@@ -293,7 +293,7 @@ func (t *builderTrait) Apply(e *Environment) error {
 		pipelineTasks = append(pipelineTasks, jibTask)
 
 	case v1.IntegrationPlatformBuildPublishStrategyS2I:
-		pipelineTasks = append(pipelineTasks, v1.Task{S2i: &v1.S2iTask{
+		s2iTask := v1.Task{S2i: &v1.S2iTask{
 			BaseTask: v1.BaseTask{
 				Name:          "s2i",
 				Configuration: *taskConfOrDefault(tasksConf, "s2i"),
@@ -303,7 +303,11 @@ func (t *builderTrait) Apply(e *Environment) error {
 				Image:     imageName,
 			},
 			Tag: e.IntegrationKit.ResourceVersion,
-		}})
+		}}
+		if t.ImagePlatforms != nil {
+			s2iTask.S2i.Configuration.ImagePlatforms = t.ImagePlatforms
+		}
+		pipelineTasks = append(pipelineTasks, s2iTask)
 	}
 
 	// filter only those tasks required by the user
@@ -482,7 +486,9 @@ func (t *builderTrait) determineCustomTasks(e *Environment, builderTask *v1.Buil
 		realBuildStrategy = e.Platform.Status.Build.BuildConfiguration.Strategy
 	}
 
-	if len(t.Tasks) > 0 && realBuildStrategy != v1.BuildStrategyPod {
+	// Custom tasks are run in the builder Pod, as Tekton steps, or by the external builder
+	if len(t.Tasks) > 0 && realBuildStrategy != v1.BuildStrategyPod && realBuildStrategy != v1.BuildStrategyTekton &&
+		realBuildStrategy != v1.BuildStrategyExternal {
 		err := failIntegrationKit(
 			e,
 			"IntegrationKitTasksValid",
@@ -684,3 +684,13 @@ func TestBuilderTraitPlatforms(t *testing.T) {
 
 	assert.Equal(t, []string{"linux/amd64", "linux/arm64"}, env.Pipeline[2].Jib.Configuration.ImagePlatforms)
 }
+
+func TestBuilderTraitPlatformsS2I(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterOpenShift, v1.IntegrationPlatformBuildPublishStrategyS2I, v1.BuildStrategyRoutine)
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.ImagePlatforms = []string{"linux/amd64", "linux/arm64"}
+	err := builderTrait.Apply(env)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"linux/amd64", "linux/arm64"}, env.Pipeline[2].S2i.Configuration.ImagePlatforms)
+}
@@ -20,7 +20,9 @@ package trait
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -35,8 +37,12 @@ import (
 
 const (
 	CamelPropertiesType = "camel-properties"
-	camelTraitID        = "camel"
-	camelTraitOrder     = 200
+
+	camelTraitID    = "camel"
+	camelTraitOrder = 200
+
+	// controlledRoutesProperty is the Camel property excluding routes from the routes started automatically
+	controlledRoutesProperty = "camel.main.auto-startup-exclude-pattern"
 )
 
 type camelTrait struct {
@@ -177,6 +183,12 @@ func (t *camelTrait) computeConfigMaps(e *Environment) []ctrl.Object {
 		}
 	}
 
+	if routes := controlledRoutesExcludePattern(e.Integration); routes != "" {
+		// The routes stopped or suspended with the kamel route command must not start in the Pods started afterwards,
+		// e.g. when scaling the integration. The ConfigMap is read by the new Pods only, with no rollout.
+		userProperties += fmt.Sprintf("%s=%s\n", controlledRoutesProperty, routes)
+	}
+
 	if userProperties != "" {
 		maps = append(
 			maps,
@@ -252,3 +264,16 @@ func determineRuntimeVersion(e *Environment) (string, error) {
 	}
 	return "", errors.New("unable to determine runtime version")
 }
+
+// controlledRoutesExcludePattern returns the ids of the routes stopped or suspended with the kamel route command,
+// as a pattern excluding them from the routes automatically started by Camel.
+func controlledRoutesExcludePattern(it *v1.Integration) string {
+	routes := it.GetControlledRoutes()
+	ids := make([]string, 0, len(routes))
+	for id := range routes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return strings.Join(ids, ",")
+}
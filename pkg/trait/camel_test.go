@@ -207,6 +207,28 @@ func TestApplyCamelTraitWithProperties(t *testing.T) {
 	}, userPropertiesCm.Data)
 }
 
+func TestApplyCamelTraitWithControlledRoutes(t *testing.T) {
+	trait, environment := createNominalCamelTest(false)
+	trait.Properties = []string{"a=b"}
+	environment.Integration.SetControlledRoutes(map[string]string{"route2": "Suspended", "route1": "Stopped"})
+
+	configured, condition, err := trait.Configure(environment)
+	require.NoError(t, err)
+	assert.Nil(t, condition)
+	assert.True(t, configured)
+
+	err = trait.Apply(environment)
+	require.NoError(t, err)
+
+	userPropertiesCm := environment.Resources.GetConfigMap(func(cm *corev1.ConfigMap) bool {
+		return cm.Labels["camel.apache.org/properties.type"] == "user"
+	})
+	assert.NotNil(t, userPropertiesCm)
+	assert.Equal(t, map[string]string{
+		"application.properties": "a=b\ncamel.main.auto-startup-exclude-pattern=route1,route2\n",
+	}, userPropertiesCm.Data)
+}
+
 func TestApplyCamelTraitSyntheticKitWithProperties(t *testing.T) {
 	trait, environment := createNominalCamelTest(false)
 	trait.Properties = []string{"a=b", "c=d"}
@@ -636,3 +636,26 @@ func TestCronWithBackoffLimit(t *testing.T) {
 	assert.NotNil(t, cronJob.Spec.JobTemplate.Spec.BackoffLimit)
 	assert.EqualValues(t, *cronJob.Spec.JobTemplate.Spec.BackoffLimit, 5)
 }
+
+func TestCronJobSuspended(t *testing.T) {
+	ct, _ := newCronTrait().(*cronTrait)
+	ct.Schedule = "0 0/2 * * ?"
+	environment := Environment{
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "ns",
+			},
+		},
+	}
+
+	cronJob := ct.getCronJobFor(&environment)
+	assert.Equal(t, pointer.Bool(false), cronJob.Spec.Suspend)
+
+	environment.Integration.Annotations = map[string]string{
+		v1.IntegrationSuspendedAnnotation: "true",
+	}
+	cronJob = ct.getCronJobFor(&environment)
+	assert.Equal(t, pointer.Bool(true), cronJob.Spec.Suspend)
+	assert.NotContains(t, cronJob.Spec.JobTemplate.Spec.Template.Annotations, v1.IntegrationSuspendedAnnotation)
+}
@@ -56,7 +56,7 @@ func (t *deploymentTrait) Configure(e *Environment) (bool, *TraitCondition, erro
 		return false, nil, nil
 	}
 
-	if e.IntegrationInPhase(v1.IntegrationPhaseRunning, v1.IntegrationPhaseError) {
+	if e.IntegrationInPhase(v1.IntegrationPhaseRunning, v1.IntegrationPhaseError, v1.IntegrationPhaseSuspended) {
 		condition := e.Integration.Status.GetCondition(v1.IntegrationConditionDeploymentAvailable)
 		return condition != nil && condition.Status == corev1.ConditionTrue, nil, nil
 	}
@@ -197,6 +197,11 @@ func (t *deploymentTrait) getDeploymentFor(e *Environment) *appsv1.Deployment {
 		one := int32(1)
 		replicas = &one
 	}
+	if e.Integration.IsInactive() {
+		// Keep the Deployment, scaled down to zero, while the Integration is suspended
+		zero := int32(0)
+		replicas = &zero
+	}
 	deployment.Spec.Replicas = replicas
 
 	return &deployment
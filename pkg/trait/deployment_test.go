@@ -120,6 +120,30 @@ func TestApplyDeploymentTraitWhileRunningIntegrationDoesSucceed(t *testing.T) {
 	assert.Equal(t, int32(60), *deployment.Spec.ProgressDeadlineSeconds)
 }
 
+func TestApplyDeploymentTraitWhileIntegrationIsSuspended(t *testing.T) {
+	deploymentTrait, environment := createNominalDeploymentTest()
+	environment.Integration.Status.SetCondition(
+		v1.IntegrationConditionDeploymentAvailable,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionDeploymentAvailableReason,
+		"deployment-name",
+	)
+	environment.Integration.Status.Phase = v1.IntegrationPhaseSuspended
+	environment.Integration.Annotations = map[string]string{
+		v1.IntegrationSuspendedAnnotation: "true",
+	}
+
+	configured, _, err := deploymentTrait.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, configured)
+	require.NoError(t, deploymentTrait.Apply(environment))
+
+	deployment := environment.Resources.GetDeployment(func(deployment *appsv1.Deployment) bool { return true })
+	require.NotNil(t, deployment)
+	assert.Equal(t, int32(0), *deployment.Spec.Replicas)
+	assert.NotContains(t, deployment.Spec.Template.Annotations, v1.IntegrationSuspendedAnnotation)
+}
+
 func TestApplyDeploymentTraitWithProgressDeadline(t *testing.T) {
 	deploymentTrait, environment := createNominalDeploymentTest()
 	progressDeadlineSeconds := int32(120)
@@ -232,8 +232,13 @@ func (t *gcTrait) deleteEachOf(ctx context.Context, deletableGVKs map[schema.Gro
 
 func (t *gcTrait) canBeDeleted(e *Environment, u unstructured.Unstructured) bool {
 	// Only delete direct children of the integration, otherwise we can affect the behavior of external controllers (i.e. Knative)
+	return isIntegrationChild(e.Integration, u)
+}
+
+// isIntegrationChild returns true when the resource is owned by the Integration.
+func isIntegrationChild(it *v1.Integration, u unstructured.Unstructured) bool {
 	for _, o := range u.GetOwnerReferences() {
-		if o.Kind == v1.IntegrationKind && strings.HasPrefix(o.APIVersion, v1.SchemeGroupVersion.Group) && o.Name == e.Integration.Name {
+		if o.Kind == v1.IntegrationKind && strings.HasPrefix(o.APIVersion, v1.SchemeGroupVersion.Group) && o.Name == it.Name {
 			return true
 		}
 	}
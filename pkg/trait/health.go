@@ -123,7 +123,7 @@ func (t *healthTrait) Apply(e *Environment) error {
 	}
 
 	var port *intstr.IntOrString
-	containerPort := e.getIntegrationContainerPort()
+	containerPort := e.GetIntegrationContainerPort()
 	if containerPort == nil {
 		containerPort = e.createContainerPort()
 	}
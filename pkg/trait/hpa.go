@@ -0,0 +1,227 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"errors"
+	"fmt"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+)
+
+const (
+	hpaTraitID    = "hpa"
+	hpaTraitOrder = 1150
+
+	defaultHPAMinReplicas              = int32(1)
+	defaultHPAMaxReplicas              = int32(10)
+	defaultHPACPUUtilization           = int32(80)
+	defaultHPAInflightExchangesMetric  = "camel_exchanges_inflight"
+	defaultHPAExchangesPerSecondMetric = "camel_exchanges_per_second"
+)
+
+type hpaTrait struct {
+	BaseTrait
+	traitv1.HPATrait `property:",squash"`
+}
+
+func newHPATrait() Trait {
+	return &hpaTrait{
+		BaseTrait: NewBaseTrait(hpaTraitID, hpaTraitOrder),
+	}
+}
+
+func (t *hpaTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil || !pointer.BoolDeref(t.Enabled, false) {
+		return false, nil, nil
+	}
+	if !e.IntegrationInRunningPhases() {
+		return false, nil, nil
+	}
+
+	strategy, err := e.DetermineControllerStrategy()
+	if err != nil {
+		return false, nil, fmt.Errorf("unable to determine the controller strategy")
+	}
+	if strategy != ControllerStrategyDeployment {
+		return false, nil, fmt.Errorf("horizontalpodautoscaler isn't supported with %s controller strategy", strategy)
+	}
+
+	minReplicas := pointer.Int32Deref(t.MinReplicas, defaultHPAMinReplicas)
+	maxReplicas := pointer.Int32Deref(t.MaxReplicas, defaultHPAMaxReplicas)
+	if minReplicas < 1 {
+		return false, nil, errors.New("minReplicas must be greater than 0")
+	}
+	if maxReplicas < minReplicas {
+		return false, nil, fmt.Errorf("maxReplicas (%d) must be greater than or equal to minReplicas (%d)", maxReplicas, minReplicas)
+	}
+
+	for _, target := range []string{t.InflightExchanges, t.ExchangesPerSecond} {
+		if target == "" {
+			continue
+		}
+		if _, err := resource.ParseQuantity(target); err != nil {
+			return false, nil, fmt.Errorf("invalid metric target %q: %w", target, err)
+		}
+		if !t.isPrometheusEnabled(e) {
+			return false, nil, errors.New("the prometheus trait must be enabled to scale on Camel metrics")
+		}
+	}
+
+	return true, nil, nil
+}
+
+func (t *hpaTrait) Apply(e *Environment) error {
+	hpa, err := t.horizontalPodAutoscalerFor(e)
+	if err != nil {
+		return err
+	}
+	e.Resources.Add(hpa)
+
+	return nil
+}
+
+func (t *hpaTrait) isPrometheusEnabled(e *Environment) bool {
+	if e.Catalog == nil {
+		return false
+	}
+	pt, ok := e.Catalog.GetTrait(prometheusTraitID).(*prometheusTrait)
+
+	return ok && pointer.BoolDeref(pt.Enabled, false)
+}
+
+func (t *hpaTrait) horizontalPodAutoscalerFor(e *Environment) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "HorizontalPodAutoscaler",
+			APIVersion: autoscalingv2.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.Integration.Name,
+			Namespace: e.Integration.Namespace,
+			Labels:    e.Integration.Labels,
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: t.scaleTargetRef(e),
+			MinReplicas:    pointer.Int32(pointer.Int32Deref(t.MinReplicas, defaultHPAMinReplicas)),
+			MaxReplicas:    pointer.Int32Deref(t.MaxReplicas, defaultHPAMaxReplicas),
+		},
+	}
+
+	cpuUtilization := t.CPUUtilization
+	if cpuUtilization == nil && t.MemoryUtilization == nil && t.InflightExchanges == "" && t.ExchangesPerSecond == "" {
+		cpuUtilization = pointer.Int32(defaultHPACPUUtilization)
+	}
+	if cpuUtilization != nil {
+		hpa.Spec.Metrics = append(hpa.Spec.Metrics, resourceMetric(corev1.ResourceCPU, *cpuUtilization))
+	}
+	if t.MemoryUtilization != nil {
+		hpa.Spec.Metrics = append(hpa.Spec.Metrics, resourceMetric(corev1.ResourceMemory, *t.MemoryUtilization))
+	}
+	if t.InflightExchanges != "" {
+		name := t.InflightExchangesMetric
+		if name == "" {
+			name = defaultHPAInflightExchangesMetric
+		}
+		metric, err := podsMetric(name, t.InflightExchanges)
+		if err != nil {
+			return nil, err
+		}
+		hpa.Spec.Metrics = append(hpa.Spec.Metrics, metric)
+	}
+	if t.ExchangesPerSecond != "" {
+		name := t.ExchangesPerSecondMetric
+		if name == "" {
+			name = defaultHPAExchangesPerSecondMetric
+		}
+		metric, err := podsMetric(name, t.ExchangesPerSecond)
+		if err != nil {
+			return nil, err
+		}
+		hpa.Spec.Metrics = append(hpa.Spec.Metrics, metric)
+	}
+
+	if t.ScaleDownStabilizationWindow != nil {
+		hpa.Spec.Behavior = &autoscalingv2.HorizontalPodAutoscalerBehavior{
+			ScaleDown: &autoscalingv2.HPAScalingRules{
+				StabilizationWindowSeconds: t.ScaleDownStabilizationWindow,
+			},
+		}
+	}
+
+	return hpa, nil
+}
+
+// scaleTargetRef references the scale subresource of the Pipe owning the Integration, if any, or of the Integration.
+func (t *hpaTrait) scaleTargetRef(e *Environment) autoscalingv2.CrossVersionObjectReference {
+	for _, o := range e.Integration.OwnerReferences {
+		if o.Kind == v1.PipeKind && o.APIVersion == v1.SchemeGroupVersion.String() {
+			return autoscalingv2.CrossVersionObjectReference{
+				APIVersion: o.APIVersion,
+				Kind:       o.Kind,
+				Name:       o.Name,
+			}
+		}
+	}
+
+	return autoscalingv2.CrossVersionObjectReference{
+		APIVersion: v1.SchemeGroupVersion.String(),
+		Kind:       v1.IntegrationKind,
+		Name:       e.Integration.Name,
+	}
+}
+
+func resourceMetric(name corev1.ResourceName, utilization int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: name,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: pointer.Int32(utilization),
+			},
+		},
+	}
+}
+
+func podsMetric(name string, target string) (autoscalingv2.MetricSpec, error) {
+	value, err := resource.ParseQuantity(target)
+	if err != nil {
+		return autoscalingv2.MetricSpec{}, err
+	}
+
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.PodsMetricSourceType,
+		Pods: &autoscalingv2.PodsMetricSource{
+			Metric: autoscalingv2.MetricIdentifier{
+				Name: name,
+			},
+			Target: autoscalingv2.MetricTarget{
+				Type:         autoscalingv2.AverageValueMetricType,
+				AverageValue: &value,
+			},
+		},
+	}, nil
+}
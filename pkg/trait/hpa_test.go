@@ -0,0 +1,190 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+func TestConfigureHPATraitDoesSucceed(t *testing.T) {
+	hpaTrait, environment := createHPATest()
+	configured, condition, err := hpaTrait.Configure(environment)
+
+	assert.True(t, configured)
+	require.NoError(t, err)
+	assert.Nil(t, condition)
+}
+
+func TestConfigureHPATraitDoesNotSucceed(t *testing.T) {
+	hpaTrait, environment := createHPATest()
+	hpaTrait.MinReplicas = pointer.Int32(3)
+	hpaTrait.MaxReplicas = pointer.Int32(2)
+	configured, _, err := hpaTrait.Configure(environment)
+	require.EqualError(t, err, "maxReplicas (2) must be greater than or equal to minReplicas (3)")
+	assert.False(t, configured)
+
+	hpaTrait, environment = createHPATest()
+	hpaTrait.InflightExchanges = "ten"
+	configured, _, err = hpaTrait.Configure(environment)
+	require.Error(t, err)
+	assert.False(t, configured)
+}
+
+func TestConfigureHPATraitWithCronStrategy(t *testing.T) {
+	hpaTrait, environment := createHPATest()
+	cron, _ := environment.Catalog.GetTrait(cronTraitID).(*cronTrait)
+	cron.CronTrait = traitv1.CronTrait{
+		Trait:    traitv1.Trait{Enabled: pointer.Bool(true)},
+		Schedule: "* * * * *",
+	}
+	environment.ConfiguredTraits = []Trait{cron}
+	configured, _, err := hpaTrait.Configure(environment)
+
+	require.EqualError(t, err, "horizontalpodautoscaler isn't supported with cron-job controller strategy")
+	assert.False(t, configured)
+}
+
+func TestConfigureHPATraitRequiresPrometheusForCamelMetrics(t *testing.T) {
+	hpaTrait, environment := createHPATest()
+	hpaTrait.InflightExchanges = "10"
+	configured, _, err := hpaTrait.Configure(environment)
+	require.EqualError(t, err, "the prometheus trait must be enabled to scale on Camel metrics")
+	assert.False(t, configured)
+
+	environment.Catalog.GetTrait(prometheusTraitID).(*prometheusTrait).Enabled = pointer.Bool(true)
+	configured, _, err = hpaTrait.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, configured)
+}
+
+func TestHPAIsCreatedWithDefaults(t *testing.T) {
+	hpaTrait, environment := createHPATest()
+
+	hpa := hpaCreatedCheck(t, hpaTrait, environment)
+	assert.Equal(t, autoscalingv2.CrossVersionObjectReference{
+		APIVersion: v1.SchemeGroupVersion.String(),
+		Kind:       v1.IntegrationKind,
+		Name:       "integration-name",
+	}, hpa.Spec.ScaleTargetRef)
+	assert.Equal(t, pointer.Int32(1), hpa.Spec.MinReplicas)
+	assert.Equal(t, int32(10), hpa.Spec.MaxReplicas)
+	require.Len(t, hpa.Spec.Metrics, 1)
+	assert.Equal(t, corev1.ResourceCPU, hpa.Spec.Metrics[0].Resource.Name)
+	assert.Equal(t, pointer.Int32(80), hpa.Spec.Metrics[0].Resource.Target.AverageUtilization)
+	assert.Nil(t, hpa.Spec.Behavior)
+}
+
+func TestHPAIsCreatedWithCamelMetrics(t *testing.T) {
+	hpaTrait, environment := createHPATest()
+	hpaTrait.MinReplicas = pointer.Int32(2)
+	hpaTrait.MaxReplicas = pointer.Int32(5)
+	hpaTrait.MemoryUtilization = pointer.Int32(70)
+	hpaTrait.InflightExchanges = "10"
+	hpaTrait.ExchangesPerSecond = "500m"
+	hpaTrait.ExchangesPerSecondMetric = "camel_exchanges_rate"
+	hpaTrait.ScaleDownStabilizationWindow = pointer.Int32(600)
+
+	hpa := hpaCreatedCheck(t, hpaTrait, environment)
+	assert.Equal(t, pointer.Int32(2), hpa.Spec.MinReplicas)
+	assert.Equal(t, int32(5), hpa.Spec.MaxReplicas)
+	require.Len(t, hpa.Spec.Metrics, 3)
+	assert.Equal(t, corev1.ResourceMemory, hpa.Spec.Metrics[0].Resource.Name)
+	assert.Equal(t, pointer.Int32(70), hpa.Spec.Metrics[0].Resource.Target.AverageUtilization)
+	assert.Equal(t, "camel_exchanges_inflight", hpa.Spec.Metrics[1].Pods.Metric.Name)
+	assert.Equal(t, resource.MustParse("10"), *hpa.Spec.Metrics[1].Pods.Target.AverageValue)
+	assert.Equal(t, "camel_exchanges_rate", hpa.Spec.Metrics[2].Pods.Metric.Name)
+	assert.Equal(t, resource.MustParse("500m"), *hpa.Spec.Metrics[2].Pods.Target.AverageValue)
+	assert.Equal(t, pointer.Int32(600), hpa.Spec.Behavior.ScaleDown.StabilizationWindowSeconds)
+}
+
+func TestHPATargetsOwnerPipe(t *testing.T) {
+	hpaTrait, environment := createHPATest()
+	environment.Integration.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: v1.SchemeGroupVersion.String(),
+		Kind:       v1.PipeKind,
+		Name:       "my-pipe",
+	}}
+
+	hpa := hpaCreatedCheck(t, hpaTrait, environment)
+	assert.Equal(t, autoscalingv2.CrossVersionObjectReference{
+		APIVersion: v1.SchemeGroupVersion.String(),
+		Kind:       v1.PipeKind,
+		Name:       "my-pipe",
+	}, hpa.Spec.ScaleTargetRef)
+}
+
+func hpaCreatedCheck(t *testing.T, hpaTrait *hpaTrait, environment *Environment) *autoscalingv2.HorizontalPodAutoscaler {
+	t.Helper()
+
+	err := hpaTrait.Apply(environment)
+	require.NoError(t, err)
+	hpa := findHPA(environment.Resources)
+
+	require.NotNil(t, hpa)
+	assert.Equal(t, environment.Integration.Name, hpa.Name)
+	assert.Equal(t, environment.Integration.Namespace, hpa.Namespace)
+	assert.Equal(t, environment.Integration.Labels, hpa.Labels)
+	return hpa
+}
+
+func findHPA(resources *kubernetes.Collection) *autoscalingv2.HorizontalPodAutoscaler {
+	for _, a := range resources.Items() {
+		if hpa, ok := a.(*autoscalingv2.HorizontalPodAutoscaler); ok {
+			return hpa
+		}
+	}
+	return nil
+}
+
+func createHPATest() (*hpaTrait, *Environment) {
+	catalog := NewCatalog(nil)
+	trait, _ := catalog.GetTrait(hpaTraitID).(*hpaTrait)
+	trait.Enabled = pointer.Bool(true)
+
+	environment := &Environment{
+		Catalog: catalog,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "integration-name",
+				Namespace: "ns",
+				Labels: map[string]string{
+					v1.IntegrationLabel: "integration-name",
+				},
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Resources: kubernetes.NewCollection(),
+	}
+
+	return trait, environment
+}
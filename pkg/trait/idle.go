@@ -0,0 +1,126 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+)
+
+const (
+	idleTraitID    = "idle"
+	idleTraitOrder = 1160
+
+	// DefaultIdleTimeout is the default period without any exchange after which an Integration is scaled down to zero.
+	DefaultIdleTimeout = 15 * time.Minute
+)
+
+type idleTrait struct {
+	BaseTrait
+	traitv1.IdleTrait `property:",squash"`
+}
+
+func newIdleTrait() Trait {
+	return &idleTrait{
+		BaseTrait: NewBaseTrait(idleTraitID, idleTraitOrder),
+	}
+}
+
+func (t *idleTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil || !pointer.BoolDeref(t.Enabled, false) {
+		return false, nil, nil
+	}
+	if !e.IntegrationInRunningPhases() {
+		return false, nil, nil
+	}
+
+	strategy, err := e.DetermineControllerStrategy()
+	if err != nil {
+		return false, nil, fmt.Errorf("unable to determine the controller strategy")
+	}
+	if strategy != ControllerStrategyDeployment {
+		return false, nil, fmt.Errorf("scaling to zero when idle isn't supported with %s controller strategy", strategy)
+	}
+	if _, err := ParseIdleTimeout(t.Timeout); err != nil {
+		return false, nil, err
+	}
+	if _, err := ParseWakeSchedule(t.WakeSchedule); err != nil {
+		return false, nil, err
+	}
+	if e.Catalog != nil {
+		if pt, ok := e.Catalog.GetTrait(prometheusTraitID).(*prometheusTrait); !ok || !pointer.BoolDeref(pt.Enabled, false) {
+			return false, nil, errors.New("the prometheus trait must be enabled to detect idle periods")
+		}
+		if ht, ok := e.Catalog.GetTrait(hpaTraitID).(*hpaTrait); ok && pointer.BoolDeref(ht.Enabled, false) {
+			return false, nil, errors.New("the idle trait cannot be enabled together with the hpa trait")
+		}
+	}
+
+	return true, nil, nil
+}
+
+func (t *idleTrait) Apply(e *Environment) error {
+	// Start the idle period with the deployment of the Integration
+	if e.Integration.Status.GetCondition(v1.IntegrationConditionIdle) == nil {
+		e.Integration.Status.SetCondition(
+			v1.IntegrationConditionIdle,
+			corev1.ConditionFalse,
+			v1.IntegrationConditionActiveReason,
+			"integration deployed",
+		)
+	}
+
+	return nil
+}
+
+// ParseIdleTimeout parses the idle trait timeout, defaulting to DefaultIdleTimeout.
+func ParseIdleTimeout(timeout string) (time.Duration, error) {
+	if timeout == "" {
+		return DefaultIdleTimeout, nil
+	}
+	d, err := time.ParseDuration(timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid idle timeout %q: %w", timeout, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid idle timeout %q: must be positive", timeout)
+	}
+
+	return d, nil
+}
+
+// ParseWakeSchedule parses the idle trait wake schedule, returning nil if it is not set.
+func ParseWakeSchedule(schedule string) (cron.Schedule, error) {
+	if schedule == "" {
+		return nil, nil
+	}
+	s, err := cron.ParseStandard(schedule)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wake schedule %q: %w", schedule, err)
+	}
+
+	return s, nil
+}
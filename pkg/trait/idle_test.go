@@ -0,0 +1,146 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+func TestConfigureIdleTraitDoesSucceed(t *testing.T) {
+	idleTrait, environment := createIdleTest()
+	configured, condition, err := idleTrait.Configure(environment)
+
+	assert.True(t, configured)
+	require.NoError(t, err)
+	assert.Nil(t, condition)
+}
+
+func TestConfigureIdleTraitDisabled(t *testing.T) {
+	idleTrait, environment := createIdleTest()
+	idleTrait.Enabled = nil
+	configured, _, err := idleTrait.Configure(environment)
+
+	assert.False(t, configured)
+	require.NoError(t, err)
+}
+
+func TestConfigureIdleTraitDoesNotSucceed(t *testing.T) {
+	idleTrait, environment := createIdleTest()
+	idleTrait.Timeout = "forever"
+	configured, _, err := idleTrait.Configure(environment)
+	require.Error(t, err)
+	assert.False(t, configured)
+
+	idleTrait, environment = createIdleTest()
+	idleTrait.Timeout = "-1m"
+	configured, _, err = idleTrait.Configure(environment)
+	require.EqualError(t, err, `invalid idle timeout "-1m": must be positive`)
+	assert.False(t, configured)
+
+	idleTrait, environment = createIdleTest()
+	idleTrait.WakeSchedule = "every hour"
+	configured, _, err = idleTrait.Configure(environment)
+	require.Error(t, err)
+	assert.False(t, configured)
+
+	idleTrait, environment = createIdleTest()
+	environment.Catalog.GetTrait(prometheusTraitID).(*prometheusTrait).Enabled = nil
+	configured, _, err = idleTrait.Configure(environment)
+	require.EqualError(t, err, "the prometheus trait must be enabled to detect idle periods")
+	assert.False(t, configured)
+
+	idleTrait, environment = createIdleTest()
+	environment.Catalog.GetTrait(hpaTraitID).(*hpaTrait).Enabled = pointer.Bool(true)
+	configured, _, err = idleTrait.Configure(environment)
+	require.EqualError(t, err, "the idle trait cannot be enabled together with the hpa trait")
+	assert.False(t, configured)
+}
+
+func TestConfigureIdleTraitWithCronStrategy(t *testing.T) {
+	idleTrait, environment := createIdleTest()
+	cron, _ := environment.Catalog.GetTrait(cronTraitID).(*cronTrait)
+	cron.CronTrait = traitv1.CronTrait{
+		Trait:    traitv1.Trait{Enabled: pointer.Bool(true)},
+		Schedule: "* * * * *",
+	}
+	environment.ConfiguredTraits = []Trait{cron}
+	configured, _, err := idleTrait.Configure(environment)
+
+	require.EqualError(t, err, "scaling to zero when idle isn't supported with cron-job controller strategy")
+	assert.False(t, configured)
+}
+
+func TestApplyIdleTraitSetsCondition(t *testing.T) {
+	idleTrait, environment := createIdleTest()
+	require.NoError(t, idleTrait.Apply(environment))
+
+	cond := environment.Integration.Status.GetCondition(v1.IntegrationConditionIdle)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, v1.IntegrationConditionActiveReason, cond.Reason)
+
+	// An existing condition is left untouched
+	environment.Integration.Status.SetCondition(v1.IntegrationConditionIdle, corev1.ConditionTrue, v1.IntegrationConditionIdleReason, "idle")
+	require.NoError(t, idleTrait.Apply(environment))
+	cond = environment.Integration.Status.GetCondition(v1.IntegrationConditionIdle)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+}
+
+func TestParseWakeSchedule(t *testing.T) {
+	schedule, err := ParseWakeSchedule("")
+	require.NoError(t, err)
+	assert.Nil(t, schedule)
+
+	schedule, err = ParseWakeSchedule("0 * * * *")
+	require.NoError(t, err)
+	assert.NotNil(t, schedule)
+}
+
+func createIdleTest() (*idleTrait, *Environment) {
+	catalog := NewCatalog(nil)
+	trait, _ := catalog.GetTrait(idleTraitID).(*idleTrait)
+	trait.Enabled = pointer.Bool(true)
+	catalog.GetTrait(prometheusTraitID).(*prometheusTrait).Enabled = pointer.Bool(true)
+
+	environment := &Environment{
+		Catalog: catalog,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "integration-name",
+				Namespace: "ns",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Resources: kubernetes.NewCollection(),
+	}
+
+	return trait, environment
+}
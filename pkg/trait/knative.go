@@ -28,12 +28,16 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/pointer"
 
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 
 	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
+	messaging "knative.dev/eventing/pkg/apis/messaging/v1"
+	sources "knative.dev/eventing/pkg/apis/sources/v1"
 	serving "knative.dev/serving/pkg/apis/serving/v1"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
@@ -52,6 +56,13 @@ const (
 	knativeHistoryHeader = "ce-knativehistory"
 )
 
+// knativeEventingKinds are the kinds of the resources delivering the events to the Integration.
+var knativeEventingKinds = []schema.GroupVersionKind{
+	eventing.SchemeGroupVersion.WithKind("Trigger"),
+	messaging.SchemeGroupVersion.WithKind("Subscription"),
+	sources.SchemeGroupVersion.WithKind("SinkBinding"),
+}
+
 type knativeTrait struct {
 	BaseTrait
 	traitv1.KnativeTrait `property:",squash"`
@@ -214,6 +225,10 @@ func (t *knativeTrait) Apply(e *Environment) error {
 	if err := t.configureSinkBinding(e, &env); err != nil {
 		return err
 	}
+	if e.Integration.IsInactive() {
+		// Stop the delivery of the events to the Integration while it is inactive
+		e.PostActions = append(e.PostActions, t.deleteEventingResources)
+	}
 	if e.ApplicationProperties == nil {
 		e.ApplicationProperties = make(map[string]string)
 	}
@@ -283,6 +298,10 @@ func (t *knativeTrait) configureChannels(e *Environment, env *knativeapi.CamelEn
 }
 
 func (t *knativeTrait) createSubscription(e *Environment, ref *corev1.ObjectReference, path string) error {
+	if e.Integration.IsInactive() {
+		// No event is delivered to the Integration while it is inactive
+		return nil
+	}
 	if ref.Namespace == "" {
 		ref.Namespace = e.Integration.Namespace
 	}
@@ -441,7 +460,7 @@ func (t *knativeTrait) configureSinkBinding(e *Environment, env *knativeapi.Came
 		// Mark the service which will be used as SinkBinding
 		env.SetSinkBinding(ref.Name, knativeapi.CamelEndpointKindSink, serviceType, ref.APIVersion, ref.Kind)
 
-		if !e.IntegrationInPhase(v1.IntegrationPhaseDeploying, v1.IntegrationPhaseRunning) {
+		if !e.IntegrationInPhase(v1.IntegrationPhaseDeploying, v1.IntegrationPhaseRunning) || e.Integration.IsInactive() {
 			return nil
 		}
 
@@ -501,6 +520,10 @@ func (t *knativeTrait) configureSinkBinding(e *Environment, env *knativeapi.Came
 }
 
 func (t *knativeTrait) createTrigger(e *Environment, ref *corev1.ObjectReference, eventType string, path string) error {
+	if e.Integration.IsInactive() {
+		// No event is delivered to the Integration while it is inactive
+		return nil
+	}
 	// TODO extend to additional filters too, to filter them at source and not at destination
 	found := e.Resources.HasKnativeTrigger(func(trigger *eventing.Trigger) bool {
 		return trigger.Spec.Broker == ref.Name &&
@@ -539,6 +562,42 @@ func (t *knativeTrait) createTrigger(e *Environment, ref *corev1.ObjectReference
 	return nil
 }
 
+// deleteEventingResources deletes the Triggers, Subscriptions and SinkBindings of the Integration, that are
+// not generated while it is inactive.
+func (t *knativeTrait) deleteEventingResources(e *Environment) error {
+	if ok, err := knativeutil.IsEventingInstalled(e.Client); err != nil {
+		return err
+	} else if !ok {
+		return nil
+	}
+
+	for _, gvk := range knativeEventingKinds {
+		resources := unstructured.UnstructuredList{}
+		resources.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := t.Client.List(e.Ctx, &resources,
+			ctrl.InNamespace(e.Integration.Namespace),
+			ctrl.MatchingLabels{v1.IntegrationLabel: e.Integration.Name},
+		); err != nil {
+			if k8serrors.IsNotFound(err) || kubernetes.IsUnknownAPIError(err) {
+				continue
+			}
+			return fmt.Errorf("cannot list %s resources: %w", gvk.Kind, err)
+		}
+		for _, resource := range resources.Items {
+			r := resource
+			if !isIntegrationChild(e.Integration, r) {
+				continue
+			}
+			if err := t.Client.Delete(e.Ctx, &r); err != nil && !k8serrors.IsNotFound(err) {
+				return fmt.Errorf("cannot delete %s %s: %w", r.GetKind(), r.GetName(), err)
+			}
+			t.L.ForIntegration(e.Integration).Infof("%s %s deleted while the integration is inactive", r.GetKind(), r.GetName())
+		}
+	}
+
+	return nil
+}
+
 func (t *knativeTrait) ifServiceMissingDo(
 	e *Environment,
 	env *knativeapi.CamelEnvironment,
@@ -122,7 +122,7 @@ func (t *knativeServiceTrait) Configure(e *Environment) (bool, *TraitCondition,
 		), nil
 	}
 
-	if e.IntegrationInPhase(v1.IntegrationPhaseRunning, v1.IntegrationPhaseError) {
+	if e.IntegrationInPhase(v1.IntegrationPhaseRunning, v1.IntegrationPhaseError, v1.IntegrationPhaseSuspended) {
 		condition := e.Integration.Status.GetCondition(v1.IntegrationConditionKnativeServiceAvailable)
 		return condition != nil && condition.Status == corev1.ConditionTrue, nil, nil
 	}
@@ -255,6 +255,10 @@ func (t *knativeServiceTrait) getServiceFor(e *Environment) (*serving.Service, e
 	if t.Visibility != "" {
 		serviceLabels[knativeServingVisibilityLabel] = t.Visibility
 	}
+	if e.Integration.IsInactive() {
+		// Remove the external traffic while the Integration is suspended
+		serviceLabels[knativeServingVisibilityLabel] = "cluster-local"
+	}
 
 	svc := serving.Service{
 		TypeMeta: metav1.TypeMeta{
@@ -334,6 +338,10 @@ func (t *knativeServiceTrait) getServiceFor(e *Environment) (*serving.Service, e
 			svc.Spec.Template.Annotations[knativeServingMaxScaleAnnotation] = scale
 		}
 	}
+	if e.Integration.IsInactive() {
+		// Let the suspended Integration scale down to zero
+		delete(svc.Spec.Template.Annotations, knativeServingMinScaleAnnotation)
+	}
 
 	return &svc, nil
 }
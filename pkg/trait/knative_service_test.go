@@ -518,6 +518,28 @@ func TestKnativeServiceWithVisibility(t *testing.T) {
 	assert.Equal(t, ksvc.Labels[knativeServingVisibilityLabel], "cluster-local")
 }
 
+func TestKnativeServiceSuspended(t *testing.T) {
+	ks, _ := newKnativeServiceTrait().(*knativeServiceTrait)
+	ks.MinScale = pointer.Int(2)
+	environment := &Environment{
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      KnativeServiceTestName,
+				Namespace: KnativeServiceTestNamespace,
+				Annotations: map[string]string{
+					v1.IntegrationSuspendedAnnotation: "true",
+				},
+			},
+		},
+	}
+
+	ksvc, err := ks.getServiceFor(environment)
+	require.NoError(t, err)
+	assert.Equal(t, "cluster-local", ksvc.Labels[knativeServingVisibilityLabel])
+	assert.NotContains(t, ksvc.Spec.Template.Annotations, knativeServingMinScaleAnnotation)
+	assert.NotContains(t, ksvc.Spec.Template.Annotations, v1.IntegrationSuspendedAnnotation)
+}
+
 func createKnativeServiceTestEnvironment(t *testing.T, trait *traitv1.KnativeServiceTrait) *Environment {
 	t.Helper()
 
@@ -18,6 +18,7 @@ limitations under the License.
 package trait
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"regexp"
@@ -37,6 +38,8 @@ import (
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	serving "knative.dev/serving/pkg/apis/serving/v1"
 
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	knativeapi "github.com/apache/camel-k/v2/pkg/apis/camel/v1/knative"
 	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
@@ -461,6 +464,131 @@ func TestKnativeTriggerConfigurationNoServingAvailable(t *testing.T) {
 	}))
 }
 
+func TestKnativeTriggerInactiveIntegration(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+
+	c, err := NewFakeClient("ns")
+	require.NoError(t, err)
+
+	owner := metav1.OwnerReference{APIVersion: v1.SchemeGroupVersion.String(), Kind: v1.IntegrationKind, Name: "test"}
+	previous := eventing.Trigger{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Trigger",
+			APIVersion: eventing.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "ns",
+			Name:            "default-test-evttype",
+			Labels:          map[string]string{v1.IntegrationLabel: "test"},
+			OwnerReferences: []metav1.OwnerReference{owner},
+		},
+	}
+	foreign := eventing.Trigger{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Trigger",
+			APIVersion: eventing.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "foreign",
+			Labels:    map[string]string{v1.IntegrationLabel: "test"},
+		},
+	}
+	require.NoError(t, c.Create(context.TODO(), &previous))
+	require.NoError(t, c.Create(context.TODO(), &foreign))
+
+	traitCatalog := NewCatalog(c)
+
+	environment := Environment{
+		Ctx:          context.TODO(),
+		CamelCatalog: catalog,
+		Catalog:      traitCatalog,
+		Client:       c,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "test",
+				Namespace:   "ns",
+				Annotations: map[string]string{v1.IntegrationSuspendedAnnotation: "true"},
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseSuspended,
+				Conditions: []v1.IntegrationCondition{
+					{
+						Type:   v1.IntegrationConditionKnativeServiceAvailable,
+						Status: corev1.ConditionTrue,
+					},
+				},
+			},
+			Spec: v1.IntegrationSpec{
+				Profile: v1.TraitProfileKnative,
+				Sources: []v1.SourceSpec{
+					{
+						DataSpec: v1.DataSpec{
+							Name: "route.java",
+							Content: `
+								public class CartoonMessagesMover extends RouteBuilder {
+									public void configure() {
+										from("knative:event/evt.type")
+ 											.log("${body}");
+									}
+								}
+							`,
+						},
+						Language: v1.LanguageJavaSource,
+					},
+				},
+				Traits: v1.Traits{
+					Knative: &traitv1.KnativeTrait{
+						Trait: traitv1.Trait{
+							Enabled: pointer.Bool(true),
+						},
+					},
+				},
+			},
+		},
+		IntegrationKit: &v1.IntegrationKit{
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		},
+		Platform: &v1.IntegrationPlatform{
+			Spec: v1.IntegrationPlatformSpec{
+				Cluster: v1.IntegrationPlatformClusterOpenShift,
+				Build: v1.IntegrationPlatformBuildSpec{
+					PublishStrategy: v1.IntegrationPlatformBuildPublishStrategyS2I,
+					Registry:        v1.RegistrySpec{Address: "registry"},
+					RuntimeVersion:  catalog.Runtime.Version,
+				},
+				Profile: v1.TraitProfileKnative,
+			},
+			Status: v1.IntegrationPlatformStatus{
+				Phase: v1.IntegrationPlatformPhaseReady,
+			},
+		},
+		EnvVars:        make([]corev1.EnvVar, 0),
+		ExecutedTraits: make([]Trait, 0),
+		Resources:      k8sutils.NewCollection(),
+	}
+	environment.Platform.ResyncStatusFullConfig()
+
+	_, err = traitCatalog.apply(&environment)
+	require.NoError(t, err)
+
+	// No event is delivered to the suspended Integration
+	assert.Nil(t, environment.Resources.GetKnativeTrigger(func(trigger *eventing.Trigger) bool {
+		return true
+	}))
+	kt, ok := environment.GetTrait("knative").(*knativeTrait)
+	require.True(t, ok)
+	require.NoError(t, kt.deleteEventingResources(&environment))
+
+	var triggers eventing.TriggerList
+	require.NoError(t, c.List(context.TODO(), &triggers, ctrl.InNamespace("ns"), ctrl.MatchingLabels{v1.IntegrationLabel: "test"}))
+	require.Len(t, triggers.Items, 1)
+	assert.Equal(t, "foreign", triggers.Items[0].Name)
+}
+
 func TestKnativePlatformHttpConfig(t *testing.T) {
 	sources := []v1.SourceSpec{
 		{
@@ -234,7 +234,8 @@ func (t *openAPITrait) createNewOpenAPIConfigMap(e *Environment, resource v1.Dat
 		mc.UserSettings = []byte(settings)
 	}
 
-	settings, err := maven.NewSettings(maven.DefaultRepositories, maven.ProxyFromEnvironment)
+	settings, err := maven.NewSettings(maven.DefaultRepositories, maven.ProxyFromEnvironment,
+		maven.OfflineBundle(e.Platform.Status.Build.Maven.OfflineBundle))
 	if err != nil {
 		return err
 	}
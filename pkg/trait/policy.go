@@ -0,0 +1,412 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/metadata"
+	"github.com/apache/camel-k/v2/pkg/util/dsl"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/uri"
+)
+
+// PolicyViolation is a rule of an IntegrationPolicy the Integration does not comply with.
+type PolicyViolation struct {
+	Policy  string
+	Action  v1.IntegrationPolicyAction
+	Message string
+}
+
+func (v PolicyViolation) String() string {
+	return fmt.Sprintf("policy %s: %s", v.Policy, v.Message)
+}
+
+// PolicyReport is the outcome of the IntegrationPolicies evaluation.
+type PolicyReport struct {
+	// the changes applied to the trait configuration by the mutating rules
+	Mutations []string
+	// the rules the Integration does not comply with
+	Violations []PolicyViolation
+}
+
+// Denied returns true if any of the violations belongs to a denying policy.
+func (r *PolicyReport) Denied() bool {
+	for _, v := range r.Violations {
+		if v.Action == v1.IntegrationPolicyActionDeny {
+			return true
+		}
+	}
+	return false
+}
+
+// Condition returns the status, reason and message of the condition reporting the policies compliance.
+func (r *PolicyReport) Condition() (corev1.ConditionStatus, string, string) {
+	if len(r.Violations) > 0 {
+		messages := make([]string, 0, len(r.Violations))
+		for _, v := range r.Violations {
+			messages = append(messages, v.String())
+		}
+		return corev1.ConditionFalse, v1.IntegrationConditionPolicyViolationReason, strings.Join(messages, "; ")
+	}
+	if len(r.Mutations) > 0 {
+		return corev1.ConditionTrue, v1.IntegrationConditionPolicyMutatedReason, strings.Join(r.Mutations, "; ")
+	}
+	return corev1.ConditionTrue, v1.IntegrationConditionPolicyCompliantReason, ""
+}
+
+// EvaluatePolicies checks the Integration of the given environment against the enforced IntegrationPolicies.
+// It returns a nil report when no policy applies.
+func EvaluatePolicies(e *Environment) (*PolicyReport, error) {
+	if len(e.IntegrationPolicies) == 0 || e.Integration == nil {
+		return nil, nil
+	}
+
+	report := PolicyReport{
+		Mutations:  e.PolicyMutations,
+		Violations: make([]PolicyViolation, 0),
+	}
+
+	components, err := policyComponents(e)
+	if err != nil {
+		return nil, err
+	}
+	images := policyImages(e)
+
+	for _, p := range e.IntegrationPolicies {
+		violation := func(format string, args ...interface{}) {
+			report.Violations = append(report.Violations, PolicyViolation{
+				Policy:  p.Name,
+				Action:  p.GetAction(),
+				Message: fmt.Sprintf(format, args...),
+			})
+		}
+		for _, forbidden := range p.Spec.ForbiddenComponents {
+			if components[forbidden] {
+				violation("component %s is forbidden", forbidden)
+			}
+		}
+		if len(p.Spec.AllowedRegistries) > 0 {
+			for _, image := range images {
+				if !isImageFromRegistries(image, p.Spec.AllowedRegistries) {
+					violation("image %s is not pulled from an allowed registry", image)
+				}
+			}
+		}
+		for _, rule := range p.Spec.Traits {
+			for _, message := range e.Catalog.applyTraitPolicy(rule, false) {
+				violation("%s", message)
+			}
+		}
+	}
+
+	return &report, nil
+}
+
+// EvaluatePoliciesFor checks an Integration that is not yet created against the enforced IntegrationPolicies, without
+// applying the traits. It returns a nil report when no policy applies.
+func EvaluatePoliciesFor(ctx context.Context, c client.Client, integration *v1.Integration) (*PolicyReport, error) {
+	it := integration.DeepCopy()
+	e, err := newEnvironment(ctx, c, it, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(e.IntegrationPolicies) == 0 {
+		return nil, nil
+	}
+
+	e.Catalog = NewCatalog(c)
+	if err := e.Catalog.Configure(e); err != nil {
+		return nil, err
+	}
+
+	if len(it.Spec.Flows) > 0 {
+		content, err := dsl.ToYamlDSL(it.Spec.Flows)
+		if err != nil {
+			return nil, err
+		}
+		it.Status.AddOrReplaceGeneratedSources(v1.SourceSpec{
+			DataSpec: v1.DataSpec{
+				Name:    flowsInternalSourceName,
+				Content: string(content),
+			},
+		})
+	}
+
+	if ct, ok := e.Catalog.GetTrait(camelTraitID).(*camelTrait); ok {
+		runtimeVersion := ct.RuntimeVersion
+		if runtimeVersion == "" {
+			if runtimeVersion, err = determineRuntimeVersion(e); err != nil {
+				return nil, err
+			}
+		}
+		if err := ct.loadOrCreateCatalog(e, runtimeVersion); err != nil {
+			return nil, err
+		}
+	}
+
+	return EvaluatePolicies(e)
+}
+
+// mutateTraits applies the mutating rules of the given policies to the configured traits and returns the applied changes.
+func (c *Catalog) mutateTraits(policies []v1.IntegrationPolicy) []string {
+	mutations := make([]string, 0)
+	for _, p := range policies {
+		for _, rule := range p.Spec.Traits {
+			if !rule.Mutate {
+				continue
+			}
+			for _, message := range c.applyTraitPolicy(rule, true) {
+				mutations = append(mutations, fmt.Sprintf("policy %s: %s", p.Name, message))
+			}
+		}
+	}
+	return mutations
+}
+
+// applyTraitPolicy checks the configured trait property against the given rule. When mutating, the property is fixed
+// where possible and the applied changes are returned, otherwise the violations are returned.
+func (c *Catalog) applyTraitPolicy(rule v1.TraitPolicy, mutate bool) []string {
+	messages := make([]string, 0)
+	value, ok := c.traitProperty(rule.Property)
+	if !ok {
+		if !mutate {
+			messages = append(messages, fmt.Sprintf("unknown trait property %s", rule.Property))
+		}
+		return messages
+	}
+
+	for _, forbidden := range rule.Forbidden {
+		if !containsPropertyValue(value, forbidden) {
+			continue
+		}
+		if mutate {
+			removePropertyValue(value, forbidden)
+			messages = append(messages, fmt.Sprintf("%s value %s removed", rule.Property, forbidden))
+		} else {
+			messages = append(messages, fmt.Sprintf("%s value %s is forbidden", rule.Property, forbidden))
+		}
+	}
+
+	if rule.Max == "" {
+		return messages
+	}
+	limit, err := resource.ParseQuantity(rule.Max)
+	if err != nil {
+		if !mutate {
+			messages = append(messages, fmt.Sprintf("invalid %s maximum %s", rule.Property, rule.Max))
+		}
+		return messages
+	}
+	for _, v := range propertyValues(value) {
+		quantity, err := resource.ParseQuantity(v)
+		if err != nil {
+			if !mutate {
+				messages = append(messages, fmt.Sprintf("%s value %s is not a valid quantity", rule.Property, v))
+			}
+			continue
+		}
+		if quantity.Cmp(limit) <= 0 {
+			continue
+		}
+		if mutate && setPropertyValue(value, rule.Max) {
+			messages = append(messages, fmt.Sprintf("%s capped from %s to %s", rule.Property, v, rule.Max))
+		} else if !mutate {
+			messages = append(messages, fmt.Sprintf("%s value %s exceeds the maximum %s", rule.Property, v, rule.Max))
+		}
+	}
+
+	return messages
+}
+
+// traitProperty returns the configured value of the given property, in the `trait.property` form.
+func (c *Catalog) traitProperty(property string) (reflect.Value, bool) {
+	id, name, ok := strings.Cut(property, ".")
+	if !ok {
+		return reflect.Value{}, false
+	}
+	t := c.GetTrait(id)
+	if t == nil {
+		return reflect.Value{}, false
+	}
+	return findProperty(reflect.ValueOf(t).Elem(), name)
+}
+
+func findProperty(v reflect.Value, name string) (reflect.Value, bool) {
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag, opts, _ := strings.Cut(field.Tag.Get("property"), ",")
+		if tag == name {
+			return v.Field(i), true
+		}
+		if opts == "squash" || (tag == "" && field.Anonymous) {
+			if p, ok := findProperty(v.Field(i), name); ok {
+				return p, true
+			}
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// propertyValues returns the string representation of the values held by the property.
+func propertyValues(v reflect.Value) []string {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.String:
+		if v.String() == "" {
+			return nil
+		}
+		return []string{v.String()}
+	case reflect.Bool:
+		return []string{strconv.FormatBool(v.Bool())}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return []string{strconv.FormatInt(v.Int(), 10)}
+	case reflect.Slice:
+		values := make([]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			values = append(values, propertyValues(v.Index(i))...)
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+func containsPropertyValue(v reflect.Value, value string) bool {
+	for _, pv := range propertyValues(v) {
+		if strings.EqualFold(pv, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// removePropertyValue resets a scalar property, or drops the matching items of a slice property.
+func removePropertyValue(v reflect.Value, value string) {
+	if v.Kind() != reflect.Slice {
+		v.Set(reflect.Zero(v.Type()))
+		return
+	}
+	items := reflect.MakeSlice(v.Type(), 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		if !containsPropertyValue(v.Index(i), value) {
+			items = reflect.Append(items, v.Index(i))
+		}
+	}
+	v.Set(items)
+}
+
+// setPropertyValue sets a string property, returning false for the other kinds of property.
+func setPropertyValue(v reflect.Value, value string) bool {
+	switch {
+	case v.Kind() == reflect.String:
+		v.SetString(value)
+		return true
+	case v.Kind() == reflect.Ptr && v.Type().Elem().Kind() == reflect.String:
+		p := reflect.New(v.Type().Elem())
+		p.Elem().SetString(value)
+		v.Set(p)
+		return true
+	default:
+		return false
+	}
+}
+
+// policyComponents returns the Camel components used by the Integration endpoints.
+func policyComponents(e *Environment) (map[string]bool, error) {
+	sources, err := kubernetes.ResolveIntegrationSources(e.Ctx, e.Client, e.Integration, e.Resources)
+	if err != nil {
+		return nil, err
+	}
+	meta, err := metadata.ExtractAll(e.CamelCatalog, sources)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := make([]string, 0, len(meta.FromURIs)+len(meta.ToURIs))
+	endpoints = append(endpoints, meta.FromURIs...)
+	endpoints = append(endpoints, meta.ToURIs...)
+	components := make(map[string]bool)
+	for _, endpoint := range endpoints {
+		if component := uri.GetComponent(endpoint); component != "" {
+			components[component] = true
+		}
+	}
+	return components, nil
+}
+
+// policyImages returns the container images explicitly set on the Integration.
+func policyImages(e *Environment) []string {
+	images := make(map[string]bool)
+	if ct, ok := e.Catalog.GetTrait(containerTraitID).(*containerTrait); ok && ct.Image != "" {
+		images[ct.Image] = true
+	}
+	if t := e.Integration.Spec.PodTemplate; t != nil {
+		for _, c := range t.Spec.InitContainers {
+			if c.Image != "" {
+				images[c.Image] = true
+			}
+		}
+		for _, c := range t.Spec.Containers {
+			if c.Image != "" {
+				images[c.Image] = true
+			}
+		}
+	}
+
+	sorted := make([]string, 0, len(images))
+	for image := range images {
+		sorted = append(sorted, image)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+func isImageFromRegistries(image string, registries []string) bool {
+	for _, registry := range registries {
+		registry = strings.TrimSuffix(registry, "/")
+		if image == registry ||
+			strings.HasPrefix(image, registry+"/") ||
+			strings.HasPrefix(image, registry+":") ||
+			strings.HasPrefix(image, registry+"@") {
+			return true
+		}
+	}
+	return false
+}
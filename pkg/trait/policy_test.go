@@ -0,0 +1,210 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+)
+
+func TestPolicyMutatesTraits(t *testing.T) {
+	serviceType := traitv1.ServiceTypeLoadBalancer
+	it := policyTestIntegration(`from("timer:tick").to("log:info")`)
+	it.Spec.Traits.Container = &traitv1.ContainerTrait{LimitMemory: "2Gi"}
+	it.Spec.Traits.Service = &traitv1.ServiceTrait{Type: &serviceType}
+
+	policy := v1.NewIntegrationPolicy("ns", "limits")
+	policy.Spec.Traits = []v1.TraitPolicy{
+		{Property: "container.limit-memory", Max: "1Gi", Mutate: true},
+		{Property: "service.type", Forbidden: []string{"LoadBalancer"}, Mutate: true},
+	}
+
+	e := policyTestEnvironment(t, it, policy)
+	require.NoError(t, e.Catalog.Configure(e))
+
+	ct, ok := e.Catalog.GetTrait(containerTraitID).(*containerTrait)
+	require.True(t, ok)
+	assert.Equal(t, "1Gi", ct.LimitMemory)
+	st, ok := e.Catalog.GetTrait(serviceTraitID).(*serviceTrait)
+	require.True(t, ok)
+	assert.Nil(t, st.Type)
+	assert.Equal(t, []string{
+		"policy limits: container.limit-memory capped from 2Gi to 1Gi",
+		"policy limits: service.type value LoadBalancer removed",
+	}, e.PolicyMutations)
+	// The Integration spec is left untouched
+	assert.Equal(t, "2Gi", it.Spec.Traits.Container.LimitMemory)
+
+	report, err := EvaluatePolicies(e)
+	require.NoError(t, err)
+	assert.Empty(t, report.Violations)
+	assert.False(t, report.Denied())
+	status, reason, _ := report.Condition()
+	assert.Equal(t, corev1.ConditionTrue, status)
+	assert.Equal(t, v1.IntegrationConditionPolicyMutatedReason, reason)
+}
+
+func TestPolicyViolations(t *testing.T) {
+	serviceType := traitv1.ServiceTypeLoadBalancer
+	it := policyTestIntegration(`from("timer:tick").to("exec:ls")`)
+	it.Spec.Traits.Container = &traitv1.ContainerTrait{Image: "docker.io/my-org/my-app:1.0", LimitMemory: "2Gi"}
+	it.Spec.Traits.Service = &traitv1.ServiceTrait{Type: &serviceType}
+
+	deny := v1.NewIntegrationPolicy("ns", "deny")
+	deny.Spec.ForbiddenComponents = []string{"exec", "file"}
+	deny.Spec.AllowedRegistries = []string{"quay.io/my-org"}
+	deny.Spec.Traits = []v1.TraitPolicy{
+		{Property: "container.limit-memory", Max: "1Gi"},
+		{Property: "service.type", Forbidden: []string{"LoadBalancer"}},
+	}
+	warn := v1.NewIntegrationPolicy("ns", "warn")
+	warn.Spec.Action = v1.IntegrationPolicyActionWarn
+	warn.Spec.Traits = []v1.TraitPolicy{
+		{Property: "unknown.property", Forbidden: []string{"any"}},
+	}
+
+	e := policyTestEnvironment(t, it, deny, warn)
+	require.NoError(t, e.Catalog.Configure(e))
+
+	report, err := EvaluatePolicies(e)
+	require.NoError(t, err)
+	assert.Empty(t, report.Mutations)
+	assert.Equal(t, []PolicyViolation{
+		{Policy: "deny", Action: v1.IntegrationPolicyActionDeny, Message: "component exec is forbidden"},
+		{Policy: "deny", Action: v1.IntegrationPolicyActionDeny, Message: "image docker.io/my-org/my-app:1.0 is not pulled from an allowed registry"},
+		{Policy: "deny", Action: v1.IntegrationPolicyActionDeny, Message: "container.limit-memory value 2Gi exceeds the maximum 1Gi"},
+		{Policy: "deny", Action: v1.IntegrationPolicyActionDeny, Message: "service.type value LoadBalancer is forbidden"},
+		{Policy: "warn", Action: v1.IntegrationPolicyActionWarn, Message: "unknown trait property unknown.property"},
+	}, report.Violations)
+	assert.True(t, report.Denied())
+	status, reason, message := report.Condition()
+	assert.Equal(t, corev1.ConditionFalse, status)
+	assert.Equal(t, v1.IntegrationConditionPolicyViolationReason, reason)
+	assert.Contains(t, message, "policy deny: component exec is forbidden; ")
+}
+
+func TestPolicyWarning(t *testing.T) {
+	it := policyTestIntegration(`from("timer:tick").to("file:/tmp")`)
+
+	warn := v1.NewIntegrationPolicy("ns", "warn")
+	warn.Spec.Action = v1.IntegrationPolicyActionWarn
+	warn.Spec.ForbiddenComponents = []string{"file"}
+
+	e := policyTestEnvironment(t, it, warn)
+	require.NoError(t, e.Catalog.Configure(e))
+
+	report, err := EvaluatePolicies(e)
+	require.NoError(t, err)
+	assert.Len(t, report.Violations, 1)
+	assert.False(t, report.Denied())
+}
+
+func TestNoPolicy(t *testing.T) {
+	e := policyTestEnvironment(t, policyTestIntegration(`from("timer:tick").to("exec:ls")`))
+	require.NoError(t, e.Catalog.Configure(e))
+
+	report, err := EvaluatePolicies(e)
+	require.NoError(t, err)
+	assert.Nil(t, report)
+	assert.Nil(t, e.PolicyMutations)
+}
+
+func TestEvaluatePoliciesFor(t *testing.T) {
+	it := policyTestIntegration("")
+	it.Spec.Sources = nil
+	it.Spec.Flows = []v1.Flow{{RawMessage: []byte(`{"from":{"uri":"timer:tick","steps":[{"to":"exec:ls"}]}}`)}}
+
+	policy := v1.NewIntegrationPolicy("ns", "deny")
+	policy.Spec.ForbiddenComponents = []string{"exec"}
+	catalog := v1.NewCamelCatalog("ns", "camel-k-catalog")
+	defaultCatalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+	catalog.Spec = defaultCatalog.CamelCatalogSpec
+	it.Status.RuntimeVersion = catalog.Spec.Runtime.Version
+
+	c, err := test.NewFakeClient(&policy, &catalog)
+	require.NoError(t, err)
+
+	report, err := EvaluatePoliciesFor(context.TODO(), c, it)
+	require.NoError(t, err)
+	assert.True(t, report.Denied())
+	assert.Equal(t, "policy deny: component exec is forbidden", report.Violations[0].String())
+	// The Integration is left untouched
+	assert.Empty(t, it.Status.GeneratedSources)
+}
+
+func TestIsImageFromRegistries(t *testing.T) {
+	registries := []string{"quay.io/my-org/", "registry.local:5000"}
+	assert.True(t, isImageFromRegistries("quay.io/my-org/app:1.0", registries))
+	assert.True(t, isImageFromRegistries("registry.local:5000/app@sha256:1234", registries))
+	assert.False(t, isImageFromRegistries("quay.io/my-org-fork/app:1.0", registries))
+	assert.False(t, isImageFromRegistries("docker.io/library/app", registries))
+}
+
+func policyTestIntegration(route string) *v1.Integration {
+	return &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+		Status: v1.IntegrationStatus{
+			Phase: v1.IntegrationPhaseInitialization,
+		},
+		Spec: v1.IntegrationSpec{
+			Sources: []v1.SourceSpec{
+				{
+					DataSpec: v1.DataSpec{
+						Name:    "routes.java",
+						Content: route,
+					},
+					Language: v1.LanguageJavaSource,
+				},
+			},
+		},
+	}
+}
+
+func policyTestEnvironment(t *testing.T, it *v1.Integration, policies ...v1.IntegrationPolicy) *Environment {
+	t.Helper()
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+	c, err := test.NewFakeClient()
+	require.NoError(t, err)
+
+	return &Environment{
+		Ctx:                 context.TODO(),
+		Client:              c,
+		CamelCatalog:        catalog,
+		Catalog:             NewCatalog(c),
+		Integration:         it,
+		IntegrationPolicies: policies,
+		Resources:           kubernetes.NewCollection(),
+	}
+}
@@ -80,7 +80,7 @@ func (t *prometheusTrait) Apply(e *Environment) error {
 		Reason: v1.IntegrationConditionPrometheusAvailableReason,
 	}
 
-	containerPort := e.getIntegrationContainerPort()
+	containerPort := e.GetIntegrationContainerPort()
 	if containerPort == nil {
 		containerPort = e.createContainerPort()
 		container.Ports = append(container.Ports, *containerPort)
@@ -0,0 +1,301 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	// Embed the time zone database, so that the schedule time zones resolve regardless of the operator base image
+	_ "time/tzdata"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+)
+
+const (
+	scheduleTraitID    = "schedule"
+	scheduleTraitOrder = 950
+
+	scheduleDateLayout = "2006-01-02"
+	// scheduleHorizon is how far ahead the next scheduled transition is searched for.
+	scheduleHorizon = 366
+)
+
+type scheduleTrait struct {
+	BaseTrait
+	traitv1.ScheduleTrait `property:",squash"`
+}
+
+func newScheduleTrait() Trait {
+	return &scheduleTrait{
+		BaseTrait: NewBaseTrait(scheduleTraitID, scheduleTraitOrder),
+	}
+}
+
+func (t *scheduleTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil {
+		return false, nil, nil
+	}
+	if !pointer.BoolDeref(t.Enabled, false) {
+		// Resume the Integration that was out of schedule when the trait gets disabled
+		e.Integration.Status.RemoveCondition(v1.IntegrationConditionScheduled)
+		e.Integration.Status.NextScheduledTransition = nil
+		return false, nil, nil
+	}
+	if !e.IntegrationInRunningPhases() {
+		return false, nil, nil
+	}
+	if _, err := t.schedule(); err != nil {
+		return false, nil, err
+	}
+
+	return true, nil, nil
+}
+
+func (t *scheduleTrait) Apply(e *Environment) error {
+	s, err := t.schedule()
+	if err != nil {
+		return err
+	}
+	active, next := s.At(time.Now())
+
+	status := corev1.ConditionTrue
+	reason := v1.IntegrationConditionInScheduleReason
+	message := "integration in schedule"
+	if !active {
+		status = corev1.ConditionFalse
+		reason = v1.IntegrationConditionOutOfScheduleReason
+		message = "integration out of schedule"
+	}
+	if next != nil {
+		message = fmt.Sprintf("%s until %s", message, next.Format(time.RFC3339))
+		e.Integration.Status.NextScheduledTransition = &metav1.Time{Time: *next}
+	} else {
+		e.Integration.Status.NextScheduledTransition = nil
+	}
+	e.Integration.Status.SetCondition(v1.IntegrationConditionScheduled, status, reason, message)
+
+	return nil
+}
+
+func (t *scheduleTrait) schedule() (*Schedule, error) {
+	if len(t.Windows) == 0 {
+		return nil, errors.New("the schedule trait requires at least one window")
+	}
+	return ParseSchedule(t.Windows, t.TimeZone, t.Exclusions)
+}
+
+// Schedule is the parsed configuration of the schedule trait.
+type Schedule struct {
+	windows    []scheduleWindow
+	location   *time.Location
+	exclusions map[string]bool
+}
+
+// scheduleWindow is a daily window, in minutes since midnight, active on the given week days.
+type scheduleWindow struct {
+	days  [7]bool
+	start int
+	end   int
+}
+
+type scheduleInterval struct {
+	start time.Time
+	end   time.Time
+}
+
+var scheduleDays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// ParseSchedule parses the schedule trait windows, time zone and excluded dates.
+func ParseSchedule(windows []string, timeZone string, exclusions []string) (*Schedule, error) {
+	s := Schedule{
+		location:   time.UTC,
+		exclusions: make(map[string]bool),
+	}
+	if timeZone != "" {
+		loc, err := time.LoadLocation(timeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule time zone %q: %w", timeZone, err)
+		}
+		s.location = loc
+	}
+	for _, window := range windows {
+		w, err := parseScheduleWindow(window)
+		if err != nil {
+			return nil, err
+		}
+		s.windows = append(s.windows, w)
+	}
+	for _, exclusion := range exclusions {
+		d, err := time.Parse(scheduleDateLayout, exclusion)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule exclusion %q: expected YYYY-MM-DD", exclusion)
+		}
+		s.exclusions[d.Format(scheduleDateLayout)] = true
+	}
+
+	return &s, nil
+}
+
+func parseScheduleWindow(window string) (scheduleWindow, error) {
+	w := scheduleWindow{}
+	fields := strings.Fields(window)
+	if len(fields) != 2 {
+		return w, fmt.Errorf("invalid schedule window %q: expected <days> <start>-<end>", window)
+	}
+	if err := parseScheduleDays(fields[0], &w.days); err != nil {
+		return w, fmt.Errorf("invalid schedule window %q: %w", window, err)
+	}
+	start, end, ok := strings.Cut(fields[1], "-")
+	if !ok {
+		return w, fmt.Errorf("invalid schedule window %q: expected <start>-<end> times", window)
+	}
+	var err error
+	if w.start, err = parseScheduleTime(start); err != nil {
+		return w, fmt.Errorf("invalid schedule window %q: %w", window, err)
+	}
+	if w.end, err = parseScheduleTime(end); err != nil {
+		return w, fmt.Errorf("invalid schedule window %q: %w", window, err)
+	}
+	if w.start == w.end {
+		return w, fmt.Errorf("invalid schedule window %q: start and end times must differ", window)
+	}
+
+	return w, nil
+}
+
+func parseScheduleDays(days string, result *[7]bool) error {
+	if days == "*" {
+		for i := range result {
+			result[i] = true
+		}
+		return nil
+	}
+	for _, item := range strings.Split(days, ",") {
+		from, to, isRange := strings.Cut(item, "-")
+		first, ok := scheduleDays[strings.ToLower(from)]
+		if !ok {
+			return fmt.Errorf("unknown day %q", from)
+		}
+		last := first
+		if isRange {
+			if last, ok = scheduleDays[strings.ToLower(to)]; !ok {
+				return fmt.Errorf("unknown day %q", to)
+			}
+		}
+		// Ranges may wrap around the end of the week, e.g. Sat-Mon
+		for d := first; ; d = (d + 1) % 7 {
+			result[d] = true
+			if d == last {
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseScheduleTime parses a HH:MM time into minutes since midnight, 24:00 being accepted as the end of the day.
+func parseScheduleTime(value string) (int, error) {
+	if value == "24:00" {
+		return 24 * 60, nil
+	}
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q: expected HH:MM", value)
+	}
+
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// At returns whether the schedule is active at the given time, and the time of its next transition,
+// or nil if there is none within the next year.
+func (s *Schedule) At(now time.Time) (bool, *time.Time) {
+	now = now.In(s.location)
+	// Start the day before, to account for the windows spanning over midnight
+	y, m, d := now.Date()
+	first := time.Date(y, m, d-1, 0, 0, 0, 0, s.location)
+	limit := time.Date(y, m, d+scheduleHorizon, 0, 0, 0, 0, s.location)
+
+	intervals := make([]scheduleInterval, 0)
+	for day := first; day.Before(limit); day = day.AddDate(0, 0, 1) {
+		if s.exclusions[day.Format(scheduleDateLayout)] {
+			continue
+		}
+		for _, w := range s.windows {
+			if !w.days[day.Weekday()] {
+				continue
+			}
+			end := w.end
+			if end < w.start {
+				end += 24 * 60
+			}
+			dy, dm, dd := day.Date()
+			intervals = append(intervals, scheduleInterval{
+				start: time.Date(dy, dm, dd, 0, w.start, 0, 0, s.location),
+				end:   time.Date(dy, dm, dd, 0, end, 0, 0, s.location),
+			})
+		}
+	}
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].start.Before(intervals[j].start)
+	})
+
+	// Merge the overlapping and adjacent intervals
+	merged := make([]scheduleInterval, 0, len(intervals))
+	for _, i := range intervals {
+		if n := len(merged); n > 0 && !i.start.After(merged[n-1].end) {
+			if i.end.After(merged[n-1].end) {
+				merged[n-1].end = i.end
+			}
+			continue
+		}
+		merged = append(merged, i)
+	}
+
+	for _, i := range merged {
+		if now.Before(i.start) {
+			return false, &i.start
+		}
+		if now.Before(i.end) {
+			if !i.end.Before(limit) {
+				// Active for the whole horizon
+				return true, nil
+			}
+			return true, &i.end
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,191 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+func TestConfigureScheduleTraitDoesSucceed(t *testing.T) {
+	scheduleTrait, environment := createScheduleTest()
+	configured, condition, err := scheduleTrait.Configure(environment)
+
+	assert.True(t, configured)
+	require.NoError(t, err)
+	assert.Nil(t, condition)
+}
+
+func TestConfigureScheduleTraitDisabled(t *testing.T) {
+	scheduleTrait, environment := createScheduleTest()
+	scheduleTrait.Enabled = nil
+	environment.Integration.Status.SetCondition(v1.IntegrationConditionScheduled, corev1.ConditionFalse, v1.IntegrationConditionOutOfScheduleReason, "")
+	environment.Integration.Status.NextScheduledTransition = &metav1.Time{Time: time.Now()}
+	configured, _, err := scheduleTrait.Configure(environment)
+
+	assert.False(t, configured)
+	require.NoError(t, err)
+	assert.Nil(t, environment.Integration.Status.GetCondition(v1.IntegrationConditionScheduled))
+	assert.Nil(t, environment.Integration.Status.NextScheduledTransition)
+	assert.False(t, environment.Integration.IsInactive())
+}
+
+func TestConfigureScheduleTraitDoesNotSucceed(t *testing.T) {
+	scheduleTrait, environment := createScheduleTest()
+	scheduleTrait.Windows = nil
+	configured, _, err := scheduleTrait.Configure(environment)
+	require.EqualError(t, err, "the schedule trait requires at least one window")
+	assert.False(t, configured)
+
+	scheduleTrait, environment = createScheduleTest()
+	scheduleTrait.TimeZone = "Mars/Olympus_Mons"
+	configured, _, err = scheduleTrait.Configure(environment)
+	require.Error(t, err)
+	assert.False(t, configured)
+
+	scheduleTrait, environment = createScheduleTest()
+	scheduleTrait.Exclusions = []string{"25/12/2024"}
+	configured, _, err = scheduleTrait.Configure(environment)
+	require.EqualError(t, err, `invalid schedule exclusion "25/12/2024": expected YYYY-MM-DD`)
+	assert.False(t, configured)
+}
+
+func TestApplyScheduleTraitSetsCondition(t *testing.T) {
+	scheduleTrait, environment := createScheduleTest()
+	scheduleTrait.Windows = []string{"* 00:00-24:00"}
+	require.NoError(t, scheduleTrait.Apply(environment))
+
+	cond := environment.Integration.Status.GetCondition(v1.IntegrationConditionScheduled)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, v1.IntegrationConditionInScheduleReason, cond.Reason)
+	assert.Nil(t, environment.Integration.Status.NextScheduledTransition)
+	assert.False(t, environment.Integration.IsInactive())
+
+	// Only active on a day that is never today
+	tomorrow := time.Now().UTC().AddDate(0, 0, 1).Weekday().String()[:3]
+	scheduleTrait.Windows = []string{tomorrow + " 00:00-00:01"}
+	scheduleTrait.Exclusions = []string{time.Now().UTC().AddDate(0, 0, 1).Format("2006-01-02")}
+	require.NoError(t, scheduleTrait.Apply(environment))
+
+	cond = environment.Integration.Status.GetCondition(v1.IntegrationConditionScheduled)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, v1.IntegrationConditionOutOfScheduleReason, cond.Reason)
+	require.NotNil(t, environment.Integration.Status.NextScheduledTransition)
+	assert.True(t, environment.Integration.Status.NextScheduledTransition.After(time.Now().AddDate(0, 0, 7)))
+	assert.True(t, environment.Integration.IsOutOfSchedule())
+	assert.True(t, environment.Integration.IsInactive())
+}
+
+func TestParseScheduleWindows(t *testing.T) {
+	_, err := ParseSchedule([]string{"Mon-Fri 08:00-18:00", "Sat,Sun 10:00-12:00", "* 22:00-02:00", "Sat-Mon 00:00-24:00"}, "", nil)
+	require.NoError(t, err)
+
+	for window, message := range map[string]string{
+		"08:00-18:00":         `invalid schedule window "08:00-18:00": expected <days> <start>-<end>`,
+		"Mon-Fry 08:00-18:00": `invalid schedule window "Mon-Fry 08:00-18:00": unknown day "Fry"`,
+		"Mon 08:00":           `invalid schedule window "Mon 08:00": expected <start>-<end> times`,
+		"Mon 08:00-25:00":     `invalid schedule window "Mon 08:00-25:00": invalid time "25:00": expected HH:MM`,
+		"Mon 08:00-08:00":     `invalid schedule window "Mon 08:00-08:00": start and end times must differ`,
+	} {
+		_, err := ParseSchedule([]string{window}, "", nil)
+		require.EqualError(t, err, message)
+	}
+}
+
+func TestScheduleAt(t *testing.T) {
+	s, err := ParseSchedule([]string{"Mon-Fri 08:00-18:00"}, "", []string{"2024-01-03"})
+	require.NoError(t, err)
+
+	// Monday 2024-01-01
+	active, next := s.At(time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC))
+	assert.False(t, active)
+	assert.Equal(t, time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC), next.UTC())
+
+	active, next = s.At(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+	assert.True(t, active)
+	assert.Equal(t, time.Date(2024, 1, 1, 18, 0, 0, 0, time.UTC), next.UTC())
+
+	// The excluded Wednesday is skipped
+	active, next = s.At(time.Date(2024, 1, 2, 18, 0, 0, 0, time.UTC))
+	assert.False(t, active)
+	assert.Equal(t, time.Date(2024, 1, 4, 8, 0, 0, 0, time.UTC), next.UTC())
+
+	// Friday evening resumes on Monday
+	active, next = s.At(time.Date(2024, 1, 5, 20, 0, 0, 0, time.UTC))
+	assert.False(t, active)
+	assert.Equal(t, time.Date(2024, 1, 8, 8, 0, 0, 0, time.UTC), next.UTC())
+}
+
+func TestScheduleAtOverMidnight(t *testing.T) {
+	s, err := ParseSchedule([]string{"Fri 22:00-02:00", "Sat 02:00-04:00"}, "", nil)
+	require.NoError(t, err)
+
+	// The window started on Friday is still active on Saturday, and merged with the adjacent window
+	active, next := s.At(time.Date(2024, 1, 6, 1, 0, 0, 0, time.UTC))
+	assert.True(t, active)
+	assert.Equal(t, time.Date(2024, 1, 6, 4, 0, 0, 0, time.UTC), next.UTC())
+
+	active, next = s.At(time.Date(2024, 1, 6, 5, 0, 0, 0, time.UTC))
+	assert.False(t, active)
+	assert.Equal(t, time.Date(2024, 1, 12, 22, 0, 0, 0, time.UTC), next.UTC())
+}
+
+func TestScheduleAtTimeZone(t *testing.T) {
+	s, err := ParseSchedule([]string{"Mon-Fri 08:00-18:00"}, "Europe/Paris", nil)
+	require.NoError(t, err)
+
+	// 07:30 UTC is 08:30 in Paris in winter
+	active, next := s.At(time.Date(2024, 1, 1, 7, 30, 0, 0, time.UTC))
+	assert.True(t, active)
+	assert.Equal(t, time.Date(2024, 1, 1, 17, 0, 0, 0, time.UTC), next.UTC())
+}
+
+func createScheduleTest() (*scheduleTrait, *Environment) {
+	catalog := NewCatalog(nil)
+	trait, _ := catalog.GetTrait(scheduleTraitID).(*scheduleTrait)
+	trait.Enabled = pointer.Bool(true)
+	trait.Windows = []string{"Mon-Fri 08:00-18:00"}
+
+	environment := &Environment{
+		Catalog: catalog,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "integration-name",
+				Namespace: "ns",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseRunning,
+			},
+		},
+		Resources: kubernetes.NewCollection(),
+	}
+
+	return trait, environment
+}
@@ -128,6 +128,14 @@ func newEnvironment(ctx context.Context, c client.Client, integration *v1.Integr
 		}
 	}
 
+	var policies []v1.IntegrationPolicy
+	if integration != nil {
+		policies, err = platform.ListIntegrationPolicies(ctx, c, integration)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	//
 	// kit can still be nil if integration kit is yet
 	// to finish building and be assigned to the integration
@@ -139,6 +147,7 @@ func newEnvironment(ctx context.Context, c client.Client, integration *v1.Integr
 		Client:                c,
 		IntegrationKit:        kit,
 		Integration:           integration,
+		IntegrationPolicies:   policies,
 		ExecutedTraits:        make([]Trait, 0),
 		Resources:             kubernetes.NewCollection(),
 		EnvVars:               make([]corev1.EnvVar, 0),
@@ -61,6 +61,10 @@ func (c *Catalog) Configure(env *Environment) error {
 			return err
 		}
 	}
+	if len(env.IntegrationPolicies) > 0 {
+		// Mutating policies are applied last so that they take precedence over any configuration
+		env.PolicyMutations = c.mutateTraits(env.IntegrationPolicies)
+	}
 
 	return nil
 }
@@ -32,6 +32,8 @@ func init() {
 	AddToTraits(newErrorHandlerTrait)
 	AddToTraits(newGCTrait)
 	AddToTraits(newHealthTrait)
+	AddToTraits(newHPATrait)
+	AddToTraits(newIdleTrait)
 	AddToTraits(NewInitTrait)
 	AddToTraits(newIngressTrait)
 	AddToTraits(newIstioTrait)
@@ -52,6 +54,7 @@ func init() {
 	AddToTraits(newQuarkusTrait)
 	AddToTraits(newRegistryTrait)
 	AddToTraits(newRouteTrait)
+	AddToTraits(newScheduleTrait)
 	AddToTraits(newSecurityContextTrait)
 	AddToTraits(newServiceTrait)
 	AddToTraits(newServiceBindingTrait)
@@ -213,6 +213,10 @@ type Environment struct {
 	IntegrationProfile *v1.IntegrationProfile
 	// The current Integration
 	Integration *v1.Integration
+	// The IntegrationPolicies enforced on the Integration
+	IntegrationPolicies []v1.IntegrationPolicy
+	// The changes applied to the trait configuration by the IntegrationPolicies
+	PolicyMutations []string
 	// The IntegrationKit associated to the Integration
 	IntegrationKit *v1.IntegrationKit
 	// The IntegrationKits to be created for the Integration
@@ -267,7 +271,7 @@ func (e *Environment) IntegrationInPhase(phases ...v1.IntegrationPhase) bool {
 }
 
 func (e *Environment) IntegrationInRunningPhases() bool {
-	return e.IntegrationInPhase(v1.IntegrationPhaseDeploying, v1.IntegrationPhaseRunning, v1.IntegrationPhaseError)
+	return e.IntegrationInPhase(v1.IntegrationPhaseDeploying, v1.IntegrationPhaseRunning, v1.IntegrationPhaseError, v1.IntegrationPhaseSuspended)
 }
 
 func (e *Environment) IntegrationKitInPhase(phases ...v1.IntegrationKitPhase) bool {
@@ -723,7 +727,7 @@ func (e *Environment) GetIntegrationContainer() *corev1.Container {
 	return e.Resources.GetContainerByName(containerName)
 }
 
-func (e *Environment) getIntegrationContainerPort() *corev1.ContainerPort {
+func (e *Environment) GetIntegrationContainerPort() *corev1.ContainerPort {
 	container := e.GetIntegrationContainer()
 	if container == nil {
 		return nil
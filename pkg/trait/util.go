@@ -195,6 +195,10 @@ func filterTransferableAnnotations(annotations map[string]string) map[string]str
 			// filter out kubectl annotations
 			continue
 		}
+		if k == v1.IntegrationSuspendedAnnotation {
+			// suspending the Integration must not roll out new Pods
+			continue
+		}
 		res[k] = v
 	}
 	return res
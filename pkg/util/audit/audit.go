@@ -0,0 +1,315 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apache/camel-k/v2/pkg/util/log"
+)
+
+const (
+	// SinkEnvVariable is the operator environment variable configuring the audit sink, i.e. `stdout`,
+	// `file:<path>` or a webhook `http(s)://` URL. The audit trail is disabled when it is not set.
+	SinkEnvVariable = "CAMEL_K_AUDIT_SINK"
+
+	// EntryKind identifies the audit entries, e.g. among the operator logs.
+	EntryKind = "IntegrationAuditEntry"
+
+	webhookTimeout = 10 * time.Second
+	// The number of entries queued for delivery to the webhook, before the recording of further entries blocks
+	webhookQueueSize  = 1000
+	webhookMinBackoff = 1 * time.Second
+	webhookMaxBackoff = 1 * time.Minute
+)
+
+var logger = log.WithName("audit")
+
+// Action is the kind of change recorded by an audit entry.
+type Action string
+
+const (
+	// ActionCreated --.
+	ActionCreated Action = "created"
+	// ActionUpdated --.
+	ActionUpdated Action = "updated"
+	// ActionRebuilt --.
+	ActionRebuilt Action = "rebuilt"
+	// ActionDeleted --.
+	ActionDeleted Action = "deleted"
+	// ActionReconciled records the digest resulting from the changes, once they are reconciled.
+	ActionReconciled Action = "reconciled"
+)
+
+// Entry records a change of an Integration observed by the operator, or its reconciliation.
+type Entry struct {
+	// always IntegrationAuditEntry
+	Kind string `json:"kind"`
+	// the time the change was observed, or reconciled
+	Timestamp time.Time `json:"timestamp"`
+	// the kind of change
+	Action Action `json:"action"`
+	// the namespace of the Integration
+	Namespace string `json:"namespace"`
+	// the name of the Integration
+	Integration string `json:"integration"`
+	// the generation of the Integration resulting from the change
+	Generation int64 `json:"generation,omitempty"`
+	// the user, or the field manager, that made the change
+	User string `json:"user,omitempty"`
+	// a summary of the changes of the traits, sources and dependencies
+	Changes []string `json:"changes,omitempty"`
+	// the digest of the Integration resulting from the changes, set on the reconciled entries
+	Digest string `json:"digest,omitempty"`
+}
+
+// Sink is where the audit entries are recorded.
+type Sink interface {
+	Record(ctx context.Context, entry Entry) error
+}
+
+// Flusher is implemented by the sinks that record the entries asynchronously.
+type Flusher interface {
+	// Flush waits until the recorded entries are delivered, or the context is done.
+	Flush(ctx context.Context) error
+}
+
+var (
+	sinkLock sync.RWMutex
+	sink     Sink
+)
+
+// SetSink configures the sink the audit entries are recorded to, or disables the audit trail if nil.
+func SetSink(s Sink) {
+	sinkLock.Lock()
+	defer sinkLock.Unlock()
+	sink = s
+}
+
+// Enabled returns true if an audit sink is configured.
+func Enabled() bool {
+	sinkLock.RLock()
+	defer sinkLock.RUnlock()
+	return sink != nil
+}
+
+// Record records the audit entry to the configured sink, if any.
+func Record(ctx context.Context, entry Entry) error {
+	sinkLock.RLock()
+	s := sink
+	sinkLock.RUnlock()
+	if s == nil {
+		return nil
+	}
+	entry.Kind = EntryKind
+	if entry.Timestamp.IsZero() {
+		entry.Timestamp = time.Now()
+	}
+	entry.Timestamp = entry.Timestamp.UTC()
+
+	return s.Record(ctx, entry)
+}
+
+// Flush waits until the entries recorded to the configured sink are delivered, or the context is done.
+func Flush(ctx context.Context) error {
+	sinkLock.RLock()
+	s := sink
+	sinkLock.RUnlock()
+	if f, ok := s.(Flusher); ok {
+		return f.Flush(ctx)
+	}
+
+	return nil
+}
+
+// NewSink creates the audit sink from its configuration, i.e. `stdout`, `file:<path>` or a webhook `http(s)://` URL.
+func NewSink(config string) (Sink, error) {
+	switch {
+	case config == "stdout":
+		return NewWriterSink(os.Stdout), nil
+	case strings.HasPrefix(config, "file:"):
+		path := strings.TrimPrefix(config, "file:")
+		if path == "" {
+			return nil, fmt.Errorf("invalid audit sink %q: missing file path", config)
+		}
+		return &fileSink{path: path}, nil
+	case strings.HasPrefix(config, "http://"), strings.HasPrefix(config, "https://"):
+		return newWebhookSink(config, webhookMinBackoff, webhookMaxBackoff), nil
+	default:
+		return nil, fmt.Errorf("invalid audit sink %q: expected stdout, file:<path> or a http(s):// URL", config)
+	}
+}
+
+// NewWriterSink creates an audit sink writing the entries as JSON lines.
+func NewWriterSink(w io.Writer) Sink {
+	return &writerSink{writer: w}
+}
+
+type writerSink struct {
+	lock   sync.Mutex
+	writer io.Writer
+}
+
+func (s *writerSink) Record(_ context.Context, entry Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err = s.writer.Write(append(data, '\n'))
+
+	return err
+}
+
+type fileSink struct {
+	lock sync.Mutex
+	path string
+}
+
+func (s *fileSink) Record(_ context.Context, entry Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	// #nosec G304
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// webhookSink posts the entries to the webhook asynchronously, so that the reconciliation does not wait for the
+// delivery. The entries are delivered in order, and each of them is retried until it is delivered. The recording
+// blocks while the queue is full, so that no entry is dropped when the webhook is not available.
+type webhookSink struct {
+	url        string
+	client     *http.Client
+	queue      chan Entry
+	pending    sync.WaitGroup
+	minBackoff time.Duration
+	maxBackoff time.Duration
+}
+
+func newWebhookSink(url string, minBackoff time.Duration, maxBackoff time.Duration) *webhookSink {
+	s := webhookSink{
+		url:        url,
+		client:     &http.Client{Timeout: webhookTimeout},
+		queue:      make(chan Entry, webhookQueueSize),
+		minBackoff: minBackoff,
+		maxBackoff: maxBackoff,
+	}
+	go s.deliver()
+
+	return &s
+}
+
+func (s *webhookSink) Record(ctx context.Context, entry Entry) error {
+	s.pending.Add(1)
+	select {
+	case s.queue <- entry:
+		return nil
+	case <-ctx.Done():
+		s.pending.Done()
+		return fmt.Errorf("cannot queue the entry for the audit webhook %s: %w", s.url, ctx.Err())
+	}
+}
+
+func (s *webhookSink) Flush(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.pending.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("entries not delivered to the audit webhook %s: %w", s.url, ctx.Err())
+	}
+}
+
+func (s *webhookSink) deliver() {
+	for entry := range s.queue {
+		data, err := json.Marshal(entry)
+		if err != nil {
+			logger.Errorf(err, "unable to encode the audit entry of integration %s/%s", entry.Namespace, entry.Integration)
+			s.pending.Done()
+			continue
+		}
+		backoff := s.minBackoff
+		for {
+			err := s.post(data)
+			if err == nil {
+				break
+			}
+			logger.Errorf(err, "unable to deliver the audit entry of integration %s/%s, retrying in %s", entry.Namespace, entry.Integration, backoff)
+			time.Sleep(backoff)
+			backoff *= 2
+			if backoff > s.maxBackoff {
+				backoff = s.maxBackoff
+			}
+		}
+		s.pending.Done()
+	}
+}
+
+func (s *webhookSink) post(data []byte) error {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, s.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("audit webhook %s returned status %d", s.url, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Parse parses an audit entry from a JSON line, returning false if the line is not an audit entry.
+func Parse(line []byte) (Entry, bool) {
+	var entry Entry
+	if err := json.Unmarshal(line, &entry); err != nil || entry.Kind != EntryKind {
+		return Entry{}, false
+	}
+
+	return entry, true
+}
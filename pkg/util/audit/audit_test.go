@@ -0,0 +1,170 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSink(t *testing.T) {
+	for _, config := range []string{"stdout", "file:/var/log/audit.log", "http://audit:8080/entries", "https://audit/entries"} {
+		s, err := NewSink(config)
+		require.NoError(t, err)
+		assert.NotNil(t, s)
+	}
+
+	_, err := NewSink("file:")
+	require.EqualError(t, err, `invalid audit sink "file:": missing file path`)
+	_, err = NewSink("syslog")
+	require.EqualError(t, err, `invalid audit sink "syslog": expected stdout, file:<path> or a http(s):// URL`)
+}
+
+func TestRecordToWriterSink(t *testing.T) {
+	var buf bytes.Buffer
+	SetSink(NewWriterSink(&buf))
+	defer SetSink(nil)
+	assert.True(t, Enabled())
+
+	require.NoError(t, Record(context.TODO(), Entry{
+		Action:      ActionUpdated,
+		Namespace:   "ns",
+		Integration: "my-it",
+		Generation:  2,
+		User:        "alice",
+		Changes:     []string{"trait cron added"},
+		Digest:      "vXyZ",
+	}))
+
+	entry, ok := Parse(bytes.TrimSpace(buf.Bytes()))
+	require.True(t, ok)
+	assert.Equal(t, EntryKind, entry.Kind)
+	assert.Equal(t, ActionUpdated, entry.Action)
+	assert.Equal(t, "my-it", entry.Integration)
+	assert.Equal(t, "alice", entry.User)
+	assert.Equal(t, []string{"trait cron added"}, entry.Changes)
+	assert.False(t, entry.Timestamp.IsZero())
+}
+
+func TestRecordWithoutSink(t *testing.T) {
+	SetSink(nil)
+	assert.False(t, Enabled())
+	require.NoError(t, Record(context.TODO(), Entry{Action: ActionCreated}))
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	s, err := NewSink("file:" + path)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Record(context.TODO(), Entry{Kind: EntryKind, Action: ActionCreated, Integration: "my-it"}))
+	require.NoError(t, s.Record(context.TODO(), Entry{Kind: EntryKind, Action: ActionDeleted, Integration: "my-it"}))
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	actions := make([]Action, 0)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		entry, ok := Parse(scanner.Bytes())
+		require.True(t, ok)
+		actions = append(actions, entry.Action)
+	}
+	assert.Equal(t, []Action{ActionCreated, ActionDeleted}, actions)
+}
+
+func TestWebhookSink(t *testing.T) {
+	var lock sync.Mutex
+	received := make([]Action, 0)
+	failures := 2
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		lock.Lock()
+		defer lock.Unlock()
+		// The webhook is not available for the first deliveries
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		entry, ok := Parse(data)
+		assert.True(t, ok)
+		received = append(received, entry.Action)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	s := newWebhookSink(server.URL, time.Millisecond, 10*time.Millisecond)
+	require.NoError(t, s.Record(context.TODO(), Entry{Kind: EntryKind, Action: ActionCreated, Integration: "my-it"}))
+	require.NoError(t, s.Record(context.TODO(), Entry{Kind: EntryKind, Action: ActionRebuilt, Integration: "my-it"}))
+
+	// The entries are retried, and delivered in order
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+	require.NoError(t, s.Flush(ctx))
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Equal(t, []Action{ActionCreated, ActionRebuilt}, received)
+	assert.Equal(t, 0, failures)
+}
+
+func TestWebhookSinkBackpressure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	s := newWebhookSink(server.URL, time.Hour, time.Hour)
+	// The recording blocks once the queue is full, instead of dropping the entry
+	recorded := 0
+	for {
+		ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+		err := s.Record(ctx, Entry{Kind: EntryKind, Action: ActionUpdated, Integration: "my-it"})
+		cancel()
+		if err != nil {
+			break
+		}
+		recorded++
+	}
+	assert.GreaterOrEqual(t, recorded, webhookQueueSize)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	require.Error(t, s.Flush(ctx))
+}
+
+func TestParseIgnoresOtherLines(t *testing.T) {
+	_, ok := Parse([]byte(`{"level":"info","ts":1,"msg":"Reconciling Integration"}`))
+	assert.False(t, ok)
+	_, ok = Parse([]byte("not json"))
+	assert.False(t, ok)
+}
@@ -0,0 +1,148 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+// kamelFieldManager is the field manager of the changes made with kamel.
+const kamelFieldManager = "kamel"
+
+// Author returns who made the last change to the Integration: the user recorded by kamel in the changed-by
+// annotation when the change was made with kamel, or the field manager of the change otherwise. The field manager
+// is preferred whenever it disagrees with the annotation, as the annotation is left unchanged by the other clients,
+// while kamel overwrites, or clears, it on every write.
+func Author(it *v1.Integration) string {
+	user := it.Annotations[v1.IntegrationChangedByAnnotation]
+	manager := ""
+	var last *metav1.Time
+	for _, f := range it.ManagedFields {
+		// Only consider the changes to the resource, not to its status
+		if f.Subresource != "" || f.Time == nil {
+			continue
+		}
+		if last == nil || !f.Time.Before(last) {
+			last = f.Time
+			manager = f.Manager
+		}
+	}
+	if manager == "" || manager == kamelFieldManager && user != "" {
+		return user
+	}
+
+	return manager
+}
+
+// Changes returns a summary of the changes between two versions of the Integration spec, e.g. `trait cron added`,
+// `source routes.yaml changed` or `dependency mvn:org.acme:lib removed`.
+func Changes(old *v1.Integration, it *v1.Integration) []string {
+	changes := make([]string, 0)
+	changes = append(changes, traitChanges(old.Spec.Traits, it.Spec.Traits)...)
+
+	oldSources := make(map[string]v1.SourceSpec)
+	for _, s := range old.Spec.Sources {
+		oldSources[s.Name] = s
+	}
+	newSources := make(map[string]v1.SourceSpec)
+	for _, s := range it.Spec.Sources {
+		newSources[s.Name] = s
+	}
+	changes = append(changes, mapChanges("source", oldSources, newSources)...)
+
+	oldDependencies := make(map[string]bool)
+	for _, d := range old.Spec.Dependencies {
+		oldDependencies[d] = true
+	}
+	newDependencies := make(map[string]bool)
+	for _, d := range it.Spec.Dependencies {
+		newDependencies[d] = true
+	}
+	changes = append(changes, mapChanges("dependency", oldDependencies, newDependencies)...)
+
+	// Summarize the changes of the other spec fields by field name
+	oldSpec := specFields(old.Spec)
+	newSpec := specFields(it.Spec)
+	for _, field := range []string{"traits", "sources", "dependencies"} {
+		delete(oldSpec, field)
+		delete(newSpec, field)
+	}
+	changes = append(changes, mapChanges("field", oldSpec, newSpec)...)
+
+	return changes
+}
+
+func traitChanges(old v1.Traits, traits v1.Traits) []string {
+	return mapChanges("trait", traitFields(old), traitFields(traits))
+}
+
+// traitFields returns the configuration of each trait, including the addons, by trait id.
+func traitFields(traits v1.Traits) map[string]json.RawMessage {
+	fields := make(map[string]json.RawMessage)
+	data, err := json.Marshal(traits)
+	if err != nil {
+		return fields
+	}
+	_ = json.Unmarshal(data, &fields)
+	if addons, ok := fields["addons"]; ok {
+		delete(fields, "addons")
+		addonFields := make(map[string]json.RawMessage)
+		_ = json.Unmarshal(addons, &addonFields)
+		for id, addon := range addonFields {
+			fields[id] = addon
+		}
+	}
+
+	return fields
+}
+
+func specFields(spec v1.IntegrationSpec) map[string]json.RawMessage {
+	fields := make(map[string]json.RawMessage)
+	if data, err := json.Marshal(spec); err == nil {
+		_ = json.Unmarshal(data, &fields)
+	}
+
+	return fields
+}
+
+// mapChanges returns the sorted list of the keys added, removed or changed between the two maps.
+func mapChanges[V any](kind string, old map[string]V, values map[string]V) []string {
+	changes := make([]string, 0)
+	for key, value := range values {
+		if previous, ok := old[key]; !ok {
+			changes = append(changes, fmt.Sprintf("%s %s added", kind, key))
+		} else if !reflect.DeepEqual(previous, value) {
+			changes = append(changes, fmt.Sprintf("%s %s changed", kind, key))
+		}
+	}
+	for key := range old {
+		if _, ok := values[key]; !ok {
+			changes = append(changes, fmt.Sprintf("%s %s removed", kind, key))
+		}
+	}
+	sort.Strings(changes)
+
+	return changes
+}
@@ -0,0 +1,91 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+)
+
+func TestChanges(t *testing.T) {
+	old := v1.NewIntegration("ns", "my-it")
+	old.Spec = v1.IntegrationSpec{
+		Sources: []v1.SourceSpec{
+			v1.NewSourceSpec("routes.yaml", "- from: timer:tick", v1.LanguageYaml),
+			v1.NewSourceSpec("Routes.java", "class Routes {}", v1.LanguageJavaSource),
+		},
+		Dependencies: []string{"camel:http", "mvn:org.acme:lib:1.0"},
+		Traits: v1.Traits{
+			Container: &trait.ContainerTrait{Name: "main"},
+			Logging:   &trait.LoggingTrait{Level: "INFO"},
+		},
+	}
+	it := old.DeepCopy()
+	it.Spec.Sources[0].Content = "- from: timer:tock"
+	it.Spec.Sources = append(it.Spec.Sources[:1], v1.NewSourceSpec("more.yaml", "", v1.LanguageYaml))
+	it.Spec.Dependencies = []string{"camel:http", "camel:kafka"}
+	it.Spec.Traits.Logging.Level = "DEBUG"
+	it.Spec.Traits.Container = nil
+	it.Spec.Traits.Cron = &trait.CronTrait{Schedule: "* * * * *"}
+	it.Spec.Replicas = pointer.Int32(2)
+
+	assert.Equal(t, []string{
+		"trait container removed",
+		"trait cron added",
+		"trait logging changed",
+		"source Routes.java removed",
+		"source more.yaml added",
+		"source routes.yaml changed",
+		"dependency camel:kafka added",
+		"dependency mvn:org.acme:lib:1.0 removed",
+		"field replicas added",
+	}, Changes(&old, it))
+
+	assert.Empty(t, Changes(&old, old.DeepCopy()))
+}
+
+func TestAuthor(t *testing.T) {
+	it := v1.NewIntegration("ns", "my-it")
+	assert.Equal(t, "", Author(&it))
+
+	earlier := metav1.NewTime(time.Now().Add(-time.Hour))
+	later := metav1.NewTime(time.Now())
+	it.ManagedFields = []metav1.ManagedFieldsEntry{
+		{Manager: "kamel", Operation: metav1.ManagedFieldsOperationUpdate, Time: &earlier},
+		{Manager: "kubectl-edit", Operation: metav1.ManagedFieldsOperationUpdate, Time: &later},
+		{Manager: "kamel", Operation: metav1.ManagedFieldsOperationUpdate, Subresource: "status", Time: &later},
+	}
+	it.Annotations = map[string]string{v1.IntegrationChangedByAnnotation: "alice"}
+	// The last change was not made with kamel
+	assert.Equal(t, "kubectl-edit", Author(&it))
+
+	it.ManagedFields[0].Time = &later
+	it.ManagedFields[1].Time = &earlier
+	assert.Equal(t, "alice", Author(&it))
+
+	delete(it.Annotations, v1.IntegrationChangedByAnnotation)
+	assert.Equal(t, "kamel", Author(&it))
+}
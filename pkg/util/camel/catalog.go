@@ -84,7 +84,7 @@ func GenerateCatalog(
 	if err != nil {
 		return nil, err
 	}
-	settings, err := maven.NewSettings(maven.DefaultRepositories, maven.ProxyFromEnvironment)
+	settings, err := maven.NewSettings(maven.DefaultRepositories, maven.ProxyFromEnvironment, maven.OfflineBundle(mvn.OfflineBundle))
 	if err != nil {
 		return nil, err
 	}
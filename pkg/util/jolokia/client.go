@@ -0,0 +1,143 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jolokia
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// clientTimeout bounds the requests to the agent, so that an unresponsive agent does not block the commands.
+const clientTimeout = 10 * time.Second
+
+// Client is a minimal client of the Jolokia agent enabled by the jolokia trait.
+type Client struct {
+	url        string
+	user       string
+	password   string
+	httpClient *http.Client
+}
+
+// NewClient returns a Client for the Jolokia agent served at the given base URL, e.g. http://localhost:8778/jolokia.
+// The certificate of the agent is not verified when insecure is true.
+func NewClient(url string, insecure bool) *Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if insecure {
+		//nolint:gosec
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	return &Client{
+		url:        strings.TrimSuffix(url, "/") + "/",
+		httpClient: &http.Client{Transport: transport, Timeout: clientTimeout},
+	}
+}
+
+// WithBasicAuth sets the credentials used to authenticate to the agent.
+func (c *Client) WithBasicAuth(user string, password string) *Client {
+	c.user = user
+	c.password = password
+	return c
+}
+
+type request struct {
+	Type      string        `json:"type"`
+	MBean     string        `json:"mbean"`
+	Attribute []string      `json:"attribute,omitempty"`
+	Operation string        `json:"operation,omitempty"`
+	Arguments []interface{} `json:"arguments,omitempty"`
+}
+
+type response struct {
+	Status int             `json:"status"`
+	Value  json.RawMessage `json:"value"`
+	Error  string          `json:"error"`
+}
+
+// Read reads the attributes of the MBeans matching the pattern, and unmarshals them into value, as a map of
+// the attributes by MBean name.
+func (c *Client) Read(ctx context.Context, pattern string, attributes []string, value interface{}) error {
+	return c.do(ctx, request{
+		Type:      "read",
+		MBean:     pattern,
+		Attribute: attributes,
+	}, value)
+}
+
+// Exec invokes the operation of the MBean, and unmarshals its result into value, unless value is nil.
+func (c *Client) Exec(ctx context.Context, mbean string, operation string, value interface{}, arguments ...interface{}) error {
+	return c.do(ctx, request{
+		Type:      "exec",
+		MBean:     mbean,
+		Operation: operation,
+		Arguments: arguments,
+	}, value)
+}
+
+func (c *Client) do(ctx context.Context, r request, value interface{}) error {
+	body, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.user != "" {
+		req.SetBasicAuth(c.user, c.password)
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	content, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("jolokia request %s %s failed with status %s", r.Type, r.MBean, res.Status)
+	}
+
+	var result response
+	if err := json.Unmarshal(content, &result); err != nil {
+		return err
+	}
+	if result.Status != http.StatusOK {
+		if result.Error == "" {
+			return fmt.Errorf("jolokia request %s %s failed with status %d", r.Type, r.MBean, result.Status)
+		}
+		return errors.New(result.Error)
+	}
+	if value == nil || len(result.Value) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(result.Value, value)
+}
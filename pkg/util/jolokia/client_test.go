@@ -0,0 +1,90 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jolokia
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRead(t *testing.T) {
+	var received map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "admin", user)
+		assert.Equal(t, "secret", password)
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &received)
+		_, _ = w.Write([]byte(`{"status":200,"value":{"org.apache.camel:context=camel-1,name=\"route1\",type=routes":{"RouteId":"route1","ExchangesTotal":12}}}`))
+	}))
+	defer server.Close()
+
+	var value map[string]struct {
+		RouteID        string `json:"RouteId"`
+		ExchangesTotal int64  `json:"ExchangesTotal"`
+	}
+	c := NewClient(server.URL+"/jolokia", false).WithBasicAuth("admin", "secret")
+	err := c.Read(context.TODO(), "org.apache.camel:type=routes,*", []string{"RouteId", "ExchangesTotal"}, &value)
+	require.NoError(t, err)
+
+	assert.Equal(t, "read", received["type"])
+	assert.Equal(t, "org.apache.camel:type=routes,*", received["mbean"])
+	assert.Equal(t, []interface{}{"RouteId", "ExchangesTotal"}, received["attribute"])
+	require.Len(t, value, 1)
+	assert.Equal(t, "route1", value[`org.apache.camel:context=camel-1,name="route1",type=routes`].RouteID)
+	assert.Equal(t, int64(12), value[`org.apache.camel:context=camel-1,name="route1",type=routes`].ExchangesTotal)
+}
+
+func TestExecError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":404,"error":"javax.management.InstanceNotFoundException : org.apache.camel:type=routes,name=\"missing\""}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, false)
+	err := c.Exec(context.TODO(), `org.apache.camel:type=routes,name="missing"`, "stop", nil)
+	require.EqualError(t, err, `javax.management.InstanceNotFoundException : org.apache.camel:type=routes,name="missing"`)
+}
+
+func TestInsecure(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":200,"value":"Started"}`))
+	}))
+	defer server.Close()
+
+	var state string
+	err := NewClient(server.URL, false).Exec(context.TODO(), `org.apache.camel:type=routes,name="route1"`, "getState", &state)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "certificate")
+
+	err = NewClient(server.URL, true).Exec(context.TODO(), `org.apache.camel:type=routes,name="route1"`, "getState", &state)
+	require.NoError(t, err)
+	assert.Equal(t, "Started", state)
+}
+
+func TestTimeout(t *testing.T) {
+	assert.Equal(t, clientTimeout, NewClient("http://localhost:8778/jolokia", false).httpClient.Timeout)
+}
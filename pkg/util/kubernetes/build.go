@@ -0,0 +1,56 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+// CancelBuild requests the operator to cancel the Build. The IntegrationKit waiting for the Build, if any,
+// is put in error with the given reason, so that it does not submit the Build again.
+func CancelBuild(ctx context.Context, c ctrl.Client, build *v1.Build, reason string) error {
+	build.Status.Phase = v1.BuildPhaseCancelling
+	if err := c.Status().Update(ctx, build); err != nil {
+		return err
+	}
+
+	owner := metav1.GetControllerOf(build)
+	if owner == nil || owner.Kind != v1.IntegrationKitKind {
+		return nil
+	}
+	kit := v1.NewIntegrationKit(build.Namespace, owner.Name)
+	if err := c.Get(ctx, ctrl.ObjectKeyFromObject(kit), kit); err != nil {
+		return ctrl.IgnoreNotFound(err)
+	}
+	if kit.Status.Phase != v1.IntegrationKitPhaseBuildSubmitted && kit.Status.Phase != v1.IntegrationKitPhaseBuildRunning {
+		return nil
+	}
+	kit.Status.Phase = v1.IntegrationKitPhaseError
+	kit.Status.Failure = &v1.Failure{
+		Reason: reason,
+		Time:   metav1.Now(),
+	}
+
+	return c.Status().Update(ctx, kit)
+}
@@ -40,6 +40,9 @@ const (
 	CamelClonedLabelName      = CamelClonedLabelPrefix + ".name"
 	CamelClonedLabelNamespace = CamelClonedLabelPrefix + ".namespace"
 	CamelClonedLabelVersion   = CamelClonedLabelPrefix + ".version"
+
+	// CamelProjectLabel marks the resources managed through a project manifest.
+	CamelProjectLabel = "camel.apache.org/project"
 )
 
 // FilterCamelCreatorLabels is used to inherit the creator information among resources.
@@ -22,6 +22,7 @@ import (
 	"context"
 	"io"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -69,17 +70,28 @@ func (s *SelectorScraper) Start(ctx context.Context) *bufio.Reader {
 	pipeIn, pipeOut := io.Pipe()
 	bufPipeIn := bufio.NewReader(pipeIn)
 	bufPipeOut := bufio.NewWriter(pipeOut)
-	closeFun := func() error {
-		return multierr.Append(
-			bufPipeOut.Flush(),
-			pipeOut.Close())
+	sink := &writerSink{
+		out: bufPipeOut,
+		closer: func() error {
+			return multierr.Append(
+				bufPipeOut.Flush(),
+				pipeOut.Close())
+		},
 	}
-	go s.periodicSynchronize(ctx, bufPipeOut, closeFun)
+	go s.periodicSynchronize(ctx, sink)
 	return bufPipeIn
 }
 
-func (s *SelectorScraper) periodicSynchronize(ctx context.Context, out *bufio.Writer, clientCloser func() error) {
-	if err := s.synchronize(ctx, out); err != nil {
+// StartLines returns a channel streaming the log lines of all selected pods, along with the name of the pod
+// each line comes from. The channel is never closed, the consumer should stop reading when the context is done.
+func (s *SelectorScraper) StartLines(ctx context.Context) <-chan Line {
+	lines := make(chan Line)
+	go s.periodicSynchronize(ctx, &channelSink{ctx: ctx, lines: lines})
+	return lines
+}
+
+func (s *SelectorScraper) periodicSynchronize(ctx context.Context, sink lineSink) {
+	if err := s.synchronize(ctx, sink); err != nil {
 		s.L.Info("Could not synchronize log")
 	}
 	select {
@@ -92,15 +104,15 @@ func (s *SelectorScraper) periodicSynchronize(ctx context.Context, out *bufio.Wr
 
 			return true
 		})
-		if err := clientCloser(); err != nil {
+		if err := sink.close(); err != nil {
 			s.L.Error(err, "Unable to close the client")
 		}
 	case <-time.After(scraperPeriodicTimeout):
-		go s.periodicSynchronize(ctx, out, clientCloser)
+		go s.periodicSynchronize(ctx, sink)
 	}
 }
 
-func (s *SelectorScraper) synchronize(ctx context.Context, out *bufio.Writer) error {
+func (s *SelectorScraper) synchronize(ctx context.Context, sink lineSink) error {
 	list, err := s.listPods(ctx)
 	if err != nil {
 		return err
@@ -110,7 +122,7 @@ func (s *SelectorScraper) synchronize(ctx context.Context, out *bufio.Writer) er
 	for _, pod := range list.Items {
 		present[pod.Name] = true
 		if _, ok := s.podScrapers.Load(pod.Name); !ok {
-			s.addPodScraper(ctx, pod.Name, out)
+			s.addPodScraper(ctx, pod.Name, sink)
 		}
 	}
 
@@ -136,17 +148,16 @@ func (s *SelectorScraper) synchronize(ctx context.Context, out *bufio.Writer) er
 	return nil
 }
 
-func (s *SelectorScraper) addPodScraper(ctx context.Context, podName string, out *bufio.Writer) {
+func (s *SelectorScraper) addPodScraper(ctx context.Context, podName string, sink lineSink) {
 	podScraper := NewPodScraper(s.client, s.namespace, podName, s.defaultContainerName, s.tailLines)
 	podCtx, podCancel := context.WithCancel(ctx)
 	id := atomic.AddUint64(&s.counter, 1)
-	prefix := "[" + strconv.FormatUint(id, 10) + "] "
 	podReader := podScraper.Start(podCtx)
 	s.podScrapers.Store(podName, podCancel)
 	go func() {
 		defer podCancel()
 
-		if _, err := out.WriteString(prefix + "Monitoring pod " + podName + "\n"); err != nil {
+		if err := sink.started(id, podName); err != nil {
 			s.L.Error(err, "Cannot write to output")
 			return
 		}
@@ -158,14 +169,10 @@ func (s *SelectorScraper) addPodScraper(ctx context.Context, podName string, out
 				s.L.Error(err, "Cannot read from pod stream")
 				return
 			}
-			if _, err := out.WriteString(prefix + str); err != nil {
+			if err := sink.write(id, podName, str); err != nil {
 				s.L.Error(err, "Cannot write to output")
 				return
 			}
-			if err := out.Flush(); err != nil {
-				s.L.Error(err, "Cannot flush output")
-				return
-			}
 			if podCtx.Err() != nil {
 				return
 			}
@@ -183,3 +190,67 @@ func (s *SelectorScraper) listPods(ctx context.Context) (*corev1.PodList, error)
 
 	return list, nil
 }
+
+// Line is a log line scraped from a pod.
+type Line struct {
+	// the name of the pod
+	Pod string
+	// the log line, without the trailing new line
+	Text string
+}
+
+// lineSink receives the log lines scraped from the pods.
+type lineSink interface {
+	started(id uint64, podName string) error
+	write(id uint64, podName string, line string) error
+	close() error
+}
+
+// writerSink writes the log lines prefixed with the pod identifier.
+type writerSink struct {
+	out    *bufio.Writer
+	closer func() error
+}
+
+func (w *writerSink) started(id uint64, podName string) error {
+	_, err := w.out.WriteString(podPrefix(id) + "Monitoring pod " + podName + "\n")
+	return err
+}
+
+func (w *writerSink) write(id uint64, _ string, line string) error {
+	if _, err := w.out.WriteString(podPrefix(id) + line); err != nil {
+		return err
+	}
+	return w.out.Flush()
+}
+
+func (w *writerSink) close() error {
+	return w.closer()
+}
+
+func podPrefix(id uint64) string {
+	return "[" + strconv.FormatUint(id, 10) + "] "
+}
+
+// channelSink sends the log lines to a channel.
+type channelSink struct {
+	ctx   context.Context
+	lines chan<- Line
+}
+
+func (c *channelSink) started(_ uint64, _ string) error {
+	return nil
+}
+
+func (c *channelSink) write(_ uint64, podName string, line string) error {
+	select {
+	case c.lines <- Line{Pod: podName, Text: strings.TrimRight(line, "\r\n")}:
+		return nil
+	case <-c.ctx.Done():
+		return c.ctx.Err()
+	}
+}
+
+func (c *channelSink) close() error {
+	return nil
+}
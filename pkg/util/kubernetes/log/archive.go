@@ -0,0 +1,149 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+const (
+	// BuildLogKey is the ConfigMap key holding a chunk of the compressed Build log.
+	BuildLogKey = "build.log.gz"
+	// BuildLogCompression is the compression algorithm of the archived Build logs.
+	BuildLogCompression = "gzip"
+
+	// ConfigMaps are limited to 1MiB, leave room for the metadata.
+	buildLogChunkSize = 900 * 1024
+	// Only the tail of larger logs is archived, as it's where the failures are reported.
+	buildLogMaxSize = 32 * 1024 * 1024
+)
+
+// ErrNoBuildLog is returned when no log has been archived for a Build.
+var ErrNoBuildLog = errors.New("no archived log")
+
+// BuildLogConfigMapName returns the name of the ConfigMap holding the given chunk of the Build log.
+func BuildLogConfigMapName(build *v1.Build, chunk int) string {
+	return fmt.Sprintf("%s-log-%d", build.Name, chunk)
+}
+
+// ArchiveBuildLog compresses the given log and stores it into ConfigMaps owned by the Build.
+func ArchiveBuildLog(ctx context.Context, c ctrl.Client, build *v1.Build, data []byte) (*v1.BuildLog, error) {
+	size := int64(len(data))
+	if len(data) > buildLogMaxSize {
+		data = append([]byte("... (truncated)\n"), data[len(data)-buildLogMaxSize:]...)
+	}
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	controller := true
+	blockOwnerDeletion := true
+	compressed := buf.Bytes()
+	archive := v1.BuildLog{
+		Compression: BuildLogCompression,
+		Size:        size,
+	}
+	for chunk := 0; len(archive.ConfigMaps) == 0 || len(compressed) > 0; chunk++ {
+		n := len(compressed)
+		if n > buildLogChunkSize {
+			n = buildLogChunkSize
+		}
+		cm := corev1.ConfigMap{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "ConfigMap",
+				APIVersion: "v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      BuildLogConfigMapName(build, chunk),
+				Namespace: build.Namespace,
+				Labels: map[string]string{
+					v1.BuildLabel: build.Name,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion:         v1.SchemeGroupVersion.String(),
+						Kind:               v1.BuildKind,
+						Name:               build.Name,
+						UID:                build.UID,
+						Controller:         &controller,
+						BlockOwnerDeletion: &blockOwnerDeletion,
+					}},
+			},
+			BinaryData: map[string][]byte{
+				BuildLogKey: compressed[:n],
+			},
+		}
+		err := c.Create(ctx, &cm)
+		if err != nil && k8serrors.IsAlreadyExists(err) {
+			// A previous attempt of the Build may have left it behind
+			err = c.Update(ctx, &cm)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot archive the log of build %s: %w", build.Name, err)
+		}
+		archive.ConfigMaps = append(archive.ConfigMaps, cm.Name)
+		compressed = compressed[n:]
+	}
+
+	return &archive, nil
+}
+
+// ReadBuildLog returns the log archived for the Build.
+func ReadBuildLog(ctx context.Context, c ctrl.Reader, build *v1.Build) ([]byte, error) {
+	if build.Status.Log == nil || len(build.Status.Log.ConfigMaps) == 0 {
+		return nil, ErrNoBuildLog
+	}
+	if build.Status.Log.Compression != BuildLogCompression {
+		return nil, fmt.Errorf("unsupported build log compression: %s", build.Status.Log.Compression)
+	}
+
+	var compressed bytes.Buffer
+	for _, name := range build.Status.Log.ConfigMaps {
+		cm := corev1.ConfigMap{}
+		if err := c.Get(ctx, ctrl.ObjectKey{Namespace: build.Namespace, Name: name}, &cm); err != nil {
+			return nil, fmt.Errorf("cannot read the log of build %s: %w", build.Name, err)
+		}
+		compressed.Write(cm.BinaryData[BuildLogKey])
+	}
+
+	r, err := gzip.NewReader(&compressed)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
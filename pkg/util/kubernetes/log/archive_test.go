@@ -0,0 +1,82 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/test"
+)
+
+func TestArchiveBuildLog(t *testing.T) {
+	c, err := test.NewFakeClient()
+	require.NoError(t, err)
+	build := v1.NewBuild("default", "my-build")
+	data := []byte("[INFO] BUILD SUCCESS\n")
+
+	archive, err := ArchiveBuildLog(context.TODO(), c, build, data)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"my-build-log-0"}, archive.ConfigMaps)
+	assert.Equal(t, BuildLogCompression, archive.Compression)
+	assert.Equal(t, int64(len(data)), archive.Size)
+
+	build.Status.Log = archive
+	log, err := ReadBuildLog(context.TODO(), c, build)
+	require.NoError(t, err)
+	assert.Equal(t, data, log)
+}
+
+func TestArchiveBuildLogChunks(t *testing.T) {
+	c, err := test.NewFakeClient()
+	require.NoError(t, err)
+	build := v1.NewBuild("default", "my-build")
+	// Random bytes do not compress
+	data := make([]byte, 2*buildLogChunkSize)
+	rand.New(rand.NewSource(0)).Read(data)
+
+	archive, err := ArchiveBuildLog(context.TODO(), c, build, data)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"my-build-log-0", "my-build-log-1", "my-build-log-2"}, archive.ConfigMaps)
+
+	build.Status.Log = archive
+	log, err := ReadBuildLog(context.TODO(), c, build)
+	require.NoError(t, err)
+	assert.Equal(t, data, log)
+
+	// Archiving again the log of a recovered Build overrides the chunks
+	archive, err = ArchiveBuildLog(context.TODO(), c, build, []byte("[ERROR] BUILD FAILURE\n"))
+	require.NoError(t, err)
+	build.Status.Log = archive
+	log, err = ReadBuildLog(context.TODO(), c, build)
+	require.NoError(t, err)
+	assert.Equal(t, "[ERROR] BUILD FAILURE\n", string(log))
+}
+
+func TestReadBuildLogMissing(t *testing.T) {
+	c, err := test.NewFakeClient()
+	require.NoError(t, err)
+
+	_, err = ReadBuildLog(context.TODO(), c, v1.NewBuild("default", "my-build"))
+	require.ErrorIs(t, err, ErrNoBuildLog)
+}
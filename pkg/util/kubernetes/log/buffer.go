@@ -0,0 +1,99 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"sync"
+)
+
+// BuildLogBufferSize is the maximum size of the Build log kept in memory while the Build runs.
+const BuildLogBufferSize = 8 * 1024 * 1024
+
+const truncatedLogPrefix = "... (truncated)\n"
+
+// TailBuffer is a ring buffer keeping the tail of the written log, up to a maximum size.
+// It is safe for concurrent use.
+type TailBuffer struct {
+	lock sync.Mutex
+	data []byte
+	max  int
+	pos  int
+	full bool
+}
+
+// NewTailBuffer returns a buffer keeping at most size bytes. The memory is allocated as the log grows.
+func NewTailBuffer(size int) *TailBuffer {
+	return &TailBuffer{max: size}
+}
+
+// Write appends p to the buffer, discarding the oldest bytes once the maximum size is reached.
+func (b *TailBuffer) Write(p []byte) (int, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	n := len(p)
+	if n >= b.max {
+		// Only the tail of p fits
+		b.data = append(b.data[:0], p[n-b.max:]...)
+		b.pos = 0
+		b.full = true
+		return n, nil
+	}
+	if !b.full {
+		if len(b.data)+n <= b.max {
+			b.data = append(b.data, p...)
+			return n, nil
+		}
+		// Switch to ring mode
+		b.pos = len(b.data)
+		b.data = append(b.data, make([]byte, b.max-len(b.data))...)
+		b.full = true
+	}
+	for len(p) > 0 {
+		c := copy(b.data[b.pos:], p)
+		p = p[c:]
+		b.pos = (b.pos + c) % b.max
+	}
+
+	return n, nil
+}
+
+// Bytes returns a copy of the buffered log, prefixed with a marker if its head has been discarded.
+func (b *TailBuffer) Bytes() []byte {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if !b.full {
+		return append([]byte{}, b.data...)
+	}
+	out := make([]byte, 0, len(truncatedLogPrefix)+b.max)
+	out = append(out, truncatedLogPrefix...)
+	out = append(out, b.data[b.pos:]...)
+
+	return append(out, b.data[:b.pos]...)
+}
+
+// Reset discards the buffered log and releases its memory.
+func (b *TailBuffer) Reset() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.data = nil
+	b.pos = 0
+	b.full = false
+}
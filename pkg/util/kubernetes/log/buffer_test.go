@@ -0,0 +1,44 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTailBuffer(t *testing.T) {
+	b := NewTailBuffer(8)
+	fmt.Fprint(b, "abc")
+	fmt.Fprint(b, "def")
+	assert.Equal(t, "abcdef", string(b.Bytes()))
+
+	fmt.Fprint(b, "ghij")
+	assert.Equal(t, "... (truncated)\ncdefghij", string(b.Bytes()))
+	fmt.Fprint(b, "klmnopqrs")
+	assert.Equal(t, "... (truncated)\nlmnopqrs", string(b.Bytes()))
+	fmt.Fprint(b, "tu")
+	assert.Equal(t, "... (truncated)\nnopqrstu", string(b.Bytes()))
+
+	b.Reset()
+	assert.Empty(t, b.Bytes())
+	fmt.Fprint(b, "vw")
+	assert.Equal(t, "vw", string(b.Bytes()))
+}
@@ -0,0 +1,178 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// MDCExchangeID is the MDC key holding the Camel exchange ID.
+	MDCExchangeID = "camel.exchangeId"
+	// MDCBreadcrumbID is the MDC key holding the Camel breadcrumb ID.
+	MDCBreadcrumbID = "camel.breadcrumbId"
+	// MDCRouteID is the MDC key holding the Camel route ID.
+	MDCRouteID = "camel.routeId"
+)
+
+var levelSeverities = map[string]int{
+	"TRACE":   0,
+	"FINEST":  0,
+	"FINER":   0,
+	"DEBUG":   1,
+	"FINE":    1,
+	"CONFIG":  1,
+	"INFO":    2,
+	"WARN":    3,
+	"WARNING": 3,
+	"ERROR":   4,
+	"SEVERE":  4,
+	"FATAL":   5,
+}
+
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02 15:04:05.999999999",
+}
+
+// Record is a log record produced by an Integration when the JSON output of the logging trait is enabled.
+type Record struct {
+	Timestamp  time.Time         `json:"-"`
+	Level      string            `json:"level"`
+	LoggerName string            `json:"loggerName"`
+	Message    string            `json:"message"`
+	ThreadName string            `json:"threadName"`
+	MDC        map[string]string `json:"mdc"`
+	StackTrace string            `json:"stackTrace"`
+	// Raw holds all the fields of the record
+	Raw map[string]interface{} `json:"-"`
+}
+
+// ParseRecord parses a JSON log record. It returns false if the line is not a JSON log record.
+func ParseRecord(line string) (*Record, bool) {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "{") {
+		return nil, false
+	}
+	record := Record{}
+	if err := json.Unmarshal([]byte(line), &record); err != nil {
+		return nil, false
+	}
+	if err := json.Unmarshal([]byte(line), &record.Raw); err != nil {
+		return nil, false
+	}
+	if ts, ok := record.Raw["timestamp"].(string); ok {
+		record.Timestamp = parseTimestamp(ts)
+	}
+	if record.StackTrace == "" {
+		if exception, ok := record.Raw["exception"].(map[string]interface{}); ok {
+			record.StackTrace = fmt.Sprintf("%v: %v", exception["exceptionType"], exception["message"])
+		}
+	}
+
+	return &record, true
+}
+
+func parseTimestamp(value string) time.Time {
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// RouteID returns the ID of the Camel route that produced the record, if any.
+func (r *Record) RouteID() string {
+	return r.MDC[MDCRouteID]
+}
+
+// Filter selects the log records.
+type Filter struct {
+	// the minimum level of the records
+	Level string
+	// the prefix of the logger name of the records
+	Logger string
+	// the Camel route ID of the records
+	RouteID string
+	// the MDC values of the records
+	MDC map[string]string
+	// the records produced before are discarded
+	Since time.Time
+	// the records produced after are discarded
+	Until time.Time
+}
+
+// Validate checks the filter is valid.
+func (f *Filter) Validate() error {
+	if f.Level != "" {
+		if _, ok := levelSeverities[strings.ToUpper(f.Level)]; !ok {
+			return fmt.Errorf("unknown log level %q", f.Level)
+		}
+	}
+	if !f.Since.IsZero() && !f.Until.IsZero() && f.Until.Before(f.Since) {
+		return fmt.Errorf("the end of the time window %s is before its start %s", f.Until.Format(time.RFC3339), f.Since.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// IsEmpty returns true if the filter selects all the records.
+func (f *Filter) IsEmpty() bool {
+	return f.Level == "" && f.Logger == "" && f.RouteID == "" && len(f.MDC) == 0 && f.Since.IsZero() && f.Until.IsZero()
+}
+
+// Matches returns true if the record is selected by the filter.
+func (f *Filter) Matches(r *Record) bool {
+	if f.Level != "" && severity(r.Level) < severity(f.Level) {
+		return false
+	}
+	if f.Logger != "" && !strings.HasPrefix(r.LoggerName, f.Logger) {
+		return false
+	}
+	if f.RouteID != "" && r.RouteID() != f.RouteID {
+		return false
+	}
+	for k, v := range f.MDC {
+		if r.MDC[k] != v {
+			return false
+		}
+	}
+	if !r.Timestamp.IsZero() {
+		if !f.Since.IsZero() && r.Timestamp.Before(f.Since) {
+			return false
+		}
+		if !f.Until.IsZero() && r.Timestamp.After(f.Until) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func severity(level string) int {
+	if s, ok := levelSeverities[strings.ToUpper(level)]; ok {
+		return s
+	}
+	// Unknown levels are never filtered out
+	return levelSeverities["FATAL"]
+}
@@ -0,0 +1,84 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRecord = `{"timestamp":"2023-10-18T10:15:30.123+02:00","sequence":42,"loggerClassName":"org.slf4j.impl.Slf4jLogger",` +
+	`"loggerName":"org.apache.camel.component.log","level":"WARN","message":"Hello","threadName":"Camel (camel-1) thread #1",` +
+	`"mdc":{"camel.exchangeId":"ABC-1","camel.breadcrumbId":"ABC-1","camel.routeId":"route1"}}`
+
+func TestParseRecord(t *testing.T) {
+	record, ok := ParseRecord(testRecord)
+	require.True(t, ok)
+	assert.Equal(t, "WARN", record.Level)
+	assert.Equal(t, "org.apache.camel.component.log", record.LoggerName)
+	assert.Equal(t, "Hello", record.Message)
+	assert.Equal(t, "route1", record.RouteID())
+	assert.Equal(t, "2023-10-18T08:15:30.123Z", record.Timestamp.UTC().Format(time.RFC3339Nano))
+	assert.Equal(t, float64(42), record.Raw["sequence"])
+
+	_, ok = ParseRecord("2023-10-18 10:15:30,123 INFO  [org.apa.cam.imp.eng.AbstractCamelContext] (main) Started")
+	assert.False(t, ok)
+	_, ok = ParseRecord("{not json")
+	assert.False(t, ok)
+}
+
+func TestFilterMatches(t *testing.T) {
+	record, ok := ParseRecord(testRecord)
+	require.True(t, ok)
+	ts := record.Timestamp
+
+	tests := []struct {
+		name    string
+		filter  Filter
+		matches bool
+	}{
+		{"empty", Filter{}, true},
+		{"lower level", Filter{Level: "info"}, true},
+		{"same level", Filter{Level: "WARN"}, true},
+		{"higher level", Filter{Level: "ERROR"}, false},
+		{"logger prefix", Filter{Logger: "org.apache.camel"}, true},
+		{"other logger", Filter{Logger: "io.quarkus"}, false},
+		{"route", Filter{RouteID: "route1"}, true},
+		{"other route", Filter{RouteID: "route2"}, false},
+		{"mdc", Filter{MDC: map[string]string{MDCExchangeID: "ABC-1"}}, true},
+		{"other mdc", Filter{MDC: map[string]string{MDCExchangeID: "ABC-2"}}, false},
+		{"in window", Filter{Since: ts.Add(-time.Minute), Until: ts.Add(time.Minute)}, true},
+		{"before window", Filter{Since: ts.Add(time.Second)}, false},
+		{"after window", Filter{Until: ts.Add(-time.Second)}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.matches, tc.filter.Matches(record))
+		})
+	}
+}
+
+func TestFilterValidate(t *testing.T) {
+	require.NoError(t, (&Filter{Level: "debug"}).Validate())
+	require.Error(t, (&Filter{Level: "verbose"}).Validate())
+	now := time.Now()
+	require.Error(t, (&Filter{Since: now, Until: now.Add(-time.Minute)}).Validate())
+}
@@ -124,6 +124,12 @@ func PortForward(ctx context.Context, c client.Client, ns, labelSelector string,
 	}
 }
 
+// PortForwardPod forwards a random local port to the remote port of the Pod, until the context is done, and returns
+// the local address.
+func PortForwardPod(ctx context.Context, c client.Client, ns, pod string, remotePort uint, stdOut, stdErr io.Writer) (string, error) {
+	return portFowardPod(ctx, c.GetConfig(), ns, pod, 0, remotePort, stdOut, stdErr)
+}
+
 func bootstrapPortForward(ctx context.Context, c client.Client, ns string, labelSelector string, setupPortForward func(pod *corev1.Pod) error) (*corev1.PodList, error) {
 	list, err := c.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{
 		LabelSelector: labelSelector,
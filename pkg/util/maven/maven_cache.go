@@ -0,0 +1,60 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maven
+
+import (
+	"context"
+	"regexp"
+	"sync"
+)
+
+// downloadedArtifactRegexp matches the Maven output reporting an artifact downloaded into the local repository.
+var downloadedArtifactRegexp = regexp.MustCompile(`^Downloaded from [^:]+: \S+\.(?:jar|pom) \(`)
+
+// CacheUsage accounts for the usage of a shared local repository by the Maven executions.
+type CacheUsage struct {
+	lock sync.Mutex
+	// Downloaded is the number of artifacts missing from the local repository, that were downloaded
+	Downloaded int
+}
+
+type cacheUsageKey struct{}
+
+// WithCacheUsage returns a context where the Maven executions using a shared local repository report their usage of it.
+func WithCacheUsage(ctx context.Context, usage *CacheUsage) context.Context {
+	return context.WithValue(ctx, cacheUsageKey{}, usage)
+}
+
+// countDownloads returns a handler accounting for the artifacts reported as downloaded by the Maven output, before
+// passing the output to the given handler. The downloads are counted as they happen, so that the shared local
+// repository never has to be scanned.
+func countDownloads(handler func(string) string, usage *CacheUsage) func(string) string {
+	return func(s string) string {
+		msg := s
+		if l, err := parseLog(s); err == nil {
+			msg = l.Msg
+		}
+		if downloadedArtifactRegexp.MatchString(msg) {
+			usage.lock.Lock()
+			usage.Downloaded++
+			usage.lock.Unlock()
+		}
+
+		return handler(s)
+	}
+}
@@ -0,0 +1,38 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maven
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountDownloads(t *testing.T) {
+	usage := CacheUsage{}
+	handler := countDownloads(LogHandler, &usage)
+
+	handler(`{"level":"INFO","msg":"Downloading from central: https://repo1.maven.org/maven2/org/apache/camel/camel-main/4.0.0/camel-main-4.0.0.jar"}`)
+	handler(`{"level":"INFO","msg":"Downloaded from central: https://repo1.maven.org/maven2/org/apache/camel/camel-main/4.0.0/camel-main-4.0.0.jar (52 kB at 1.2 MB/s)"}`)
+	handler(`{"level":"INFO","msg":"Downloaded from central: https://repo1.maven.org/maven2/org/apache/camel/camel-main/4.0.0/camel-main-4.0.0.pom (3 kB at 100 kB/s)"}`)
+	handler(`{"level":"INFO","msg":"Downloaded from central: https://repo1.maven.org/maven2/org/apache/camel/camel-main/maven-metadata.xml (1 kB at 10 kB/s)"}`)
+	handler("Downloaded from my-repo: https://repo.acme.org/org/acme/model/1.0/model-1.0.jar (1 kB at 10 kB/s)")
+	handler(`{"level":"INFO","msg":"BUILD SUCCESS"}`)
+
+	assert.Equal(t, 3, usage.Downloaded)
+}
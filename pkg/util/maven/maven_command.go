@@ -64,11 +64,7 @@ func (c *Command) Do(ctx context.Context) error {
 	args := make([]string, 0)
 	args = append(args, c.context.AdditionalArguments...)
 
-	if c.context.LocalRepository != "" {
-		if _, err := os.Stat(c.context.LocalRepository); err == nil {
-			args = append(args, "-Dmaven.repo.local="+c.context.LocalRepository)
-		}
-	}
+	args = append(args, localRepositoryArguments(c.context)...)
 
 	settingsPath := filepath.Join(c.context.Path, "settings.xml")
 	if settingsExists, err := util.FileExists(settingsPath); err != nil {
@@ -104,7 +100,39 @@ func (c *Command) Do(ctx context.Context) error {
 		return err
 	}
 
-	return util.RunAndLog(ctx, cmd, LogHandler, LogHandler)
+	handler := LogHandlerFor(ctx)
+	if c.context.SharedLocalRepository && c.context.LocalRepository != "" {
+		// The local repository is shared with concurrent builds, which run in parallel: the concurrent writes of
+		// the same artifact are coordinated by the Maven resolver, with the file locks configured by
+		// localRepositoryArguments.
+		if usage, ok := ctx.Value(cacheUsageKey{}).(*CacheUsage); ok && usage != nil {
+			handler = countDownloads(handler, usage)
+		}
+	}
+
+	return util.RunAndLog(ctx, cmd, handler, handler)
+}
+
+// localRepositoryArguments returns the Maven arguments configuring the local repository of the given context.
+// When the local repository is shared with builds running in other Pods, the Maven resolver is configured to
+// synchronize the accesses to the artifacts with file locks, as its default synchronization only spans one JVM.
+func localRepositoryArguments(c Context) []string {
+	if c.LocalRepository == "" {
+		return nil
+	}
+	if _, err := os.Stat(c.LocalRepository); err != nil {
+		return nil
+	}
+
+	args := []string{"-Dmaven.repo.local=" + c.LocalRepository}
+	if c.SharedLocalRepository {
+		args = append(args,
+			"-Daether.syncContext.named.factory=file-lock",
+			"-Daether.syncContext.named.nameMapper=file-gav",
+		)
+	}
+
+	return args
 }
 
 func (c *Command) optionsFromEnv() (string, []string) {
@@ -171,6 +199,8 @@ type Context struct {
 	AdditionalArguments []string
 	AdditionalEntries   map[string]interface{}
 	LocalRepository     string
+	// SharedLocalRepository is set when the local repository is shared with concurrent builds
+	SharedLocalRepository bool
 }
 
 func (c *Context) AddEntry(id string, entry interface{}) {
@@ -252,7 +282,8 @@ func generateProjectStructure(context Context, project Project) error {
 func (c *Command) prepareMavenWrapper(ctx context.Context) error {
 	cmd := exec.CommandContext(ctx, "cp", "--recursive", "/usr/share/maven/mvnw/.", ".")
 	cmd.Dir = c.context.Path
-	return util.RunAndLog(ctx, cmd, LogHandler, LogHandler)
+	handler := LogHandlerFor(ctx)
+	return util.RunAndLog(ctx, cmd, handler, handler)
 }
 
 // ParseGAV decodes the provided Maven GAV into the corresponding Dependency.
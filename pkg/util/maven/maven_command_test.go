@@ -20,6 +20,8 @@ package maven
 import (
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -34,3 +36,19 @@ func TestGetMavenContext(t *testing.T) {
 	assert.Equal(t, "-s my-settings.xml", mvnSimplePackage)
 	assert.Equal(t, "-s my-settings.xml -DmyProperty=hello", mvnOptionsPackage)
 }
+
+func TestLocalRepositoryArguments(t *testing.T) {
+	repository := t.TempDir()
+
+	assert.Empty(t, localRepositoryArguments(Context{}))
+	assert.Empty(t, localRepositoryArguments(Context{LocalRepository: repository + "/missing"}))
+
+	args := localRepositoryArguments(Context{LocalRepository: repository})
+	assert.Equal(t, []string{"-Dmaven.repo.local=" + repository}, args)
+
+	args = localRepositoryArguments(Context{LocalRepository: repository, SharedLocalRepository: true})
+	require.Len(t, args, 3)
+	assert.Equal(t, "-Dmaven.repo.local="+repository, args[0])
+	assert.Contains(t, args, "-Daether.syncContext.named.factory=file-lock")
+	assert.Contains(t, args, "-Daether.syncContext.named.nameMapper=file-gav")
+}
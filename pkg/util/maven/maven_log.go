@@ -18,8 +18,13 @@ limitations under the License.
 package maven
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/apache/camel-k/v2/pkg/util/log"
 )
@@ -47,6 +52,45 @@ const (
 
 var mavenLogger = log.WithName("maven.build")
 
+var failedGoalRegexp = regexp.MustCompile(`Failed to execute goal (\S+(?: \([^)]*\))?)`)
+
+type logWriterKey struct{}
+
+// WithLogWriter returns a context where the Maven executions also copy their output to the given writer.
+func WithLogWriter(ctx context.Context, w io.Writer) context.Context {
+	return context.WithValue(ctx, logWriterKey{}, w)
+}
+
+// LogHandlerFor returns the LogHandler, copying the normalized output to the writer of the context if any.
+func LogHandlerFor(ctx context.Context) func(string) string {
+	w, ok := ctx.Value(logWriterKey{}).(io.Writer)
+	if !ok || w == nil {
+		return LogHandler
+	}
+	// The standard and error outputs are scanned concurrently
+	var lock sync.Mutex
+	return func(s string) string {
+		line := s
+		if l, err := parseLog(s); err == nil && l.Msg != "" {
+			line = fmt.Sprintf("[%s] %s", l.Level, l.Msg)
+		}
+		lock.Lock()
+		_, _ = fmt.Fprintln(w, line)
+		lock.Unlock()
+
+		return LogHandler(s)
+	}
+}
+
+// FailedGoal returns the Maven goal reported as failed by the given log line, or an empty string.
+func FailedGoal(line string) string {
+	if m := failedGoalRegexp.FindStringSubmatch(line); m != nil {
+		return m[1]
+	}
+
+	return ""
+}
+
 func LogHandler(s string) string {
 	l, parseError := parseLog(s)
 	if parseError == nil {
@@ -18,12 +18,14 @@ limitations under the License.
 package maven
 
 import (
+	"bytes"
 	"context"
 	"os"
 	"os/exec"
 	"testing"
 
 	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -39,3 +41,20 @@ func TestRunAndLogErrorMvn(t *testing.T) {
 	require.Error(t, err)
 	require.ErrorContains(t, err, "[ERROR] The goal you specified requires a project to execute but there is no POM in this directory")
 }
+
+func TestLogHandlerForCopiesOutput(t *testing.T) {
+	var buf bytes.Buffer
+	handler := LogHandlerFor(WithLogWriter(context.Background(), &buf))
+
+	assert.Equal(t, "", handler(`{"level":"INFO","msg":"Building camel-k-integration"}`))
+	assert.Equal(t, "[ERROR] BUILD FAILURE", handler("[ERROR] BUILD FAILURE"))
+	assert.Equal(t, "[INFO] Building camel-k-integration\n[ERROR] BUILD FAILURE\n", buf.String())
+}
+
+func TestFailedGoal(t *testing.T) {
+	assert.Equal(t, "org.apache.maven.plugins:maven-compiler-plugin:3.8.1:compile (default-compile)",
+		FailedGoal("[ERROR] Failed to execute goal org.apache.maven.plugins:maven-compiler-plugin:3.8.1:compile (default-compile) on project camel-k-integration: Compilation failure"))
+	assert.Equal(t, "io.quarkus:quarkus-maven-plugin:3.2.0:build",
+		FailedGoal(`{"level":"error","msg":"[ERROR] Failed to execute goal io.quarkus:quarkus-maven-plugin:3.2.0:build on project camel-k-integration"}`))
+	assert.Equal(t, "", FailedGoal("[INFO] BUILD SUCCESS"))
+}
@@ -0,0 +1,70 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maven
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+// OfflineBundlePath is the path the offline bundle of Maven artifacts is mounted at.
+const OfflineBundlePath = "/etc/maven/offline-bundle"
+
+// OfflineBundle configures the offline bundle, if any, as the local repository, and Maven to work offline.
+func OfflineBundle(bundle *v1.MavenOfflineBundleSpec) SettingsOption {
+	return offlineBundle{
+		enabled: bundle != nil,
+	}
+}
+
+type offlineBundle struct {
+	enabled bool
+}
+
+func (o offlineBundle) apply(settings *Settings) error {
+	if !o.enabled {
+		return nil
+	}
+	settings.Offline = true
+	settings.LocalRepository = OfflineBundlePath
+
+	return nil
+}
+
+// ToOfflineRepository turns a local Maven repository into a repository that can be used read-only by Maven
+// working offline, by removing the files that tie the artifacts to the remote repositories they were
+// downloaded from, along with the tracking files of the remote resolutions.
+func ToOfflineRepository(repository string) error {
+	return filepath.WalkDir(repository, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		name := d.Name()
+		switch {
+		case name == "_remote.repositories", name == "resolver-status.properties",
+			strings.HasSuffix(name, ".lastUpdated"), strings.HasSuffix(name, ".part"):
+			return os.Remove(path)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,84 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maven
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+func TestOfflineBundleSettings(t *testing.T) {
+	settings, err := NewSettings(DefaultRepositories, OfflineBundle(&v1.MavenOfflineBundleSpec{PersistentVolumeClaim: "bundle"}))
+	require.NoError(t, err)
+	assert.True(t, settings.Offline)
+	assert.Equal(t, "/etc/maven/offline-bundle", settings.LocalRepository)
+	assert.Empty(t, settings.Mirrors)
+
+	content, err := settings.MarshalBytes()
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "<localRepository>/etc/maven/offline-bundle</localRepository>")
+	assert.Contains(t, string(content), "<offline>true</offline>")
+}
+
+func TestNoOfflineBundleSettings(t *testing.T) {
+	settings, err := NewSettings(DefaultRepositories, OfflineBundle(nil))
+	require.NoError(t, err)
+	assert.False(t, settings.Offline)
+	assert.Empty(t, settings.LocalRepository)
+
+	content, err := settings.MarshalBytes()
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "<offline>")
+}
+
+func TestToOfflineRepository(t *testing.T) {
+	repo := t.TempDir()
+	dir := filepath.Join(repo, "org", "apache", "camel", "camel-core", "4.0.0")
+	require.NoError(t, os.MkdirAll(dir, os.ModePerm))
+	for name, content := range map[string]string{
+		"camel-core-4.0.0.jar":             "jar",
+		"camel-core-4.0.0.pom":             "pom",
+		"camel-core-4.0.0.pom.sha1":        "sha1",
+		"_remote.repositories":             "remote",
+		"camel-core-4.0.0.jar.lastUpdated": "updated",
+		"camel-core-4.0.0.jar.part":        "partial",
+		"../resolver-status.properties":    "status",
+		"../maven-metadata-central.xml":    "<metadata/>",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+
+	require.NoError(t, ToOfflineRepository(repo))
+
+	assert.NoFileExists(t, filepath.Join(dir, "_remote.repositories"))
+	assert.NoFileExists(t, filepath.Join(dir, "camel-core-4.0.0.jar.lastUpdated"))
+	assert.NoFileExists(t, filepath.Join(dir, "camel-core-4.0.0.jar.part"))
+	assert.NoFileExists(t, filepath.Join(filepath.Dir(dir), "resolver-status.properties"))
+
+	assert.FileExists(t, filepath.Join(dir, "camel-core-4.0.0.jar"))
+	assert.FileExists(t, filepath.Join(dir, "camel-core-4.0.0.pom"))
+	assert.FileExists(t, filepath.Join(dir, "camel-core-4.0.0.pom.sha1"))
+	assert.FileExists(t, filepath.Join(filepath.Dir(dir), "maven-metadata-central.xml"))
+	assert.NoFileExists(t, filepath.Join(dir, "camel-core-4.0.0.jar.sha1"))
+}
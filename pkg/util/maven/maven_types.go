@@ -59,6 +59,7 @@ type Settings struct {
 	XMLNsXsi          string      `xml:"xmlns:xsi,attr"`
 	XsiSchemaLocation string      `xml:"xsi:schemaLocation,attr"`
 	LocalRepository   string      `xml:"localRepository"`
+	Offline           bool        `xml:"offline,omitempty"`
 	Servers           []v1.Server `xml:"servers>server,omitempty"`
 	Profiles          []Profile   `xml:"profiles>profile,omitempty"`
 	Proxies           []Proxy     `xml:"proxies>proxy,omitempty"`
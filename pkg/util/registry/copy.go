@@ -0,0 +1,66 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+)
+
+// TargetImage returns the repository where the source image has to be copied in the destination registry.
+// The last path segment of the source repository is kept and placed under the given organization (typically
+// the namespace of the destination operator).
+func TargetImage(source string, registryAddress string, organization string) (string, error) {
+	ref, err := name.ParseReference(source, name.WeakValidation)
+	if err != nil {
+		return "", fmt.Errorf("could not parse image %s: %w", source, err)
+	}
+	repository := ref.Context().RepositoryStr()
+	if i := strings.LastIndex(repository, "/"); i >= 0 {
+		repository = repository[i+1:]
+	}
+	target := strings.TrimSuffix(registryAddress, "/")
+	if organization != "" {
+		target += "/" + organization
+	}
+
+	return target + "/" + repository, nil
+}
+
+// CopyImage copies the source image into the target repository and returns the reference (by digest) of the
+// copied image. The manifest is copied as is, so the digest of the copied image is the same as the source one.
+func CopyImage(ctx context.Context, source string, target string, insecure bool) (string, error) {
+	options := []crane.Option{crane.WithContext(ctx)}
+	if insecure {
+		options = append(options, crane.Insecure)
+	}
+	digest, err := crane.Digest(source, options...)
+	if err != nil {
+		return "", fmt.Errorf("could not resolve digest of image %s: %w", source, err)
+	}
+	dst := target + "@" + digest
+	if err := crane.Copy(source, dst, options...); err != nil {
+		return "", fmt.Errorf("could not copy image %s to %s: %w", source, dst, err)
+	}
+
+	return dst, nil
+}
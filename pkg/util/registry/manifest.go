@@ -0,0 +1,121 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	containerv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+// PlatformImages returns the digest of the image for each platform it is available for. The image is either
+// a manifest list, or a single platform image.
+func PlatformImages(ctx context.Context, image string, options ...crane.Option) ([]v1.ImagePlatform, error) {
+	o := crane.GetOptions(append(options, crane.WithContext(ctx))...)
+	ref, err := name.ParseReference(image, o.Name...)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse image %s: %w", image, err)
+	}
+	desc, err := remote.Get(ref, o.Remote...)
+	if err != nil {
+		return nil, fmt.Errorf("could not get image %s: %w", image, err)
+	}
+
+	if !desc.MediaType.IsIndex() {
+		img, err := desc.Image()
+		if err != nil {
+			return nil, err
+		}
+		config, err := img.ConfigFile()
+		if err != nil {
+			return nil, fmt.Errorf("could not get configuration of image %s: %w", image, err)
+		}
+		return []v1.ImagePlatform{{Platform: config.Platform().String(), Digest: desc.Digest.String()}}, nil
+	}
+
+	index, err := desc.ImageIndex()
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, fmt.Errorf("could not get manifest list of image %s: %w", image, err)
+	}
+	platforms := make([]v1.ImagePlatform, 0, len(manifest.Manifests))
+	for _, m := range manifest.Manifests {
+		// Skip the manifests not bound to a platform, like attestations
+		if m.Platform == nil || m.Platform.OS == "unknown" {
+			continue
+		}
+		platforms = append(platforms, v1.ImagePlatform{Platform: m.Platform.String(), Digest: m.Digest.String()})
+	}
+
+	return platforms, nil
+}
+
+// WriteManifestList pushes a manifest list as the target image, referencing the given platform images,
+// that must be available in the target repository, and returns its digest.
+func WriteManifestList(ctx context.Context, target string, images []v1.ImagePlatform, options ...crane.Option) (string, error) {
+	o := crane.GetOptions(append(options, crane.WithContext(ctx))...)
+	ref, err := name.ParseReference(target, o.Name...)
+	if err != nil {
+		return "", fmt.Errorf("could not parse image %s: %w", target, err)
+	}
+
+	index := mutate.IndexMediaType(empty.Index, types.DockerManifestList)
+	for _, image := range images {
+		platform, err := containerv1.ParsePlatform(image.Platform)
+		if err != nil {
+			return "", fmt.Errorf("could not parse platform %s: %w", image.Platform, err)
+		}
+		desc, err := remote.Get(ref.Context().Digest(image.Digest), o.Remote...)
+		if err != nil {
+			return "", fmt.Errorf("could not get %s image %s: %w", image.Platform, image.Digest, err)
+		}
+		img, err := desc.Image()
+		if err != nil {
+			return "", err
+		}
+		index = mutate.AppendManifests(index, mutate.IndexAddendum{
+			Add: img,
+			Descriptor: containerv1.Descriptor{
+				MediaType: desc.MediaType,
+				Platform:  platform,
+			},
+		})
+	}
+
+	if err := remote.WriteIndex(ref, index, o.Remote...); err != nil {
+		return "", fmt.Errorf("could not push manifest list %s: %w", target, err)
+	}
+	digest, err := index.Digest()
+	if err != nil {
+		return "", err
+	}
+
+	return digest.String(), nil
+}
@@ -0,0 +1,83 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+func TestWriteManifestList(t *testing.T) {
+	ctx := context.TODO()
+	server := httptest.NewServer(registry.New())
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	repository := u.Host + "/camel-k/my-kit"
+
+	amd64 := pushPlatformImage(t, repository+":amd64", "amd64")
+	arm64 := pushPlatformImage(t, repository+":arm64", "arm64")
+
+	images, err := PlatformImages(ctx, repository+":arm64")
+	require.NoError(t, err)
+	assert.Equal(t, []v1.ImagePlatform{{Platform: "linux/arm64", Digest: arm64}}, images)
+
+	expected := []v1.ImagePlatform{
+		{Platform: "linux/amd64", Digest: amd64},
+		{Platform: "linux/arm64", Digest: arm64},
+	}
+	digest, err := WriteManifestList(ctx, repository+":latest", expected)
+	require.NoError(t, err)
+	assert.NotEmpty(t, digest)
+
+	images, err = PlatformImages(ctx, repository+"@"+digest)
+	require.NoError(t, err)
+	assert.Equal(t, expected, images)
+}
+
+func pushPlatformImage(t *testing.T, image string, architecture string) string {
+	t.Helper()
+	img, err := random.Image(64, 1)
+	require.NoError(t, err)
+	config, err := img.ConfigFile()
+	require.NoError(t, err)
+	config.OS = "linux"
+	config.Architecture = architecture
+	img, err = mutate.ConfigFile(img, config)
+	require.NoError(t, err)
+
+	ref, err := name.ParseReference(image)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+	digest, err := img.Digest()
+	require.NoError(t, err)
+
+	return digest.String()
+}
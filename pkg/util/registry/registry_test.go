@@ -109,3 +109,16 @@ func TestMountSecretRegistryConfig(t *testing.T) {
 	assert.True(t, dockerfileExists)
 	os.RemoveAll(registryConfigDir)
 }
+
+func TestTargetImage(t *testing.T) {
+	target, err := TargetImage("10.96.0.1:5000/development/camel-k-kit-abc@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef", "quay.io/", "production")
+	require.NoError(t, err)
+	assert.Equal(t, "quay.io/production/camel-k-kit-abc", target)
+
+	target, err = TargetImage("camel-k-kit-abc:latest", "registry.prod:5000", "")
+	require.NoError(t, err)
+	assert.Equal(t, "registry.prod:5000/camel-k-kit-abc", target)
+
+	_, err = TargetImage("Not An Image", "quay.io", "production")
+	require.Error(t, err)
+}
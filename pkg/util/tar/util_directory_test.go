@@ -0,0 +1,57 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tar
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTarGzFromDirectory(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "org", "acme"), os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "org", "acme", "acme.jar"), []byte("12345"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "root.pom"), []byte("123"), 0o600))
+
+	var buf bytes.Buffer
+	require.NoError(t, CreateTarGzFromDirectory(dir, &buf))
+
+	gr, err := gzip.NewReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	tr := tar.NewReader(gr)
+	var names []string
+	for {
+		header, err := tr.Next()
+		if err != nil {
+			break
+		}
+		names = append(names, header.Name)
+	}
+	assert.ElementsMatch(t, []string{"org/", "org/acme/", "org/acme/acme.jar", "root.pom"}, names)
+
+	size, err := UncompressedSize(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), size)
+}
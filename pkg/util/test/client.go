@@ -70,14 +70,14 @@ func NewFakeClient(initObjs ...runtime.Object) (client.Client, error) {
 			},
 		).
 		WithRuntimeObjects(initObjs...).
-		WithStatusSubresource(&v1.IntegrationKit{}).
+		WithStatusSubresource(&v1.IntegrationKit{}, &v1.Build{}).
 		Build()
 
 	camelClientset := fakecamelclientset.NewSimpleClientset(filterObjects(scheme, initObjs, func(gvk schema.GroupVersionKind) bool {
 		return strings.Contains(gvk.Group, "camel")
 	})...)
 	clientset := fakeclientset.NewSimpleClientset(filterObjects(scheme, initObjs, func(gvk schema.GroupVersionKind) bool {
-		return !strings.Contains(gvk.Group, "camel") && !strings.Contains(gvk.Group, "knative")
+		return !strings.Contains(gvk.Group, "camel") && !strings.Contains(gvk.Group, "knative") && !strings.Contains(gvk.Group, "tekton")
 	})...)
 	replicasCount := make(map[string]int32)
 	fakescaleclient := fakescale.FakeScaleClient{}
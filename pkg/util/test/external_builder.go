@@ -0,0 +1,178 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/builder/external"
+)
+
+// ExternalBuilder is an in-process external build service. The submitted builds stay in the Pending phase
+// until their status is changed with SetStatus.
+type ExternalBuilder struct {
+	server *httptest.Server
+	token  string
+	lock   sync.Mutex
+	builds map[string]*fakeBuild
+}
+
+type fakeBuild struct {
+	request   external.BuildRequest
+	status    external.BuildStatus
+	log       string
+	submitted bool
+	cancelled bool
+}
+
+// NewExternalBuilder starts a fake external build service, requiring the given bearer token, if not empty.
+func NewExternalBuilder(token string) *ExternalBuilder {
+	s := &ExternalBuilder{
+		token:  token,
+		builds: make(map[string]*fakeBuild),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serve))
+
+	return s
+}
+
+// URL returns the base URL of the external build service.
+func (s *ExternalBuilder) URL() string {
+	return s.server.URL
+}
+
+// Close shuts down the external build service.
+func (s *ExternalBuilder) Close() {
+	s.server.Close()
+}
+
+// Request returns the request submitted for the given build, if any.
+func (s *ExternalBuilder) Request(namespace, name string) (external.BuildRequest, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if b, ok := s.builds[namespace+"/"+name]; ok && b.submitted {
+		return b.request, true
+	}
+	return external.BuildRequest{}, false
+}
+
+// SetStatus sets the status of the given build, which is registered if it has not been submitted.
+func (s *ExternalBuilder) SetStatus(namespace, name string, status external.BuildStatus) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.get(namespace, name).status = status
+}
+
+// SetLog sets the log of the given build, which is registered if it has not been submitted.
+func (s *ExternalBuilder) SetLog(namespace, name, log string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.get(namespace, name).log = log
+}
+
+// Cancelled tells whether the given build has been cancelled.
+func (s *ExternalBuilder) Cancelled(namespace, name string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if b, ok := s.builds[namespace+"/"+name]; ok {
+		return b.cancelled
+	}
+	return false
+}
+
+func (s *ExternalBuilder) get(namespace, name string) *fakeBuild {
+	key := namespace + "/" + name
+	b, ok := s.builds[key]
+	if !ok {
+		b = &fakeBuild{
+			status: external.BuildStatus{Phase: v1.BuildPhasePending},
+		}
+		s.builds[key] = b
+	}
+	return b
+}
+
+func (s *ExternalBuilder) serve(w http.ResponseWriter, r *http.Request) {
+	if s.token != "" && r.Header.Get("Authorization") != "Bearer "+s.token {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	// /v1/builds/{namespace}/{name}[/logs]
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/v1/builds/"), "/")
+	if !strings.HasPrefix(r.URL.Path, "/v1/builds/") || len(parts) < 2 || len(parts) > 3 || (len(parts) == 3 && parts[2] != "logs") {
+		http.NotFound(w, r)
+		return
+	}
+	namespace, name := parts[0], parts[1]
+	logs := len(parts) == 3
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if r.Method == http.MethodPut && !logs {
+		var request external.BuildRequest
+		data, err := io.ReadAll(r.Body)
+		if err == nil {
+			err = json.Unmarshal(data, &request)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		// Submitting a build twice is a no-op
+		if b := s.get(namespace, name); !b.submitted {
+			b.request = request
+			b.submitted = true
+		}
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	b, ok := s.builds[namespace+"/"+name]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	switch {
+	case r.Method == http.MethodGet && logs:
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte(b.log))
+	case r.Method == http.MethodGet:
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(b.status)
+	case r.Method == http.MethodDelete && !logs:
+		b.cancelled = true
+		if b.status.Phase == v1.BuildPhasePending || b.status.Phase == v1.BuildPhaseRunning {
+			b.status.Phase = v1.BuildPhaseFailed
+			b.status.Error = "build cancelled"
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
@@ -67,6 +67,7 @@ deploy_crd camel-catalog camelcatalogs
 deploy_crd integration integrations
 deploy_crd integration-kit integrationkits
 deploy_crd integration-platform integrationplatforms
+deploy_crd integration-policy integrationpolicies
 deploy_crd integration-profile integrationprofiles
 deploy_crd kamelet kamelets
 deploy_crd kamelet-binding kameletbindings